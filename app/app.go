@@ -136,6 +136,10 @@ import (
 	ibcnfttransferkeeper "github.com/cosmos/ibc-go/v5/modules/apps/nft-transfer/keeper"
 	ibcnfttransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/nft-transfer/types"
 
+	"github.com/UptickNetwork/uptick/x/erc1155"
+	erc1155client "github.com/UptickNetwork/uptick/x/erc1155/client"
+	erc1155keeper "github.com/UptickNetwork/uptick/x/erc1155/keeper"
+	erc1155types "github.com/UptickNetwork/uptick/x/erc1155/types"
 	"github.com/UptickNetwork/uptick/x/erc721"
 	erc721client "github.com/UptickNetwork/uptick/x/erc721/client"
 	erc721keeper "github.com/UptickNetwork/uptick/x/erc721/keeper"
@@ -188,10 +192,22 @@ var (
 				erc20client.RegisterCoinProposalHandler,
 				erc20client.RegisterERC20ProposalHandler,
 				erc20client.ToggleTokenRelayProposalHandler,
+				erc20client.ResolveRegistrationDepositProposalHandler,
+				erc20client.UpdateTokenPairRateLimitProposalHandler,
+				erc20client.UpdateTokenPairConversionFeeProposalHandler,
+				erc20client.UpdateTokenPairMetadataProposalHandler,
+				erc20client.UpdateTokenPairBehavioursProposalHandler,
 
 				erc721client.RegisterNFTProposalHandler,
 				erc721client.RegisterERC721ProposalHandler,
 				erc721client.ToggleTokenConversionProposalHandler,
+				erc721client.UpdateTokenPairERC721ProposalHandler,
+				erc721client.DeregisterTokenPairProposalHandler,
+
+				erc1155client.RegisterCoinsProposalHandler,
+				erc1155client.RegisterNFTClassProposalHandler,
+				erc1155client.RegisterERC1155ProposalHandler,
+				erc1155client.ToggleTokenConversionProposalHandler,
 			},
 		),
 
@@ -210,6 +226,7 @@ var (
 		feemarket.AppModuleBasic{},
 		erc20.AppModuleBasic{},
 		erc721.AppModuleBasic{},
+		erc1155.AppModuleBasic{},
 
 		collection.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
@@ -229,7 +246,9 @@ var (
 
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		erc20types.DepositModuleName:   {authtypes.Burner},
 		erc721types.ModuleName:         nil,
+		erc1155types.ModuleName:        {authtypes.Minter, authtypes.Burner},
 
 		collectiontypes.ModuleName:     nil,
 		nft.ModuleName:                 nil,
@@ -306,6 +325,7 @@ type Uptick struct {
 	// Uptick keepers
 	Erc20Keeper      *erc20keeper.Keeper
 	Erc721Keeper     erc721keeper.Keeper
+	Erc1155Keeper    erc1155keeper.Keeper
 
 	NFTKeeper        nftkeeper.Keeper
 	CollectionKeeper collectionkeeper.Keeper
@@ -370,6 +390,7 @@ func NewUptick(
 		// uptick keys
 		erc20types.StoreKey,
 		erc721types.StoreKey,
+		erc1155types.StoreKey,
 		collectiontypes.StoreKey,
 
 		internft.StoreKey,
@@ -536,7 +557,9 @@ func NewUptick(
 		app.GetSubspace(erc20types.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.EvmKeeper,
+		app.IBCKeeper.ChannelKeeper,
 	)
 	app.NFTKeeper = nftkeeper.NewKeeper(
 		keys[nftkeeper.StoreKey],
@@ -553,6 +576,16 @@ func NewUptick(
 		app.EvmKeeper,
 	)
 
+	app.Erc1155Keeper = erc1155keeper.NewKeeper(
+		keys[erc1155types.StoreKey],
+		appCodec,
+		app.GetSubspace(erc1155types.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.NFTKeeper,
+		app.EvmKeeper,
+	)
+
 	// register the proposal types
 	// govRouter := govtypes.NewRouter()
 	govRouter := govv1beta1.NewRouter()
@@ -562,7 +595,8 @@ func NewUptick(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(app.Erc20Keeper)).
-		AddRoute(erc721types.RouterKey, erc721.NewErc721ProposalHandler(&app.Erc721Keeper))
+		AddRoute(erc721types.RouterKey, erc721.NewErc721ProposalHandler(&app.Erc721Keeper)).
+		AddRoute(erc1155types.RouterKey, erc1155.NewErc1155ProposalHandler(&app.Erc1155Keeper))
 
 	govConfig := govtypes.DefaultConfig()
 	govKeeper := govkeeper.NewKeeper(
@@ -584,6 +618,7 @@ func NewUptick(
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.Erc20Keeper.Hooks(),
+			app.Erc721Keeper.Hooks(),
 		),
 	)
 
@@ -600,6 +635,7 @@ func NewUptick(
 		scopedTransferKeeper,
 	)
 	app.Erc20Keeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
+	app.Erc20Keeper.SetTransferKeeper(app.TransferKeeper)
 
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
@@ -684,6 +720,7 @@ func NewUptick(
 		// Uptick app modules
 		erc20.NewAppModule(*app.Erc20Keeper, app.AccountKeeper),
 		erc721.NewAppModule(app.Erc721Keeper, app.AccountKeeper),
+		erc1155.NewAppModule(app.Erc1155Keeper, app.AccountKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		collection.NewAppModule(app.appCodec, app.CollectionKeeper, app.AccountKeeper, app.BankKeeper),
 
@@ -723,6 +760,7 @@ func NewUptick(
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
 		erc721types.ModuleName,
+		erc1155types.ModuleName,
 		nft.ModuleName,
 		collectiontypes.ModuleName,
 
@@ -754,6 +792,7 @@ func NewUptick(
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
 		erc721types.ModuleName,
+		erc1155types.ModuleName,
 		nft.ModuleName,
 		collectiontypes.ModuleName,
 		ibcnfttransfertypes.ModuleName,
@@ -793,6 +832,7 @@ func NewUptick(
 
 		erc20types.ModuleName,
 		erc721types.ModuleName,
+		erc1155types.ModuleName,
 
 		crisistypes.ModuleName,
 		nft.ModuleName,
@@ -1110,10 +1150,14 @@ func initParamsKeeper(
 	// uptick subspaces
 	paramsKeeper.Subspace(erc20types.ModuleName)
 	paramsKeeper.Subspace(erc721types.ModuleName)
+	paramsKeeper.Subspace(erc1155types.ModuleName)
 	return paramsKeeper
 }
 
 
+// upgradeV03 is the name of the v0.3 upgrade
+const upgradeV03 = "v0.3"
+
 func (app *Uptick) registerUpgradeHandlers() {
 	// v0.2 upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(
@@ -1129,6 +1173,16 @@ func (app *Uptick) registerUpgradeHandlers() {
 			return app.mm.RunMigrations(ctx, app.configurator, vm)
 		})
 
+	// v0.3 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		upgradeV03,
+		func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			// set the new erc20 params and migrate the erc721 nft pairs to be
+			// scoped by token pair, the erc1155 module is initialized from its
+			// default genesis
+			return app.mm.RunMigrations(ctx, app.configurator, vm)
+		})
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1143,10 +1197,13 @@ func (app *Uptick) registerUpgradeHandlers() {
 
 	var storeUpgrades *storetypes.StoreUpgrades
 
-	// switch upgradeInfo.Name {
-	// case "v0.2":
-	// 	// no store upgrades in v0.2
-	// }
+	switch upgradeInfo.Name {
+	case upgradeV03:
+		// add the store of the new erc1155 module
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{erc1155types.StoreKey},
+		}
+	}
 
 	if storeUpgrades != nil {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
@@ -8,19 +8,28 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/evmos/ethermint/encoding"
+
+	"github.com/UptickNetwork/uptick/x/erc1155"
+	erc1155types "github.com/UptickNetwork/uptick/x/erc1155/types"
+	"github.com/UptickNetwork/uptick/x/erc20"
+	erc20types "github.com/UptickNetwork/uptick/x/erc20/types"
+	"github.com/UptickNetwork/uptick/x/erc721"
+	erc721types "github.com/UptickNetwork/uptick/x/erc721/types"
 )
 
 func TestUptickExport(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := NewUptick(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{})
 
-	genesisState := NewDefaultGenesisState()
+	genesisState := NewTestGenesisState(app.AppCodec())
 	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
 	require.NoError(t, err)
 
@@ -39,3 +48,23 @@ func TestUptickExport(t *testing.T) {
 	_, err = app2.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+func TestUpgradeV03(t *testing.T) {
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	// the module versions of the chain before the upgrade, without erc1155
+	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	vm[erc20types.ModuleName] = 1
+	vm[erc721types.ModuleName] = 1
+	delete(vm, erc1155types.ModuleName)
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: upgradeV03, Height: 1})
+
+	vm = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, erc20.AppModuleBasic{}.ConsensusVersion(), vm[erc20types.ModuleName])
+	require.Equal(t, erc721.AppModuleBasic{}.ConsensusVersion(), vm[erc721types.ModuleName])
+	require.Equal(t, erc1155.AppModuleBasic{}.ConsensusVersion(), vm[erc1155types.ModuleName])
+	require.Equal(t, erc1155types.DefaultParams(), app.Erc1155Keeper.GetParams(ctx))
+}
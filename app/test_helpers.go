@@ -10,8 +10,15 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 
 	"github.com/evmos/ethermint/encoding"
@@ -47,13 +54,14 @@ var DefaultConsensusParams = &abci.ConsensusParams{
 	},
 }
 
-// Setup initializes a new Uptick. A Nop logger is set in Uptick.
+// Setup initializes a new Uptick with a single genesis validator. A Nop logger
+// is set in Uptick.
 func Setup(isCheckTx bool, feemarketGenesis *feemarkettypes.GenesisState) *Uptick {
 	db := dbm.NewMemDB()
 	app := NewUptick(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{})
 	if !isCheckTx {
 		// init chain must be called to stop deliverState from being nil
-		genesisState := NewDefaultGenesisState()
+		genesisState := NewTestGenesisState(app.AppCodec())
 
 		// Verify feeMarket genesis
 		if feemarketGenesis != nil {
@@ -89,3 +97,57 @@ func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
 	app := NewUptick(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, cfg, simapp.EmptyAppOptions{})
 	return app, NewDefaultGenesisState()
 }
+
+// NewTestGenesisState returns the default genesis state with the given
+// balances and a single bonded validator, as the staking module requires a
+// validator set at genesis
+func NewTestGenesisState(cdc codec.Codec, balances ...banktypes.Balance) simapp.GenesisState {
+	genesisState := NewDefaultGenesisState()
+
+	delegator := authtypes.NewBaseAccount(secp256k1.GenPrivKey().PubKey().Address().Bytes(), nil, 0, 0)
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), []authtypes.GenesisAccount{delegator})
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		panic(err)
+	}
+
+	bondAmt := sdk.DefaultPowerReduction
+	valAddr := sdk.ValAddress(pubKey.Address())
+	validator := stakingtypes.Validator{
+		OperatorAddress:   valAddr.String(),
+		ConsensusPubkey:   pkAny,
+		Status:            stakingtypes.Bonded,
+		Tokens:            bondAmt,
+		DelegatorShares:   sdk.OneDec(),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
+	}
+	delegation := stakingtypes.NewDelegation(delegator.GetAddress(), valAddr, sdk.OneDec())
+	stakingGenesis := stakingtypes.NewGenesisState(
+		stakingtypes.DefaultParams(),
+		[]stakingtypes.Validator{validator},
+		[]stakingtypes.Delegation{delegation},
+	)
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	// the bonded tokens are held by the bonded pool
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(stakingGenesis.Params.BondDenom, bondAmt))
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   bondedCoins,
+	})
+
+	totalSupply := sdk.NewCoins()
+	for _, balance := range balances {
+		totalSupply = totalSupply.Add(balance.Coins...)
+	}
+
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	return genesisState
+}
@@ -33,6 +33,13 @@ contract ERC20MinterBurnerDecimals is
     bytes32 public constant PAUSER_ROLE = keccak256("PAUSER_ROLE");
     bytes32 public constant BURNER_ROLE = keccak256("BURNER_ROLE");
     uint8 private _decimals;
+    string private _metadataName;
+    string private _metadataSymbol;
+
+    /**
+     * @dev Emitted when the name, symbol and decimals are updated by the admin.
+     */
+    event MetadataUpdated(string name, string symbol, uint8 decimals);
 
     /**
      * @dev Grants `DEFAULT_ADMIN_ROLE`, `MINTER_ROLE` and `PAUSER_ROLE` to the
@@ -50,16 +57,57 @@ contract ERC20MinterBurnerDecimals is
         _setupRole(MINTER_ROLE, _msgSender());
         _setupRole(PAUSER_ROLE, _msgSender());
         _setupRole(BURNER_ROLE, _msgSender());
-        _setupDecimals(decimals_);
+        _setupMetadata(name, symbol, decimals_);
     }
 
     /**
-     * @dev Sets `_decimals` as `decimals_ once at Deployment'
+     * @dev Sets the name, symbol and `_decimals` at Deployment and on the
+     * metadata updates
      */
-    function _setupDecimals(uint8 decimals_) private {
+    function _setupMetadata(
+        string memory name_,
+        string memory symbol_,
+        uint8 decimals_
+    ) private {
+        _metadataName = name_;
+        _metadataSymbol = symbol_;
         _decimals = decimals_;
     }
 
+    /**
+     * @dev Updates the name, symbol and decimals of the token.
+     *
+     * Requirements:
+     *
+     * - the caller must have the `DEFAULT_ADMIN_ROLE`.
+     */
+    function setMetadata(
+        string memory name_,
+        string memory symbol_,
+        uint8 decimals_
+    ) public virtual {
+        require(
+            hasRole(DEFAULT_ADMIN_ROLE, _msgSender()),
+            "ERC20MinterBurnerDecimals: must have admin role to set metadata"
+        );
+        _setupMetadata(name_, symbol_, decimals_);
+        emit MetadataUpdated(name_, symbol_, decimals_);
+    }
+
+    /**
+     * @dev Overrides the `name()` method with the updatable `_metadataName`
+     */
+    function name() public view virtual override returns (string memory) {
+        return _metadataName;
+    }
+
+    /**
+     * @dev Overrides the `symbol()` method with the updatable `_metadataSymbol`
+     */
+    function symbol() public view virtual override returns (string memory) {
+        return _metadataSymbol;
+    }
+
     /**
      * @dev Overrides the `decimals()` method with custom `_decimals`
      */
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.0;
+
+import "./@openzeppelin/contracts/token/ERC20/ERC20.sol";
+
+// ERC20 token implementing the EIP-2612 permit, only the deployer can mint.
+contract ERC20MinterPermit is ERC20 {
+    bytes32 public constant PERMIT_TYPEHASH =
+        keccak256("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)");
+
+    bytes32 public immutable DOMAIN_SEPARATOR;
+
+    address private immutable _minter;
+
+    mapping(address => uint256) public nonces;
+
+    constructor(string memory name, string memory symbol) ERC20(name, symbol) {
+        _minter = _msgSender();
+        DOMAIN_SEPARATOR = keccak256(
+            abi.encode(
+                keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"),
+                keccak256(bytes(name)),
+                keccak256(bytes("1")),
+                block.chainid,
+                address(this)
+            )
+        );
+    }
+
+    function mint(address to, uint256 amount) public virtual {
+        require(_msgSender() == _minter, "ERC20MinterPermit: must be the minter");
+        _mint(to, amount);
+    }
+
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public virtual {
+        require(block.timestamp <= deadline, "ERC20MinterPermit: expired deadline");
+
+        bytes32 structHash = keccak256(abi.encode(PERMIT_TYPEHASH, owner, spender, value, nonces[owner]++, deadline));
+        bytes32 digest = keccak256(abi.encodePacked("\x19\x01", DOMAIN_SEPARATOR, structHash));
+
+        address signer = ecrecover(digest, v, r, s);
+        require(signer != address(0) && signer == owner, "ERC20MinterPermit: invalid signature");
+
+        _approve(owner, spender, value);
+    }
+}
@@ -18,6 +18,8 @@ import "./@openzeppelin/contracts/utils/Counters.sol";
  *  - a minter role that allows for token minting (creation)
  *  - a pauser role that allows to stop all token transfers
  *  - token ID and URI autogeneration
+ *  - minting with an explicit token ID and URI
+ *  - EIP-2981 royalties, set by the admin for the whole contract or per token
  *
  * This contract uses {AccessControl} to lock permissioned functions using the
  * different roles - head to its documentation for details.
@@ -40,10 +42,24 @@ contract ERC721PresetMinterPauserAutoId is
     bytes32 public constant MINTER_ROLE = keccak256("MINTER_ROLE");
     bytes32 public constant PAUSER_ROLE = keccak256("PAUSER_ROLE");
 
+    // Automatically assigned token IDs start at 2^255, explicit token IDs
+    // must be lower, so that both ranges never overlap.
+    uint256 public constant AUTO_ID_OFFSET = 1 << 255;
+
     Counters.Counter private _tokenIdTracker;
 
     string private _baseTokenURI;
 
+    mapping(uint256 => string) private _tokenURIs;
+
+    struct RoyaltyInfo {
+        address receiver;
+        uint96 royaltyFraction;
+    }
+
+    RoyaltyInfo private _defaultRoyaltyInfo;
+    mapping(uint256 => RoyaltyInfo) private _tokenRoyaltyInfo;
+
     /**
      * @dev Grants `DEFAULT_ADMIN_ROLE`, `MINTER_ROLE` and `PAUSER_ROLE` to the
      * account that deploys the contract.
@@ -87,15 +103,156 @@ contract ERC721PresetMinterPauserAutoId is
 
         // We cannot just use balanceOf to create the new tokenId because tokens
         // can be burned (destroyed), so we need a separate counter.
-        _mint(to, _tokenIdTracker.current());
+        _mint(to, nextTokenId());
         _tokenIdTracker.increment();
     }
 
+    /**
+     * @dev Creates the token `tokenId` for `to`, with `uri` as token URI. When
+     * `uri` is empty, the token URI is autogenerated based on the base URI.
+     *
+     * See {ERC721-_mint}.
+     *
+     * Requirements:
+     *
+     * - the caller must have the `MINTER_ROLE`.
+     * - `tokenId` must be lower than `AUTO_ID_OFFSET`.
+     * - `tokenId` must not exist.
+     */
+    function mintWithTokenId(
+        address to,
+        uint256 tokenId,
+        string memory uri
+    ) public virtual {
+        require(
+            hasRole(MINTER_ROLE, _msgSender()),
+            "ERC721PresetMinterPauserAutoId: must have minter role to mint"
+        );
+        require(
+            tokenId < AUTO_ID_OFFSET,
+            "ERC721PresetMinterPauserAutoId: token id in the automatic range"
+        );
+
+        _mint(to, tokenId);
+        if (bytes(uri).length > 0) {
+            _tokenURIs[tokenId] = uri;
+        }
+    }
+
+    /**
+     * @dev See {IERC721Metadata-tokenURI}.
+     */
+    function tokenURI(uint256 tokenId)
+        public
+        view
+        virtual
+        override
+        returns (string memory)
+    {
+        require(
+            _exists(tokenId),
+            "ERC721PresetMinterPauserAutoId: URI query for nonexistent token"
+        );
+
+        string memory uri = _tokenURIs[tokenId];
+        if (bytes(uri).length > 0) {
+            return uri;
+        }
+
+        return super.tokenURI(tokenId);
+    }
+
     /**
      * Returns next tokenId
      */
     function nextTokenId() public view returns (uint256) {
-        return _tokenIdTracker.current();
+        return AUTO_ID_OFFSET + _tokenIdTracker.current();
+    }
+
+    /**
+     * @dev Returns the royalty receiver and amount of `salePrice` for
+     * `tokenId`, as defined by EIP-2981. The royalty of the token defaults to
+     * the royalty of the contract.
+     */
+    function royaltyInfo(uint256 tokenId, uint256 salePrice)
+        public
+        view
+        virtual
+        returns (address, uint256)
+    {
+        RoyaltyInfo memory royalty = _tokenRoyaltyInfo[tokenId];
+
+        if (royalty.receiver == address(0)) {
+            royalty = _defaultRoyaltyInfo;
+        }
+
+        uint256 royaltyAmount = (salePrice * royalty.royaltyFraction) /
+            _feeDenominator();
+
+        return (royalty.receiver, royaltyAmount);
+    }
+
+    /**
+     * @dev Sets the royalty of all the tokens without token royalty, in basis
+     * points of the sale price.
+     *
+     * Requirements:
+     *
+     * - the caller must have the `DEFAULT_ADMIN_ROLE`.
+     * - `feeNumerator` cannot be greater than the fee denominator.
+     */
+    function setDefaultRoyalty(address receiver, uint96 feeNumerator)
+        public
+        virtual
+    {
+        require(
+            hasRole(DEFAULT_ADMIN_ROLE, _msgSender()),
+            "ERC721PresetMinterPauserAutoId: must have admin role to set royalty"
+        );
+        require(
+            feeNumerator <= _feeDenominator(),
+            "ERC721PresetMinterPauserAutoId: royalty fee will exceed salePrice"
+        );
+
+        _defaultRoyaltyInfo = RoyaltyInfo(receiver, feeNumerator);
+    }
+
+    /**
+     * @dev Sets the royalty of `tokenId`, in basis points of the sale price,
+     * overriding the royalty of the contract.
+     *
+     * Requirements:
+     *
+     * - the caller must have the `DEFAULT_ADMIN_ROLE`.
+     * - `tokenId` must exist.
+     * - `feeNumerator` cannot be greater than the fee denominator.
+     */
+    function setTokenRoyalty(
+        uint256 tokenId,
+        address receiver,
+        uint96 feeNumerator
+    ) public virtual {
+        require(
+            hasRole(DEFAULT_ADMIN_ROLE, _msgSender()),
+            "ERC721PresetMinterPauserAutoId: must have admin role to set royalty"
+        );
+        require(
+            _exists(tokenId),
+            "ERC721PresetMinterPauserAutoId: royalty set for nonexistent token"
+        );
+        require(
+            feeNumerator <= _feeDenominator(),
+            "ERC721PresetMinterPauserAutoId: royalty fee will exceed salePrice"
+        );
+
+        _tokenRoyaltyInfo[tokenId] = RoyaltyInfo(receiver, feeNumerator);
+    }
+
+    /**
+     * @dev The denominator of the royalty fees, in basis points.
+     */
+    function _feeDenominator() internal pure virtual returns (uint96) {
+        return 10000;
     }
 
     /**
@@ -132,6 +289,16 @@ contract ERC721PresetMinterPauserAutoId is
         _unpause();
     }
 
+    function _burn(uint256 tokenId) internal virtual override {
+        super._burn(tokenId);
+
+        if (bytes(_tokenURIs[tokenId]).length != 0) {
+            delete _tokenURIs[tokenId];
+        }
+
+        delete _tokenRoyaltyInfo[tokenId];
+    }
+
     function _beforeTokenTransfer(
         address from,
         address to,
@@ -150,6 +317,8 @@ contract ERC721PresetMinterPauserAutoId is
         override(AccessControlEnumerable, ERC721, ERC721Enumerable)
         returns (bool)
     {
-        return super.supportsInterface(interfaceId);
+        // EIP-2981 royaltyInfo
+        return
+            interfaceId == 0x2a55205a || super.supportsInterface(interfaceId);
     }
 }
@@ -0,0 +1,26 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/ERC1155PresetMinterPauser.json
+	ERC1155PresetMinterPauserJSON []byte // nolint: golint
+
+	// ERC1155PresetMinterPauserContract is the compiled erc1155 contract
+	ERC1155PresetMinterPauserContract evmtypes.CompiledContract
+)
+
+func init() {
+	if err := json.Unmarshal(ERC1155PresetMinterPauserJSON, &ERC1155PresetMinterPauserContract); err != nil {
+		panic(err)
+	}
+
+	if len(ERC1155PresetMinterPauserContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,23 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/ERC20MinterPermit.json
+	erc20MinterPermitJSON []byte
+
+	// ERC20MinterPermitContract is the compiled ERC20 contract implementing
+	// the EIP-2612 permit
+	ERC20MinterPermitContract evmtypes.CompiledContract
+)
+
+func init() {
+	if err := json.Unmarshal(erc20MinterPermitJSON, &ERC20MinterPermitContract); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,23 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/IERC20Permit.json
+	ierc20PermitJSON []byte
+
+	// IERC20PermitContract is the compiled EIP-2612 permit interface, it only
+	// holds the ABI
+	IERC20PermitContract evmtypes.CompiledContract
+)
+
+func init() {
+	if err := json.Unmarshal(ierc20PermitJSON, &IERC20PermitContract); err != nil {
+		panic(err)
+	}
+}
@@ -17,6 +17,10 @@ const (
 	FlagSymbol           = "symbol"
 	FlagMintRestricted   = "mint-restricted"
 	FlagUpdateRestricted = "update-restricted"
+
+	FlagRoyaltyReceiver    = "royalty-receiver"
+	FlagRoyaltyBasisPoints = "royalty-basis-points"
+	FlagSalePrice          = "sale-price"
 )
 
 var (
@@ -27,6 +31,8 @@ var (
 	FsQuerySupply   = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQueryOwner    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsTransferDenom = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRoyalty       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryRoyalty  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -52,4 +58,9 @@ func init() {
 	FsQuerySupply.String(FlagOwner, "", "The owner of the nft")
 
 	FsQueryOwner.String(FlagDenomID, "", "The name of the collection")
+
+	FsRoyalty.String(FlagRoyaltyReceiver, "", "Receiver of the royalty, if not filled, no royalty is set")
+	FsRoyalty.Uint32(FlagRoyaltyBasisPoints, 0, "Royalty in basis points of the sale price")
+
+	FsQueryRoyalty.String(FlagSalePrice, "", "Sale price to compute the royalty amount of")
 }
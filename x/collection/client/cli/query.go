@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySupply(),
 		GetCmdQueryOwner(),
 		GetCmdQueryNFT(),
+		GetCmdQueryRoyalty(),
 	)
 
 	return queryCmd
@@ -247,3 +248,44 @@ func GetCmdQueryNFT() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryRoyalty queries the royalty of an NFT or a denom
+func GetCmdQueryRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [denom-id] [nft-id]",
+		Long:    "Query the royalty of an NFT, or of the denom when the nft id is omitted.",
+		Example: fmt.Sprintf("$ %s query nft royalty <denom-id> <nft-id> --sale-price=<sale-price>", version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var tokenID string
+			if len(args) == 2 {
+				tokenID = args[1]
+			}
+
+			salePrice, err := cmd.Flags().GetString(FlagSalePrice)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Royalty(context.Background(), &types.QueryRoyaltyRequest{
+				DenomId:   args[0],
+				TokenId:   tokenID,
+				SalePrice: salePrice,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryRoyalty)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
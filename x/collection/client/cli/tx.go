@@ -97,6 +97,9 @@ func GetCmdIssueDenom() *cobra.Command {
 				mintRestricted,
 				updateRestricted,
 			)
+			if msg.Royalty, err = parseRoyalty(cmd); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -104,6 +107,7 @@ func GetCmdIssueDenom() *cobra.Command {
 		},
 	}
 	cmd.Flags().AddFlagSet(FsIssueDenom)
+	cmd.Flags().AddFlagSet(FsRoyalty)
 	_ = cmd.MarkFlagRequired(FlagMintRestricted)
 	_ = cmd.MarkFlagRequired(FlagUpdateRestricted)
 	flags.AddTxFlagsToCmd(cmd)
@@ -170,6 +174,9 @@ func GetCmdMintNFT() *cobra.Command {
 				sender,
 				recipient,
 			)
+			if msg.Royalty, err = parseRoyalty(cmd); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -177,6 +184,7 @@ func GetCmdMintNFT() *cobra.Command {
 		},
 	}
 	cmd.Flags().AddFlagSet(FsMintNFT)
+	cmd.Flags().AddFlagSet(FsRoyalty)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -365,3 +373,25 @@ func GetCmdTransferDenom() *cobra.Command {
 
 	return cmd
 }
+
+// parseRoyalty returns the royalty set by the royalty flags, nil without
+// royalty receiver
+func parseRoyalty(cmd *cobra.Command) (*types.RoyaltyInfo, error) {
+	receiver, err := cmd.Flags().GetString(FlagRoyaltyReceiver)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(receiver) == "" {
+		return nil, nil
+	}
+
+	basisPoints, err := cmd.Flags().GetUint32(FlagRoyaltyBasisPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RoyaltyInfo{
+		Receiver:    strings.TrimSpace(receiver),
+		BasisPoints: basisPoints,
+	}, nil
+}
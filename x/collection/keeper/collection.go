@@ -26,6 +26,11 @@ func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) erro
 		); err != nil {
 			return err
 		}
+		if nft.Royalty != nil {
+			if err := k.SetNFTRoyalty(ctx, collection.Denom.ID, nft.GetID(), *nft.Royalty); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
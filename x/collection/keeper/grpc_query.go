@@ -97,11 +97,12 @@ func (k Keeper) Collection(c context.Context, request *types.QueryCollectionRequ
 		}
 
 		nfts = append(nfts, types.BaseNFT{
-			ID:    token.Id,
-			URI:   token.Uri,
-			Name:  nftMetadata.Name,
-			Owner: owner.String(),
-			Data:  nftMetadata.Description,
+			ID:      token.Id,
+			URI:     token.Uri,
+			Name:    nftMetadata.Name,
+			Owner:   owner.String(),
+			Data:    nftMetadata.Description,
+			Royalty: nftMetadata.Royalty,
 		})
 	}
 
@@ -167,3 +168,26 @@ func (k Keeper) NFT(c context.Context, request *types.QueryNFTRequest) (*types.Q
 
 	return &types.QueryNFTResponse{NFT: &baseNFT}, nil
 }
+
+func (k Keeper) Royalty(c context.Context, request *types.QueryRoyaltyRequest) (*types.QueryRoyaltyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	royalty, found, err := k.GetRoyalty(ctx, request.DenomId, request.TokenId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no royalty for NFT %s of collection %s", request.TokenId, request.DenomId)
+	}
+
+	response := &types.QueryRoyaltyResponse{Royalty: royalty}
+	if request.SalePrice != "" {
+		salePrice, ok := sdk.NewIntFromString(request.SalePrice)
+		if !ok || salePrice.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sale price %s", request.SalePrice)
+		}
+		response.RoyaltyAmount = royalty.RoyaltyAmount(salePrice).String()
+	}
+
+	return response, nil
+}
@@ -233,6 +233,7 @@ func (k Keeper) TransferDenomOwner(
 		Schema:           denom.Schema,
 		MintRestricted:   denom.MintRestricted,
 		UpdateRestricted: denom.UpdateRestricted,
+		Royalty:          denom.Royalty,
 	}
 	data, err := codectypes.NewAnyWithValue(denomMetadata)
 	if err != nil {
@@ -11,7 +11,9 @@ import (
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 
 	"github.com/UptickNetwork/uptick/app"
 	"github.com/UptickNetwork/uptick/x/collection/keeper"
@@ -179,6 +181,41 @@ func (suite *KeeperSuite) TestTransferDenom() {
 	suite.Equal(denom.Creator, address3.String())
 }
 
+func (suite *KeeperSuite) TestTransferDenomKeepsRoyalty() {
+	royalty := types.NewRoyaltyInfo(address2, 500)
+	err := suite.app.CollectionKeeper.SetDenomRoyalty(suite.ctx, denomID, *royalty)
+	suite.NoError(err)
+
+	err = suite.app.CollectionKeeper.TransferDenomOwner(suite.ctx, denomID, address, address3)
+	suite.NoError(err)
+
+	denom, err := suite.app.CollectionKeeper.GetDenomInfo(suite.ctx, denomID)
+	suite.NoError(err)
+	suite.Equal(address3.String(), denom.Creator)
+	suite.Equal(royalty, denom.Royalty)
+
+	// the royalty still applies to the NFTs of the denom
+	info, found, err := suite.app.CollectionKeeper.GetRoyalty(suite.ctx, denomID, "")
+	suite.NoError(err)
+	suite.True(found)
+	suite.Equal(*royalty, info)
+}
+
+func (suite *KeeperSuite) TestSetDenomRoyaltyForeignData() {
+	data, err := codectypes.NewAnyWithValue(&types.NFTMetadata{Name: tokenNm})
+	suite.NoError(err)
+	class := nft.Class{Id: "denomid4", Name: "denom4nm", Symbol: "denomSymbol4", Data: data}
+	suite.NoError(suite.app.NFTKeeper.SaveClass(suite.ctx, class))
+
+	err = suite.app.CollectionKeeper.SetDenomRoyalty(suite.ctx, class.Id, *types.NewRoyaltyInfo(address2, 500))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	// the data of the denom is left unchanged
+	saved, found := suite.app.NFTKeeper.GetClass(suite.ctx, class.Id)
+	suite.True(found)
+	suite.Equal(data.Value, saved.Data.Value)
+}
+
 func (suite *KeeperSuite) TestBurnNFT() {
 	// MintNFT should not fail when collection does not exist
 	err := suite.app.CollectionKeeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
@@ -207,7 +244,7 @@ func CreateTestAddrs(numAddrs int) []sdk.AccAddress {
 		buffer.WriteString("A58856F0FD53BF058B4909A21AEC019107BA6") //base address string
 
 		buffer.WriteString(numString) //adding on final two digits to make addresses unique
-		res, _ := sdk.AccAddressFromHex(buffer.String())
+		res, _ := sdk.AccAddressFromHexUnsafe(buffer.String())
 		bech := res.String()
 		addresses = append(addresses, testAddr(buffer.String(), bech))
 		buffer.Reset()
@@ -218,7 +255,7 @@ func CreateTestAddrs(numAddrs int) []sdk.AccAddress {
 
 // for incode address generation
 func testAddr(addr string, bech string) sdk.AccAddress {
-	res, err := sdk.AccAddressFromHex(addr)
+	res, err := sdk.AccAddressFromHexUnsafe(addr)
 	if err != nil {
 		panic(err)
 	}
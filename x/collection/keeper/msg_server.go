@@ -41,6 +41,12 @@ func (m msgServer) IssueDenom(goCtx context.Context, msg *types.MsgIssueDenom) (
 		return nil, err
 	}
 
+	if msg.Royalty != nil {
+		if err := m.Keeper.SetDenomRoyalty(ctx, msg.ID, *msg.Royalty); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeIssueDenom,
@@ -83,6 +89,12 @@ func (m msgServer) MintNFT(goCtx context.Context, msg *types.MsgMintNFT) (*types
 		return nil, err
 	}
 
+	if msg.Royalty != nil {
+		if err := m.Keeper.SetNFTRoyalty(ctx, msg.DenomID, msg.ID, *msg.Royalty); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeMintNFT,
@@ -22,11 +22,12 @@ func (k Keeper) GetNFT(ctx sdk.Context, denomID, tokenID string) (nft exported.N
 
 	owner := k.nk.GetOwner(ctx, denomID, tokenID)
 	return types.BaseNFT{
-		ID:    token.GetId(),
-		Name:  nftMetadata.Name,
-		URI:   token.GetUri(),
-		Data:  nftMetadata.Description,
-		Owner: owner.String(),
+		ID:      token.GetId(),
+		Name:    nftMetadata.Name,
+		URI:     token.GetUri(),
+		Data:    nftMetadata.Description,
+		Owner:   owner.String(),
+		Royalty: nftMetadata.Royalty,
 	}, nil
 }
 
@@ -39,11 +40,12 @@ func (k Keeper) GetNFTs(ctx sdk.Context, denom string) (nfts []exported.NFT, err
 			return nil, err
 		}
 		nfts = append(nfts, types.BaseNFT{
-			ID:    token.GetId(),
-			Name:  nftMetadata.Name,
-			URI:   token.GetUri(),
-			Data:  nftMetadata.Description,
-			Owner: k.nk.GetOwner(ctx, denom, token.GetId()).String(),
+			ID:      token.GetId(),
+			Name:    nftMetadata.Name,
+			URI:     token.GetUri(),
+			Data:    nftMetadata.Description,
+			Owner:   k.nk.GetOwner(ctx, denom, token.GetId()).String(),
+			Royalty: nftMetadata.Royalty,
 		})
 	}
 	return nfts, nil
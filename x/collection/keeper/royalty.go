@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/UptickNetwork/uptick/x/collection/types"
+)
+
+// GetRoyalty returns the royalty of the given NFT, or of the denom when the
+// token ID is empty. The royalty of an NFT defaults to the royalty of its
+// denom.
+func (k Keeper) GetRoyalty(ctx sdk.Context, denomID, tokenID string) (types.RoyaltyInfo, bool, error) {
+	class, has := k.nk.GetClass(ctx, denomID)
+	if !has {
+		return types.RoyaltyInfo{}, false, sdkerrors.Wrapf(types.ErrInvalidDenom, "denom ID %s not exists", denomID)
+	}
+
+	if tokenID == "" {
+		royalty, found := types.GetRoyalty(k.cdc, class, nil)
+		return royalty, found, nil
+	}
+
+	token, exist := k.nk.GetNFT(ctx, denomID, tokenID)
+	if !exist {
+		return types.RoyaltyInfo{}, false, sdkerrors.Wrapf(types.ErrUnknownNFT, "not found NFT: %s", tokenID)
+	}
+
+	royalty, found := types.GetRoyalty(k.cdc, class, &token)
+	return royalty, found, nil
+}
+
+// SetDenomRoyalty sets the royalty of the NFTs of the given denom. Denoms
+// whose data is not the module denom metadata are rejected.
+func (k Keeper) SetDenomRoyalty(ctx sdk.Context, denomID string, royalty types.RoyaltyInfo) error {
+	class, has := k.nk.GetClass(ctx, denomID)
+	if !has {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denom ID %s not exists", denomID)
+	}
+
+	var denomMetadata types.DenomMetadata
+	if class.Data != nil {
+		if class.Data.TypeUrl != "/"+proto.MessageName(&denomMetadata) {
+			return sdkerrors.Wrapf(types.ErrInvalidDenom, "denom ID %s has data of type %s", denomID, class.Data.TypeUrl)
+		}
+		if err := k.cdc.Unmarshal(class.Data.GetValue(), &denomMetadata); err != nil {
+			return err
+		}
+	}
+	denomMetadata.Royalty = &royalty
+
+	data, err := codectypes.NewAnyWithValue(&denomMetadata)
+	if err != nil {
+		return err
+	}
+	class.Data = data
+	return k.nk.UpdateClass(ctx, class)
+}
+
+// SetNFTRoyalty sets the royalty of the given NFT, overriding the royalty of
+// its denom
+func (k Keeper) SetNFTRoyalty(ctx sdk.Context, denomID, tokenID string, royalty types.RoyaltyInfo) error {
+	token, exist := k.nk.GetNFT(ctx, denomID, tokenID)
+	if !exist {
+		return sdkerrors.Wrapf(types.ErrUnknownNFT, "not found NFT: %s", tokenID)
+	}
+
+	data, err := types.SetNFTDataRoyalty(k.cdc, token.Data, royalty)
+	if err != nil {
+		return err
+	}
+	token.Data = data
+	return k.nk.Update(ctx, token)
+}
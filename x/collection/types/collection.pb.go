@@ -30,6 +30,8 @@ type BaseNFT struct {
 	URI   string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
 	Data  string `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// royalty of the nft, overriding the royalty of its denom
+	Royalty *RoyaltyInfo `protobuf:"bytes,6,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *BaseNFT) Reset()         { *m = BaseNFT{} }
@@ -68,6 +70,8 @@ var xxx_messageInfo_BaseNFT proto.InternalMessageInfo
 type NFTMetadata struct {
 	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// royalty of the nft, overriding the royalty of its denom
+	Royalty *RoyaltyInfo `protobuf:"bytes,3,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *NFTMetadata) Reset()         { *m = NFTMetadata{} }
@@ -103,6 +107,48 @@ func (m *NFTMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NFTMetadata proto.InternalMessageInfo
 
+// RoyaltyInfo defines the EIP-2981 royalty paid to the receiver on the sales
+// of an nft
+type RoyaltyInfo struct {
+	// bech32 address of the royalty receiver
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// royalty in basis points of the sale price
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *RoyaltyInfo) Reset()         { *m = RoyaltyInfo{} }
+func (m *RoyaltyInfo) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyInfo) ProtoMessage()    {}
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0e8630df3683b03, []int{2}
+}
+func (m *RoyaltyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyInfo.Merge(m, src)
+}
+func (m *RoyaltyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyInfo proto.InternalMessageInfo
+
 // Denom defines a type of NFT
 type Denom struct {
 	ID               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -112,13 +158,15 @@ type Denom struct {
 	Symbol           string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	MintRestricted   bool   `protobuf:"varint,6,opt,name=mint_restricted,json=mintRestricted,proto3" json:"mint_restricted,omitempty"`
 	UpdateRestricted bool   `protobuf:"varint,7,opt,name=update_restricted,json=updateRestricted,proto3" json:"update_restricted,omitempty"`
+	// royalty of the nfts of the denom
+	Royalty *RoyaltyInfo `protobuf:"bytes,8,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
 func (m *Denom) String() string { return proto.CompactTextString(m) }
 func (*Denom) ProtoMessage()    {}
 func (*Denom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0e8630df3683b03, []int{2}
+	return fileDescriptor_c0e8630df3683b03, []int{3}
 }
 func (m *Denom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,13 +200,15 @@ type DenomMetadata struct {
 	Schema           string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	MintRestricted   bool   `protobuf:"varint,3,opt,name=mint_restricted,json=mintRestricted,proto3" json:"mint_restricted,omitempty"`
 	UpdateRestricted bool   `protobuf:"varint,4,opt,name=update_restricted,json=updateRestricted,proto3" json:"update_restricted,omitempty"`
+	// royalty of the nfts of the denom
+	Royalty *RoyaltyInfo `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *DenomMetadata) Reset()         { *m = DenomMetadata{} }
 func (m *DenomMetadata) String() string { return proto.CompactTextString(m) }
 func (*DenomMetadata) ProtoMessage()    {}
 func (*DenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0e8630df3683b03, []int{3}
+	return fileDescriptor_c0e8630df3683b03, []int{4}
 }
 func (m *DenomMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,7 +247,7 @@ func (m *IDCollection) Reset()         { *m = IDCollection{} }
 func (m *IDCollection) String() string { return proto.CompactTextString(m) }
 func (*IDCollection) ProtoMessage()    {}
 func (*IDCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0e8630df3683b03, []int{4}
+	return fileDescriptor_c0e8630df3683b03, []int{5}
 }
 func (m *IDCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,7 +286,7 @@ func (m *Owner) Reset()         { *m = Owner{} }
 func (m *Owner) String() string { return proto.CompactTextString(m) }
 func (*Owner) ProtoMessage()    {}
 func (*Owner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0e8630df3683b03, []int{5}
+	return fileDescriptor_c0e8630df3683b03, []int{6}
 }
 func (m *Owner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +325,7 @@ func (m *Collection) Reset()         { *m = Collection{} }
 func (m *Collection) String() string { return proto.CompactTextString(m) }
 func (*Collection) ProtoMessage()    {}
 func (*Collection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0e8630df3683b03, []int{6}
+	return fileDescriptor_c0e8630df3683b03, []int{7}
 }
 func (m *Collection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,6 +357,7 @@ var xxx_messageInfo_Collection proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*BaseNFT)(nil), "uptick.collection.v1.BaseNFT")
 	proto.RegisterType((*NFTMetadata)(nil), "uptick.collection.v1.NFTMetadata")
+	proto.RegisterType((*RoyaltyInfo)(nil), "uptick.collection.v1.RoyaltyInfo")
 	proto.RegisterType((*Denom)(nil), "uptick.collection.v1.Denom")
 	proto.RegisterType((*DenomMetadata)(nil), "uptick.collection.v1.DenomMetadata")
 	proto.RegisterType((*IDCollection)(nil), "uptick.collection.v1.IDCollection")
@@ -319,46 +370,51 @@ func init() {
 }
 
 var fileDescriptor_c0e8630df3683b03 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xc9, 0xe6, 0x4f, 0x27, 0xfd, 0xf7, 0x1b, 0x4a, 0xd9, 0x9f, 0xe2, 0x6e, 0x58,
-	0x14, 0x0b, 0x42, 0x42, 0xeb, 0x41, 0x2c, 0x88, 0xb0, 0x86, 0xc2, 0x1e, 0x8c, 0xb2, 0xa4, 0x17,
-	0x2f, 0x61, 0xbb, 0x33, 0xb6, 0x43, 0xb3, 0x3b, 0x61, 0x67, 0xd2, 0xda, 0x9b, 0x2f, 0x40, 0xc4,
-	0xa3, 0x17, 0xc1, 0x97, 0xd3, 0x63, 0x8f, 0x7a, 0x59, 0x74, 0x73, 0xf1, 0xdc, 0x57, 0x20, 0xf3,
-	0x27, 0xe9, 0x8a, 0x41, 0xf4, 0xf6, 0x3c, 0xcf, 0x7c, 0x9f, 0x3c, 0x9f, 0xef, 0x93, 0x9d, 0x81,
-	0xf7, 0xa6, 0x13, 0x41, 0xe3, 0xd3, 0x5e, 0xcc, 0xc6, 0x63, 0x12, 0x0b, 0xca, 0xd2, 0xde, 0xd9,
-	0x6e, 0x29, 0xeb, 0x4e, 0x32, 0x26, 0x18, 0xda, 0xd2, 0xb2, 0x6e, 0xe9, 0xe0, 0x6c, 0xf7, 0xd6,
-	0xd6, 0x31, 0x3b, 0x66, 0x4a, 0xd0, 0x93, 0x91, 0xd6, 0x7a, 0x6f, 0x01, 0x6c, 0xfa, 0x11, 0x27,
-	0x83, 0x83, 0x21, 0xda, 0x86, 0x55, 0x8a, 0x6d, 0xd0, 0x01, 0x3b, 0x2b, 0x7e, 0xa3, 0xc8, 0xdd,
-	0x6a, 0xd0, 0x0f, 0xab, 0x14, 0x23, 0x04, 0xad, 0x34, 0x4a, 0x88, 0x5d, 0x95, 0x27, 0xa1, 0x8a,
-	0xd1, 0xff, 0xb0, 0x36, 0xcd, 0xa8, 0x5d, 0x53, 0xe2, 0x66, 0x91, 0xbb, 0xb5, 0xc3, 0x30, 0x08,
-	0x65, 0x4d, 0xca, 0x71, 0x24, 0x22, 0xdb, 0xd2, 0x72, 0x19, 0xa3, 0x2d, 0x58, 0x67, 0xe7, 0x29,
-	0xc9, 0xec, 0xba, 0x2a, 0xea, 0x64, 0xdf, 0xfa, 0xf1, 0xd9, 0x05, 0x5e, 0x00, 0xdb, 0x83, 0x83,
-	0xe1, 0x73, 0x22, 0x22, 0x25, 0x9d, 0x4f, 0x03, 0xa5, 0x69, 0x1d, 0xd8, 0xc6, 0x84, 0xc7, 0x19,
-	0x9d, 0x48, 0x37, 0x06, 0xa4, 0x5c, 0x32, 0x3f, 0xf5, 0x15, 0xc0, 0x7a, 0x9f, 0xa4, 0x2c, 0xf9,
-	0x27, 0x2f, 0xdb, 0xb0, 0xc1, 0xe3, 0x13, 0x92, 0x44, 0xda, 0x4e, 0x68, 0x32, 0x64, 0xc3, 0x66,
-	0x9c, 0x91, 0x48, 0xb0, 0xcc, 0x78, 0x99, 0xa7, 0xaa, 0xe3, 0x22, 0x39, 0x62, 0x63, 0xe3, 0xc7,
-	0x64, 0xe8, 0x3e, 0xdc, 0x48, 0x68, 0x2a, 0x46, 0x19, 0xe1, 0x22, 0xa3, 0xb1, 0x20, 0xd8, 0x6e,
-	0x74, 0xc0, 0x4e, 0x2b, 0x5c, 0x97, 0xe5, 0x70, 0x51, 0x45, 0x0f, 0xe0, 0x7f, 0xd3, 0x09, 0x8e,
-	0x04, 0x29, 0x4b, 0x9b, 0x4a, 0xba, 0xa9, 0x0f, 0x6e, 0xc4, 0xc6, 0xdb, 0x27, 0x00, 0xd7, 0x94,
-	0xb7, 0xc5, 0xa6, 0x4a, 0x7c, 0xe0, 0x77, 0x3e, 0xed, 0xa8, 0xfa, 0x8b, 0xa3, 0x25, 0x7c, 0xb5,
-	0xbf, 0xe7, 0xb3, 0xfe, 0xc8, 0xf7, 0x1e, 0xc0, 0xd5, 0xa0, 0xff, 0x6c, 0xf1, 0xcd, 0xa1, 0xc7,
-	0xb0, 0x85, 0x25, 0xef, 0x68, 0xf1, 0x47, 0x38, 0x45, 0xee, 0x36, 0x95, 0x87, 0xa0, 0x7f, 0x9d,
-	0xbb, 0x1b, 0x17, 0x51, 0x32, 0xde, 0xf7, 0xe6, 0x22, 0x2f, 0x6c, 0xaa, 0x30, 0xc0, 0xe8, 0x09,
-	0x5c, 0x11, 0xec, 0x94, 0xa4, 0x23, 0x8a, 0xb9, 0x5d, 0xed, 0xd4, 0x76, 0x56, 0xfc, 0x4e, 0x91,
-	0xbb, 0xad, 0xa1, 0x2c, 0x06, 0x7d, 0x7e, 0x9d, 0xbb, 0x9b, 0xba, 0x79, 0x21, 0xf3, 0xc2, 0x96,
-	0x8a, 0x03, 0xcc, 0x0d, 0xd0, 0x47, 0x00, 0xeb, 0x2f, 0xe4, 0x77, 0x26, 0x17, 0x15, 0x61, 0x9c,
-	0x11, 0xce, 0xe7, 0x8b, 0x32, 0x29, 0x62, 0x70, 0x9d, 0xe2, 0xd1, 0xcd, 0x45, 0xd1, 0xd3, 0xda,
-	0x7b, 0x5e, 0x77, 0xd9, 0x1d, 0xea, 0x96, 0xfd, 0xf9, 0x77, 0x2f, 0x73, 0xb7, 0x52, 0xe4, 0xee,
-	0x5a, 0xb9, 0x2a, 0xd1, 0xda, 0x1a, 0x8d, 0xe2, 0x98, 0x7b, 0xe1, 0x1a, 0xc5, 0xa5, 0x53, 0x83,
-	0xf6, 0x0e, 0x40, 0x58, 0xda, 0xd4, 0x23, 0x58, 0x57, 0xce, 0x15, 0x5d, 0x7b, 0xef, 0xf6, 0xf2,
-	0xe1, 0x6a, 0x71, 0xbe, 0x25, 0xa7, 0x86, 0x5a, 0x8f, 0x9e, 0x42, 0x2b, 0x7d, 0x2d, 0xe6, 0xd0,
-	0x77, 0x96, 0xf7, 0x99, 0xeb, 0xed, 0xaf, 0x1a, 0x5e, 0x6b, 0x70, 0x30, 0xe4, 0xa1, 0x6a, 0xd4,
-	0x38, 0xfe, 0xcb, 0xcb, 0xef, 0x4e, 0xe5, 0xb2, 0x70, 0xc0, 0x55, 0xe1, 0x80, 0x6f, 0x85, 0x03,
-	0x3e, 0xcc, 0x9c, 0xca, 0xd5, 0xcc, 0xa9, 0x7c, 0x99, 0x39, 0x95, 0x57, 0x7b, 0xc7, 0x54, 0x9c,
-	0x4c, 0x8f, 0xba, 0x31, 0x4b, 0x7a, 0x87, 0x6a, 0xc0, 0x80, 0x88, 0x73, 0x96, 0x9d, 0xf6, 0xcc,
-	0x73, 0xf4, 0xa6, 0xfc, 0x20, 0x89, 0x8b, 0x09, 0xe1, 0x47, 0x0d, 0xf5, 0xba, 0x3c, 0xfc, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0x82, 0xc7, 0xc1, 0xef, 0xb2, 0x04, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0xe6, 0xcb, 0xe9, 0xb8, 0x69, 0xcb, 0xaa, 0xaa, 0x4c, 0x11, 0x76, 0x1a, 0x81, 0xa8,
+	0x84, 0x94, 0xa8, 0xe1, 0x80, 0x28, 0x42, 0x48, 0x21, 0xaa, 0xe4, 0x03, 0xa1, 0x5a, 0xa5, 0x17,
+	0x2e, 0x91, 0xe3, 0xdd, 0xb6, 0xab, 0x26, 0xde, 0xc8, 0xde, 0xb4, 0xe4, 0x0f, 0x70, 0x42, 0x88,
+	0x13, 0xe2, 0xc8, 0x1f, 0xe1, 0xde, 0x63, 0x8f, 0x88, 0x83, 0x05, 0xee, 0x85, 0x73, 0x7f, 0x01,
+	0xf2, 0xda, 0x49, 0x8d, 0x88, 0x04, 0xf4, 0xb6, 0xf3, 0xe6, 0x8d, 0xe7, 0xbd, 0x99, 0xf5, 0xc2,
+	0xfd, 0xc9, 0x58, 0x72, 0xf7, 0xa4, 0xe9, 0x8a, 0xe1, 0x90, 0xb9, 0x92, 0x0b, 0xaf, 0x79, 0xba,
+	0x93, 0x89, 0x1a, 0x63, 0x5f, 0x48, 0x81, 0xd7, 0x13, 0x5a, 0x23, 0x93, 0x38, 0xdd, 0xd9, 0x5c,
+	0x3f, 0x12, 0x47, 0x42, 0x11, 0x9a, 0xf1, 0x29, 0xe1, 0xd6, 0xbf, 0x20, 0xd0, 0xda, 0x4e, 0xc0,
+	0xba, 0x7b, 0x3d, 0xbc, 0x01, 0x79, 0x4e, 0x0d, 0x54, 0x43, 0xdb, 0x4b, 0xed, 0x72, 0x14, 0x5a,
+	0x79, 0xbb, 0x43, 0xf2, 0x9c, 0x62, 0x0c, 0x45, 0xcf, 0x19, 0x31, 0x23, 0x1f, 0x67, 0x88, 0x3a,
+	0xe3, 0xdb, 0x50, 0x98, 0xf8, 0xdc, 0x28, 0x28, 0xb2, 0x16, 0x85, 0x56, 0xe1, 0x80, 0xd8, 0x24,
+	0xc6, 0x62, 0x3a, 0x75, 0xa4, 0x63, 0x14, 0x13, 0x7a, 0x7c, 0xc6, 0xeb, 0x50, 0x12, 0x67, 0x1e,
+	0xf3, 0x8d, 0x92, 0x02, 0x93, 0x00, 0x3f, 0x05, 0xcd, 0x17, 0x53, 0x67, 0x28, 0xa7, 0x46, 0xb9,
+	0x86, 0xb6, 0xf5, 0xd6, 0x56, 0x63, 0x91, 0xf4, 0x06, 0x49, 0x48, 0xb6, 0x77, 0x28, 0xc8, 0xac,
+	0x62, 0xb7, 0xf8, 0xf3, 0xb3, 0x85, 0xea, 0x6f, 0x11, 0xe8, 0xdd, 0xbd, 0xde, 0x4b, 0x26, 0x1d,
+	0xd5, 0x68, 0xa6, 0x15, 0x65, 0xb4, 0xd6, 0x40, 0xa7, 0x2c, 0x70, 0x7d, 0x3e, 0x8e, 0x3f, 0x98,
+	0xda, 0xc8, 0x42, 0x59, 0x21, 0x85, 0x1b, 0x0a, 0x21, 0xa0, 0x67, 0xb2, 0x78, 0x13, 0x2a, 0x3e,
+	0x73, 0x19, 0x3f, 0x65, 0x7e, 0xaa, 0x65, 0x1e, 0xe3, 0x2d, 0x58, 0x1e, 0x38, 0x01, 0x0f, 0xfa,
+	0x63, 0xc1, 0x3d, 0x19, 0x28, 0x41, 0x55, 0xa2, 0x2b, 0x6c, 0x5f, 0x41, 0xe9, 0x37, 0x3f, 0xe6,
+	0xa1, 0xd4, 0x61, 0x9e, 0x18, 0xfd, 0xd7, 0x6a, 0x36, 0xa0, 0x1c, 0xb8, 0xc7, 0x6c, 0xe4, 0x24,
+	0xdb, 0x21, 0x69, 0x84, 0x0d, 0xd0, 0x5c, 0x9f, 0x39, 0x52, 0xf8, 0xe9, 0x6a, 0x66, 0xa1, 0xaa,
+	0x98, 0x8e, 0x06, 0x62, 0x98, 0xae, 0x27, 0x8d, 0xf0, 0x03, 0x58, 0x1d, 0x71, 0x4f, 0xf6, 0x7d,
+	0x16, 0x48, 0x9f, 0xbb, 0x92, 0x51, 0xb5, 0xa7, 0x0a, 0x59, 0x89, 0x61, 0x32, 0x47, 0xf1, 0x43,
+	0xb8, 0x35, 0x19, 0x53, 0x47, 0xb2, 0x2c, 0x55, 0x53, 0xd4, 0xb5, 0x24, 0x91, 0x21, 0x67, 0x86,
+	0x5d, 0xb9, 0xe1, 0xb0, 0xbf, 0x21, 0xa8, 0xaa, 0xc1, 0xcc, 0xf7, 0x9e, 0x31, 0x87, 0xfe, 0x34,
+	0x97, 0x8c, 0x23, 0xff, 0xdb, 0x38, 0x16, 0x98, 0x2b, 0xfc, 0xbb, 0xb9, 0xe2, 0xdf, 0xcd, 0x95,
+	0x6e, 0x68, 0xee, 0x3d, 0x82, 0x65, 0xbb, 0xf3, 0x62, 0x4e, 0xc7, 0x4f, 0xa0, 0x42, 0x63, 0xb3,
+	0xfd, 0xf9, 0x15, 0x30, 0xa3, 0xd0, 0xd2, 0xd4, 0x00, 0xec, 0xce, 0x55, 0x68, 0xad, 0x4e, 0x9d,
+	0xd1, 0x70, 0xb7, 0x3e, 0x23, 0xd5, 0x89, 0xa6, 0x8e, 0x36, 0xc5, 0xcf, 0x60, 0x49, 0x8a, 0x13,
+	0xe6, 0xf5, 0x39, 0x8d, 0xef, 0x59, 0x61, 0x7b, 0xa9, 0x5d, 0x8b, 0x42, 0xab, 0xd2, 0x8b, 0x41,
+	0xbb, 0x13, 0x5c, 0x85, 0xd6, 0x5a, 0x52, 0x3c, 0xa7, 0xd5, 0x49, 0x45, 0x9d, 0x6d, 0x3a, 0xbb,
+	0x86, 0x9f, 0x10, 0x94, 0x5e, 0xa9, 0x1f, 0xd6, 0x00, 0xcd, 0xa1, 0xd4, 0x67, 0x41, 0x30, 0x9b,
+	0x72, 0x1a, 0x62, 0x01, 0x2b, 0x9c, 0xf6, 0xaf, 0x3d, 0x26, 0xdd, 0xf4, 0x56, 0x7d, 0xb1, 0xfd,
+	0xac, 0xbf, 0xf6, 0xbd, 0xf3, 0xd0, 0xca, 0x45, 0xa1, 0x55, 0xcd, 0xa2, 0xb1, 0x34, 0x3d, 0x91,
+	0xc6, 0xa9, 0x1b, 0xd4, 0x49, 0x95, 0xd3, 0x4c, 0x36, 0x95, 0xf6, 0x0e, 0x01, 0x5c, 0xa3, 0xf8,
+	0x31, 0x94, 0x94, 0x73, 0xa5, 0x4e, 0x6f, 0xdd, 0x59, 0xdc, 0x5c, 0x0d, 0xae, 0x5d, 0x8c, 0xbb,
+	0x92, 0x84, 0x8f, 0x9f, 0x43, 0xd1, 0x3b, 0x94, 0x33, 0xd1, 0x77, 0x17, 0xd7, 0xa5, 0xef, 0x64,
+	0x7b, 0x39, 0xd5, 0x5b, 0xec, 0xee, 0xf5, 0x02, 0xa2, 0x0a, 0x13, 0x39, 0xed, 0xfd, 0xf3, 0x1f,
+	0x66, 0xee, 0x3c, 0x32, 0xd1, 0x45, 0x64, 0xa2, 0xef, 0x91, 0x89, 0x3e, 0x5c, 0x9a, 0xb9, 0x8b,
+	0x4b, 0x33, 0xf7, 0xf5, 0xd2, 0xcc, 0xbd, 0x6e, 0x1d, 0x71, 0x79, 0x3c, 0x19, 0x34, 0x5c, 0x31,
+	0x6a, 0x1e, 0xa8, 0x06, 0x5d, 0x26, 0xcf, 0x84, 0x7f, 0xd2, 0x4c, 0xdf, 0xf5, 0x37, 0xd9, 0x97,
+	0x5d, 0x4e, 0xc7, 0x2c, 0x18, 0x94, 0xd5, 0x33, 0xfd, 0xe8, 0xd7, 0x00, 0x4a, 0x32, 0x95, 0xc5,
+	0xfb, 0x05, 0x00, 0x00,
 }
 
 func (this *BaseNFT) Equal(that interface{}) bool {
@@ -395,6 +451,9 @@ func (this *BaseNFT) Equal(that interface{}) bool {
 	if this.Owner != that1.Owner {
 		return false
 	}
+	if !this.Royalty.Equal(that1.Royalty) {
+		return false
+	}
 	return true
 }
 func (this *NFTMetadata) Equal(that interface{}) bool {
@@ -422,6 +481,36 @@ func (this *NFTMetadata) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
+	if !this.Royalty.Equal(that1.Royalty) {
+		return false
+	}
+	return true
+}
+func (this *RoyaltyInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoyaltyInfo)
+	if !ok {
+		that2, ok := that.(RoyaltyInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.BasisPoints != that1.BasisPoints {
+		return false
+	}
 	return true
 }
 func (this *Denom) Equal(that interface{}) bool {
@@ -464,6 +553,9 @@ func (this *Denom) Equal(that interface{}) bool {
 	if this.UpdateRestricted != that1.UpdateRestricted {
 		return false
 	}
+	if !this.Royalty.Equal(that1.Royalty) {
+		return false
+	}
 	return true
 }
 func (this *DenomMetadata) Equal(that interface{}) bool {
@@ -497,6 +589,9 @@ func (this *DenomMetadata) Equal(that interface{}) bool {
 	if this.UpdateRestricted != that1.UpdateRestricted {
 		return false
 	}
+	if !this.Royalty.Equal(that1.Royalty) {
+		return false
+	}
 	return true
 }
 func (this *IDCollection) Equal(that interface{}) bool {
@@ -615,6 +710,18 @@ func (m *BaseNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -673,6 +780,18 @@ func (m *NFTMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -690,6 +809,41 @@ func (m *NFTMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoyaltyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Denom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -710,6 +864,18 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.UpdateRestricted {
 		i--
 		if m.UpdateRestricted {
@@ -788,6 +954,18 @@ func (m *DenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.UpdateRestricted {
 		i--
 		if m.UpdateRestricted {
@@ -992,6 +1170,10 @@ func (m *BaseNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
 	return n
 }
 
@@ -1009,6 +1191,26 @@ func (m *NFTMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	return n
+}
+
+func (m *RoyaltyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovCollection(uint64(m.BasisPoints))
+	}
 	return n
 }
 
@@ -1044,6 +1246,10 @@ func (m *Denom) Size() (n int) {
 	if m.UpdateRestricted {
 		n += 2
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
 	return n
 }
 
@@ -1067,6 +1273,10 @@ func (m *DenomMetadata) Size() (n int) {
 	if m.UpdateRestricted {
 		n += 2
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
 	return n
 }
 
@@ -1320,6 +1530,42 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -1434,6 +1680,143 @@ func (m *NFTMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -1684,6 +2067,42 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UpdateRestricted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -1838,6 +2257,42 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UpdateRestricted = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
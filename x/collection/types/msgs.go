@@ -54,6 +54,11 @@ func (msg MsgIssueDenom) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
+	if msg.Royalty != nil {
+		if err := msg.Royalty.Validate(); err != nil {
+			return err
+		}
+	}
 	return ValidateKeywords(msg.ID)
 }
 
@@ -177,6 +182,11 @@ func (msg MsgMintNFT) ValidateBasic() error {
 	if err := ValidateTokenURI(msg.URI); err != nil {
 		return err
 	}
+	if msg.Royalty != nil {
+		if err := msg.Royalty.Validate(); err != nil {
+			return err
+		}
+	}
 	return ValidateTokenID(msg.ID)
 }
 
@@ -639,6 +639,123 @@ func (m *QueryNFTResponse) GetNFT() *BaseNFT {
 	return nil
 }
 
+// QueryRoyaltyRequest is the request type for the Query/Royalty RPC method
+type QueryRoyaltyRequest struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	// token_id of the nft, the royalty of the denom is returned when omitted
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty" yaml:"token_id"`
+	// sale_price to compute the royalty amount of, optional
+	SalePrice string `protobuf:"bytes,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty" yaml:"sale_price"`
+}
+
+func (m *QueryRoyaltyRequest) Reset()         { *m = QueryRoyaltyRequest{} }
+func (m *QueryRoyaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyRequest) ProtoMessage()    {}
+func (*QueryRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3befd7ee659d0ea, []int{12}
+}
+func (m *QueryRoyaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyRequest) GetDenomId() string {
+	if m != nil {
+		return m.DenomId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyRequest) GetSalePrice() string {
+	if m != nil {
+		return m.SalePrice
+	}
+	return ""
+}
+
+// QueryRoyaltyResponse is the response type for the Query/Royalty RPC method
+type QueryRoyaltyResponse struct {
+	Royalty RoyaltyInfo `protobuf:"bytes,1,opt,name=royalty,proto3" json:"royalty"`
+	// royalty_amount of the sale price, empty without sale price
+	RoyaltyAmount string `protobuf:"bytes,2,opt,name=royalty_amount,json=royaltyAmount,proto3" json:"royalty_amount,omitempty"`
+}
+
+func (m *QueryRoyaltyResponse) Reset()         { *m = QueryRoyaltyResponse{} }
+func (m *QueryRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyResponse) ProtoMessage()    {}
+func (*QueryRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3befd7ee659d0ea, []int{13}
+}
+func (m *QueryRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyResponse) GetRoyalty() RoyaltyInfo {
+	if m != nil {
+		return m.Royalty
+	}
+	return RoyaltyInfo{}
+}
+
+func (m *QueryRoyaltyResponse) GetRoyaltyAmount() string {
+	if m != nil {
+		return m.RoyaltyAmount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QuerySupplyRequest)(nil), "uptick.collection.v1.QuerySupplyRequest")
 	proto.RegisterType((*QuerySupplyResponse)(nil), "uptick.collection.v1.QuerySupplyResponse")
@@ -652,62 +769,72 @@ func init() {
 	proto.RegisterType((*QueryDenomsResponse)(nil), "uptick.collection.v1.QueryDenomsResponse")
 	proto.RegisterType((*QueryNFTRequest)(nil), "uptick.collection.v1.QueryNFTRequest")
 	proto.RegisterType((*QueryNFTResponse)(nil), "uptick.collection.v1.QueryNFTResponse")
+	proto.RegisterType((*QueryRoyaltyRequest)(nil), "uptick.collection.v1.QueryRoyaltyRequest")
+	proto.RegisterType((*QueryRoyaltyResponse)(nil), "uptick.collection.v1.QueryRoyaltyResponse")
 }
 
 func init() { proto.RegisterFile("uptick/collection/v1/query.proto", fileDescriptor_c3befd7ee659d0ea) }
 
 var fileDescriptor_c3befd7ee659d0ea = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x51, 0x4b, 0x1b, 0x4b,
-	0x14, 0xc7, 0x33, 0x89, 0x46, 0xef, 0x78, 0x41, 0xef, 0x28, 0x9a, 0x9b, 0x7b, 0x4d, 0xc2, 0x82,
-	0x1a, 0x6d, 0xb3, 0x63, 0x52, 0x0a, 0xb6, 0x4f, 0x25, 0x96, 0x88, 0x20, 0xda, 0x6e, 0xed, 0x8b,
-	0x14, 0xca, 0x26, 0x19, 0xb7, 0xc1, 0x64, 0x67, 0xcd, 0x6c, 0xb4, 0x41, 0x7c, 0x29, 0x7d, 0x6b,
-	0x0b, 0x42, 0xa1, 0xa5, 0xd0, 0xc7, 0x7e, 0x89, 0x7e, 0x03, 0x1f, 0x85, 0xbe, 0xf4, 0x29, 0x94,
-	0xd8, 0x4f, 0x20, 0xfd, 0x00, 0x65, 0x67, 0x66, 0xbb, 0x9b, 0x66, 0x9b, 0x84, 0xe0, 0xdb, 0x2c,
-	0xfb, 0x3f, 0xe7, 0xfc, 0xf6, 0x3f, 0xe7, 0x9c, 0x04, 0xa6, 0x1a, 0x96, 0x5d, 0x29, 0x1d, 0xe0,
-	0x12, 0xad, 0x56, 0x49, 0xc9, 0xae, 0x50, 0x13, 0x1f, 0x65, 0xf1, 0x61, 0x83, 0xd4, 0x9b, 0xaa,
-	0x55, 0xa7, 0x36, 0x45, 0x33, 0x42, 0xa1, 0x7a, 0x0a, 0xf5, 0x28, 0x1b, 0x9f, 0x31, 0xa8, 0x41,
-	0xb9, 0x00, 0x3b, 0x27, 0xa1, 0x8d, 0xff, 0x6f, 0x50, 0x6a, 0x54, 0x09, 0xd6, 0xad, 0x0a, 0xd6,
-	0x4d, 0x93, 0xda, 0xba, 0xa3, 0x67, 0xf2, 0xed, 0x42, 0x60, 0x2d, 0x5f, 0x5e, 0x21, 0x5b, 0x29,
-	0x51, 0x56, 0xa3, 0x0c, 0x17, 0x75, 0x46, 0x04, 0x09, 0x3e, 0xca, 0x16, 0x89, 0xad, 0x67, 0xb1,
-	0xa5, 0x1b, 0x15, 0x53, 0xf7, 0xb4, 0xca, 0x1e, 0x44, 0x0f, 0x1d, 0xc5, 0xa3, 0x86, 0x65, 0x55,
-	0x9b, 0x1a, 0x39, 0x6c, 0x10, 0x66, 0x23, 0x15, 0x8e, 0x97, 0x89, 0x49, 0x6b, 0x4f, 0x2b, 0xe5,
-	0x18, 0x48, 0x81, 0xf4, 0x5f, 0xf9, 0xe9, 0xab, 0x56, 0x72, 0xb2, 0xa9, 0xd7, 0xaa, 0x77, 0x15,
-	0xf7, 0x8d, 0xa2, 0x8d, 0xf1, 0xe3, 0x66, 0x19, 0xcd, 0xc0, 0x51, 0x7a, 0x6c, 0x92, 0x7a, 0x2c,
-	0xec, 0x88, 0x35, 0xf1, 0xa0, 0x64, 0xe0, 0x74, 0x47, 0x6e, 0x66, 0x51, 0x93, 0x11, 0x34, 0x0b,
-	0xa3, 0x7a, 0x8d, 0x36, 0x4c, 0x9b, 0xa7, 0x1e, 0xd1, 0xe4, 0x93, 0xf2, 0x19, 0xc0, 0x39, 0xae,
-	0xdf, 0x2e, 0xec, 0xb2, 0x9d, 0xfd, 0x1d, 0x27, 0xc7, 0xb0, 0x40, 0x8b, 0x1d, 0x40, 0xf9, 0xa9,
-	0xab, 0x56, 0xf2, 0x6f, 0x21, 0x16, 0x68, 0x12, 0x11, 0x15, 0x20, 0xf4, 0x2c, 0x89, 0x45, 0x52,
-	0x20, 0x3d, 0x91, 0x5b, 0x54, 0x85, 0x7f, 0xaa, 0xe3, 0x9f, 0x2a, 0x6e, 0x52, 0xfa, 0xa7, 0x3e,
-	0xd0, 0x0d, 0x22, 0x99, 0x34, 0x5f, 0xa4, 0xf2, 0x0e, 0xc0, 0x58, 0x37, 0xbb, 0xfc, 0xe0, 0xac,
-	0x0b, 0x03, 0x78, 0xfe, 0xff, 0xd4, 0xa0, 0x86, 0x50, 0x45, 0x8c, 0xe4, 0xda, 0xe8, 0xe0, 0x0a,
-	0xf3, 0xb8, 0xa5, 0xbe, 0x5c, 0xa2, 0x5e, 0x07, 0xd8, 0x19, 0x80, 0xb3, 0x1c, 0x6c, 0xfd, 0x57,
-	0xb1, 0x61, 0x3d, 0x2d, 0x04, 0x30, 0x0d, 0xe3, 0xd5, 0x27, 0xf7, 0x9e, 0xfd, 0x48, 0xd2, 0xaa,
-	0x7b, 0x10, 0x7a, 0xae, 0x48, 0xbf, 0x52, 0xc1, 0x7e, 0xf9, 0xa2, 0x7d, 0x31, 0xd7, 0xe7, 0xdc,
-	0x3a, 0xfc, 0x87, 0x53, 0xde, 0x77, 0x3e, 0x7f, 0x48, 0xcf, 0x94, 0x0d, 0x39, 0x5e, 0x32, 0x89,
-	0xd7, 0x10, 0x5c, 0xd0, 0xbb, 0x21, 0x44, 0x8c, 0x50, 0x2a, 0x4f, 0xfc, 0x89, 0x98, 0x8b, 0xd3,
-	0x79, 0x25, 0x60, 0xe8, 0x2b, 0xf9, 0x00, 0xe4, 0xa8, 0xba, 0xe9, 0x25, 0xe8, 0x1d, 0x18, 0xe5,
-	0xe5, 0x59, 0x0c, 0xa4, 0x22, 0x7d, 0x48, 0xf3, 0x23, 0xe7, 0xad, 0x64, 0x48, 0x93, 0x01, 0xd7,
-	0x77, 0x0f, 0x87, 0x70, 0xd2, 0x9d, 0xac, 0x61, 0x3b, 0x57, 0x85, 0xe3, 0x36, 0x3d, 0x20, 0xa6,
-	0xa3, 0x0f, 0xff, 0xae, 0x77, 0xdf, 0x28, 0xda, 0x18, 0x3f, 0x6e, 0x96, 0x95, 0x2d, 0x38, 0xe5,
-	0x95, 0x94, 0x56, 0xac, 0xc1, 0x88, 0xb9, 0x6f, 0x4b, 0x8f, 0xe7, 0x83, 0x7d, 0xc8, 0xeb, 0x8c,
-	0x6c, 0x17, 0x76, 0xf3, 0x63, 0xed, 0x56, 0x32, 0xe2, 0x04, 0x3b, 0x21, 0xb9, 0x1f, 0x51, 0x38,
-	0xca, 0xd3, 0xa1, 0xf7, 0x00, 0x46, 0xc5, 0x32, 0x44, 0xe9, 0xe0, 0x0c, 0xdd, 0xbb, 0x38, 0xbe,
-	0x3c, 0x80, 0x52, 0x30, 0x2a, 0x6b, 0x2f, 0xbe, 0x7c, 0x7f, 0x1b, 0xce, 0xa1, 0x55, 0xdc, 0xfd,
-	0x43, 0xe1, 0x1d, 0x19, 0x3e, 0x71, 0x3d, 0x3a, 0xc5, 0x4c, 0xe0, 0xbc, 0x01, 0x70, 0xc2, 0xb7,
-	0xba, 0x50, 0xa6, 0x47, 0xd1, 0xee, 0xf5, 0x1c, 0x57, 0x07, 0x95, 0x4b, 0xd0, 0x24, 0x07, 0xfd,
-	0x17, 0xcd, 0x05, 0x80, 0x9a, 0xfb, 0x36, 0x43, 0x1f, 0x01, 0x84, 0xde, 0x80, 0xa3, 0x9b, 0x3d,
-	0xf2, 0x77, 0x2d, 0xb6, 0x78, 0x66, 0x40, 0xb5, 0x84, 0xc9, 0x72, 0x98, 0x1b, 0x68, 0x79, 0x60,
-	0xd7, 0xd0, 0x6b, 0x00, 0x47, 0x79, 0xd3, 0xa3, 0xa5, 0x1e, 0xb5, 0xfc, 0x9b, 0x23, 0x9e, 0xee,
-	0x2f, 0x94, 0x3c, 0xab, 0x9c, 0x67, 0x05, 0xa5, 0x83, 0xcd, 0xc1, 0x62, 0xc0, 0xfc, 0x38, 0x2f,
-	0x01, 0x8c, 0x8a, 0xc9, 0x45, 0x7d, 0xcb, 0xb0, 0x41, 0xfa, 0xaa, 0x73, 0x0d, 0x28, 0x0b, 0x9c,
-	0x28, 0x89, 0xe6, 0x7b, 0x12, 0xa1, 0x57, 0x00, 0x3a, 0x5d, 0x8f, 0x16, 0x7a, 0x77, 0x83, 0x0b,
-	0xb0, 0xd8, 0x4f, 0x26, 0xab, 0xdf, 0xe6, 0xd5, 0x31, 0xca, 0xfc, 0xa1, 0x59, 0xfc, 0xed, 0x7c,
-	0xe2, 0x4e, 0xf3, 0x69, 0x7e, 0xeb, 0xbc, 0x9d, 0x00, 0x17, 0xed, 0x04, 0xf8, 0xd6, 0x4e, 0x80,
-	0xb3, 0xcb, 0x44, 0xe8, 0xe2, 0x32, 0x11, 0xfa, 0x7a, 0x99, 0x08, 0xed, 0xe5, 0x8c, 0x8a, 0xfd,
-	0xac, 0x51, 0x54, 0x4b, 0xb4, 0x86, 0x1f, 0xf3, 0x94, 0xdb, 0xc4, 0x3e, 0xa6, 0xf5, 0x03, 0xb7,
-	0xc0, 0x73, 0x7f, 0x09, 0xbb, 0x69, 0x11, 0x56, 0x8c, 0xf2, 0xbf, 0x4b, 0xb7, 0x7e, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x7f, 0x47, 0x25, 0xe2, 0xef, 0x09, 0x00, 0x00,
+	// 913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x51, 0x6f, 0x2a, 0x45,
+	0x14, 0xc7, 0x19, 0xb8, 0x85, 0xf6, 0x5c, 0xf5, 0xde, 0x3b, 0xc5, 0x7b, 0x11, 0xbd, 0x80, 0x9b,
+	0xd0, 0x72, 0xab, 0xec, 0x5e, 0x50, 0x93, 0xea, 0x93, 0xa5, 0x86, 0xa6, 0x49, 0xd3, 0xd6, 0xb5,
+	0xbe, 0x34, 0x26, 0xcd, 0x02, 0x03, 0x92, 0xc2, 0xce, 0x96, 0x59, 0x5a, 0x49, 0xd3, 0xc4, 0x18,
+	0xe3, 0x8b, 0x1a, 0x9b, 0x98, 0x68, 0x4c, 0x7c, 0xf4, 0xcd, 0x4f, 0xe0, 0x37, 0xe8, 0x63, 0x13,
+	0x5f, 0x7c, 0x22, 0x86, 0xfa, 0x09, 0xfa, 0x09, 0xcc, 0xce, 0xcc, 0xba, 0xbb, 0xb2, 0x02, 0x21,
+	0x8d, 0x6f, 0xb3, 0xbb, 0xff, 0x73, 0xce, 0x6f, 0xfe, 0x73, 0x38, 0x03, 0xe4, 0xfa, 0x96, 0xdd,
+	0xae, 0x1f, 0x6b, 0x75, 0xda, 0xe9, 0x90, 0xba, 0xdd, 0xa6, 0xa6, 0x76, 0x5a, 0xd2, 0x4e, 0xfa,
+	0xa4, 0x37, 0x50, 0xad, 0x1e, 0xb5, 0x29, 0x4e, 0x0a, 0x85, 0xea, 0x29, 0xd4, 0xd3, 0x52, 0x3a,
+	0xd9, 0xa2, 0x2d, 0xca, 0x05, 0x9a, 0xb3, 0x12, 0xda, 0xf4, 0x6b, 0x2d, 0x4a, 0x5b, 0x1d, 0xa2,
+	0x19, 0x56, 0x5b, 0x33, 0x4c, 0x93, 0xda, 0x86, 0xa3, 0x67, 0xf2, 0x6b, 0x3e, 0xb4, 0x96, 0x2f,
+	0xaf, 0x90, 0xad, 0xd5, 0x29, 0xeb, 0x52, 0xa6, 0xd5, 0x0c, 0x46, 0x04, 0x89, 0x76, 0x5a, 0xaa,
+	0x11, 0xdb, 0x28, 0x69, 0x96, 0xd1, 0x6a, 0x9b, 0x86, 0xa7, 0x55, 0x0e, 0x01, 0x7f, 0xe8, 0x28,
+	0x3e, 0xea, 0x5b, 0x56, 0x67, 0xa0, 0x93, 0x93, 0x3e, 0x61, 0x36, 0x56, 0x61, 0xb1, 0x41, 0x4c,
+	0xda, 0x3d, 0x6a, 0x37, 0x52, 0x28, 0x87, 0x0a, 0x4b, 0x95, 0xe5, 0xdb, 0x61, 0xf6, 0xc1, 0xc0,
+	0xe8, 0x76, 0xde, 0x53, 0xdc, 0x2f, 0x8a, 0x9e, 0xe0, 0xcb, 0xed, 0x06, 0x4e, 0xc2, 0x02, 0x3d,
+	0x33, 0x49, 0x2f, 0x15, 0x75, 0xc4, 0xba, 0x78, 0x50, 0x8a, 0xb0, 0x1c, 0xc8, 0xcd, 0x2c, 0x6a,
+	0x32, 0x82, 0x1f, 0x43, 0xdc, 0xe8, 0xd2, 0xbe, 0x69, 0xf3, 0xd4, 0xf7, 0x74, 0xf9, 0xa4, 0xfc,
+	0x86, 0xe0, 0x09, 0xd7, 0xef, 0x56, 0x0f, 0xd8, 0x5e, 0x73, 0xcf, 0xc9, 0x31, 0x2f, 0xd0, 0x4a,
+	0x00, 0xa8, 0xf2, 0xf0, 0x76, 0x98, 0x7d, 0x41, 0x88, 0x05, 0x9a, 0x44, 0xc4, 0x55, 0x00, 0xcf,
+	0x92, 0x54, 0x2c, 0x87, 0x0a, 0xf7, 0xcb, 0x2b, 0xaa, 0xf0, 0x4f, 0x75, 0xfc, 0x53, 0xc5, 0x49,
+	0x4a, 0xff, 0xd4, 0x7d, 0xa3, 0x45, 0x24, 0x93, 0xee, 0x8b, 0x54, 0x7e, 0x40, 0x90, 0x1a, 0x67,
+	0x97, 0x1b, 0x2e, 0xb9, 0x30, 0x88, 0xe7, 0x7f, 0x55, 0x0d, 0x6b, 0x08, 0x55, 0xc4, 0x48, 0xae,
+	0xad, 0x00, 0x57, 0x94, 0xc7, 0xad, 0x4e, 0xe5, 0x12, 0xf5, 0x02, 0x60, 0x97, 0x08, 0x1e, 0x73,
+	0xb0, 0xcd, 0x7f, 0x8a, 0xcd, 0xeb, 0x69, 0x35, 0x84, 0x69, 0x1e, 0xaf, 0x7e, 0x71, 0xcf, 0xd9,
+	0x8f, 0x24, 0xad, 0x7a, 0x1f, 0xc0, 0x73, 0x45, 0xfa, 0x95, 0x0b, 0xf7, 0xcb, 0x17, 0xed, 0x8b,
+	0xb9, 0x3b, 0xe7, 0x36, 0xe1, 0x11, 0xa7, 0xfc, 0xc0, 0xd9, 0xfe, 0x9c, 0x9e, 0x29, 0x5b, 0x80,
+	0xfd, 0x49, 0xbc, 0x86, 0xe0, 0x82, 0xc9, 0x0d, 0x21, 0x62, 0x84, 0x52, 0xf9, 0xc4, 0x9f, 0x88,
+	0xb9, 0x38, 0xc1, 0x23, 0x41, 0x73, 0x1f, 0xc9, 0x4f, 0x08, 0x96, 0x03, 0xe9, 0x25, 0xe8, 0xbb,
+	0x10, 0xe7, 0xe5, 0x59, 0x0a, 0xe5, 0x62, 0x53, 0x48, 0x2b, 0xf7, 0xae, 0x86, 0xd9, 0x88, 0x2e,
+	0x03, 0xee, 0xee, 0x1c, 0x4e, 0xe0, 0x81, 0xfb, 0xcb, 0x9a, 0xb7, 0x73, 0x55, 0x58, 0xb4, 0xe9,
+	0x31, 0x31, 0x1d, 0x7d, 0xf4, 0xdf, 0x7a, 0xf7, 0x8b, 0xa2, 0x27, 0xf8, 0x72, 0xbb, 0xa1, 0xec,
+	0xc0, 0x43, 0xaf, 0xa4, 0xb4, 0x62, 0x1d, 0x62, 0x66, 0xd3, 0x96, 0x1e, 0x3f, 0x0d, 0xf7, 0xa1,
+	0x62, 0x30, 0xb2, 0x5b, 0x3d, 0xa8, 0x24, 0x46, 0xc3, 0x6c, 0xcc, 0x09, 0x76, 0x42, 0x94, 0x5f,
+	0x5d, 0x73, 0x75, 0x3a, 0x30, 0x3a, 0xf6, 0xe0, 0x7f, 0xda, 0x05, 0x7e, 0x1b, 0x80, 0x19, 0x1d,
+	0x72, 0x64, 0xf5, 0xda, 0x75, 0xc2, 0x67, 0xdb, 0x52, 0xe5, 0xe5, 0xdb, 0x61, 0xf6, 0x91, 0x88,
+	0xf0, 0xbe, 0x29, 0xfa, 0x92, 0xf3, 0xb0, 0xcf, 0xd7, 0x9f, 0x23, 0x48, 0x06, 0x69, 0xa5, 0x01,
+	0x1b, 0x90, 0xe8, 0x89, 0x57, 0xd2, 0x84, 0xd7, 0xc3, 0x4d, 0x90, 0x71, 0xdb, 0x66, 0x93, 0xca,
+	0x96, 0x70, 0xe3, 0x70, 0x1e, 0x5e, 0x92, 0xcb, 0x23, 0x79, 0x03, 0x88, 0xfb, 0xe2, 0x45, 0xf9,
+	0x76, 0x83, 0xbf, 0x2c, 0x7f, 0xb5, 0x08, 0x0b, 0x1c, 0x01, 0xff, 0x88, 0x20, 0x2e, 0x6e, 0x0f,
+	0x5c, 0x08, 0xaf, 0x36, 0x7e, 0x79, 0xa5, 0x9f, 0xcd, 0xa0, 0x14, 0x7b, 0x52, 0xd6, 0xbf, 0xf8,
+	0xfd, 0xaf, 0xef, 0xa3, 0x65, 0xfc, 0x5c, 0x1b, 0xbf, 0x59, 0xbd, 0x25, 0xd3, 0xce, 0xdd, 0xe3,
+	0xb8, 0xd0, 0x98, 0xc0, 0xf9, 0x16, 0xc1, 0x7d, 0xdf, 0xac, 0xc7, 0xc5, 0x09, 0x45, 0xc7, 0xef,
+	0xb3, 0xb4, 0x3a, 0xab, 0x5c, 0x82, 0x66, 0x39, 0xe8, 0x2b, 0xf8, 0x49, 0x08, 0xa8, 0xd9, 0xb4,
+	0x19, 0xfe, 0x19, 0x01, 0x78, 0x13, 0x11, 0xbf, 0x39, 0x21, 0xff, 0xd8, 0x4d, 0x90, 0x2e, 0xce,
+	0xa8, 0x96, 0x30, 0x25, 0x0e, 0xf3, 0x06, 0x7e, 0x36, 0xb3, 0x6b, 0xf8, 0x1b, 0x04, 0x0b, 0x7c,
+	0x4a, 0xe0, 0xd5, 0x09, 0xb5, 0xfc, 0xa3, 0x36, 0x5d, 0x98, 0x2e, 0x94, 0x3c, 0xcf, 0x39, 0xcf,
+	0x1a, 0x2e, 0x84, 0x9b, 0xa3, 0x89, 0x89, 0xe4, 0xc7, 0xf9, 0x12, 0x41, 0x5c, 0x8c, 0x3a, 0x3c,
+	0xb5, 0x0c, 0x9b, 0xa5, 0xaf, 0x82, 0x73, 0x53, 0xc9, 0x73, 0xa2, 0x2c, 0x7e, 0x3a, 0x91, 0x08,
+	0x7f, 0x8d, 0xc0, 0x19, 0x13, 0x38, 0x3f, 0xb9, 0x1b, 0x5c, 0x80, 0x95, 0x69, 0x32, 0x59, 0xfd,
+	0x1d, 0x5e, 0x5d, 0xc3, 0xc5, 0xff, 0x68, 0x16, 0x7f, 0x3b, 0x9f, 0xbb, 0x83, 0xe3, 0x02, 0x7f,
+	0x87, 0x20, 0x21, 0x7f, 0xbc, 0x78, 0xd2, 0x5e, 0x83, 0x63, 0x2c, 0xbd, 0x36, 0x8b, 0x54, 0x92,
+	0x15, 0x39, 0xd9, 0x2a, 0xce, 0x87, 0x90, 0xc9, 0x19, 0xe0, 0x83, 0xab, 0xec, 0x5c, 0x8d, 0x32,
+	0xe8, 0x7a, 0x94, 0x41, 0x7f, 0x8e, 0x32, 0xe8, 0xf2, 0x26, 0x13, 0xb9, 0xbe, 0xc9, 0x44, 0xfe,
+	0xb8, 0xc9, 0x44, 0x0e, 0xcb, 0xad, 0xb6, 0xfd, 0x69, 0xbf, 0xa6, 0xd6, 0x69, 0x57, 0xfb, 0x98,
+	0xa7, 0xda, 0x25, 0xf6, 0x19, 0xed, 0x1d, 0xbb, 0x89, 0x3f, 0xf3, 0xa7, 0xb6, 0x07, 0x16, 0x61,
+	0xb5, 0x38, 0xff, 0xc7, 0xfb, 0xd6, 0xdf, 0x03, 0x00, 0xb4, 0x61, 0x93, 0xbc, 0xb2, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -734,6 +861,9 @@ type QueryClient interface {
 	Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
 	// NFT queries the NFT for the given denom and token ID
 	NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error)
+	// Royalty queries the EIP-2981 royalty of an nft, or of a denom when the
+	// token ID is omitted
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
 }
 
 type queryClient struct {
@@ -798,6 +928,15 @@ func (c *queryClient) NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/uptick.collection.v1.Query/Royalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Supply queries the total supply of a given denom or owner
@@ -812,6 +951,9 @@ type QueryServer interface {
 	Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error)
 	// NFT queries the NFT for the given denom and token ID
 	NFT(context.Context, *QueryNFTRequest) (*QueryNFTResponse, error)
+	// Royalty queries the EIP-2981 royalty of an nft, or of a denom when the
+	// token ID is omitted
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -836,6 +978,9 @@ func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenomsReq
 func (*UnimplementedQueryServer) NFT(ctx context.Context, req *QueryNFTRequest) (*QueryNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NFT not implemented")
 }
+func (*UnimplementedQueryServer) Royalty(ctx context.Context, req *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -949,6 +1094,24 @@ func _Query_NFT_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.collection.v1.Query/Royalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "uptick.collection.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -977,6 +1140,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NFT",
 			Handler:    _Query_NFT_Handler,
 		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "uptick/collection/v1/query.proto",
@@ -1453,6 +1620,90 @@ func (m *QueryNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRoyaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SalePrice) > 0 {
+		i -= len(m.SalePrice)
+		copy(dAtA[i:], m.SalePrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SalePrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoyaltyAmount) > 0 {
+		i -= len(m.RoyaltyAmount)
+		copy(dAtA[i:], m.RoyaltyAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RoyaltyAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1653,6 +1904,42 @@ func (m *QueryNFTResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRoyaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SalePrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Royalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.RoyaltyAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2928,6 +3215,267 @@ func (m *QueryNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoyaltyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
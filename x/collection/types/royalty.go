@@ -0,0 +1,81 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// MaxRoyaltyBasisPoints is the royalty of the whole sale price, in basis
+// points as the fee denominator of EIP-2981 implementations
+const MaxRoyaltyBasisPoints = 10000
+
+// NewRoyaltyInfo returns a new royalty paid to the receiver
+func NewRoyaltyInfo(receiver sdk.AccAddress, basisPoints uint32) *RoyaltyInfo {
+	return &RoyaltyInfo{
+		Receiver:    receiver.String(),
+		BasisPoints: basisPoints,
+	}
+}
+
+// Validate performs a stateless validation of the royalty
+func (r RoyaltyInfo) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid receiver address (%s)", err)
+	}
+	if r.BasisPoints > MaxRoyaltyBasisPoints {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "basis points %d greater than %d", r.BasisPoints, MaxRoyaltyBasisPoints)
+	}
+	return nil
+}
+
+// RoyaltyAmount returns the royalty of the sale price, rounded down as by
+// EIP-2981 implementations
+func (r RoyaltyInfo) RoyaltyAmount(salePrice sdk.Int) sdk.Int {
+	return salePrice.MulRaw(int64(r.BasisPoints)).QuoRaw(MaxRoyaltyBasisPoints)
+}
+
+// GetRoyalty returns the royalty of an nft of the class: the royalty of the
+// nft itself or, without it, the royalty of the class. The royalty of the
+// class is returned when the nft is nil. Only the class and nft data of the
+// module is read, other data has no royalty.
+func GetRoyalty(cdc codec.BinaryCodec, class nft.Class, token *nft.NFT) (RoyaltyInfo, bool) {
+	if token != nil && token.Data != nil && token.Data.TypeUrl == "/"+proto.MessageName(&NFTMetadata{}) {
+		var nftMetadata NFTMetadata
+		if err := cdc.Unmarshal(token.Data.GetValue(), &nftMetadata); err == nil && nftMetadata.Royalty != nil {
+			return *nftMetadata.Royalty, true
+		}
+	}
+
+	if class.Data != nil && class.Data.TypeUrl == "/"+proto.MessageName(&DenomMetadata{}) {
+		var denomMetadata DenomMetadata
+		if err := cdc.Unmarshal(class.Data.GetValue(), &denomMetadata); err == nil && denomMetadata.Royalty != nil {
+			return *denomMetadata.Royalty, true
+		}
+	}
+
+	return RoyaltyInfo{}, false
+}
+
+// SetNFTDataRoyalty returns the nft data with the given royalty. Empty data is
+// replaced by the module nft metadata, data of other types is returned
+// unchanged.
+func SetNFTDataRoyalty(cdc codec.BinaryCodec, data *codectypes.Any, royalty RoyaltyInfo) (*codectypes.Any, error) {
+	var nftMetadata NFTMetadata
+	switch {
+	case data == nil:
+	case data.TypeUrl == "/"+proto.MessageName(&NFTMetadata{}):
+		if err := cdc.Unmarshal(data.GetValue(), &nftMetadata); err != nil {
+			return nil, err
+		}
+	default:
+		return data, nil
+	}
+
+	nftMetadata.Royalty = &royalty
+	return codectypes.NewAnyWithValue(&nftMetadata)
+}
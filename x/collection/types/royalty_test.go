@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/UptickNetwork/uptick/x/collection/types"
+)
+
+func TestRoyaltyInfoValidate(t *testing.T) {
+	require.NoError(t, types.NewRoyaltyInfo(address, 0).Validate())
+	require.NoError(t, types.NewRoyaltyInfo(address, types.MaxRoyaltyBasisPoints).Validate())
+	require.Error(t, types.NewRoyaltyInfo(address, types.MaxRoyaltyBasisPoints+1).Validate())
+	require.Error(t, types.RoyaltyInfo{Receiver: "invalid", BasisPoints: 100}.Validate())
+}
+
+func TestRoyaltyAmount(t *testing.T) {
+	royalty := types.NewRoyaltyInfo(address, 250)
+	require.Equal(t, sdk.NewInt(25), royalty.RoyaltyAmount(sdk.NewInt(1000)))
+	require.Equal(t, sdk.NewInt(2), royalty.RoyaltyAmount(sdk.NewInt(99)))
+	require.Equal(t, sdk.ZeroInt(), royalty.RoyaltyAmount(sdk.ZeroInt()))
+}
+
+func TestGetRoyalty(t *testing.T) {
+	classRoyalty := types.NewRoyaltyInfo(address, 100)
+	nftRoyalty := types.NewRoyaltyInfo(address2, 500)
+
+	classData, err := codectypes.NewAnyWithValue(&types.DenomMetadata{Creator: address.String(), Royalty: classRoyalty})
+	require.NoError(t, err)
+	nftData, err := codectypes.NewAnyWithValue(&types.NFTMetadata{Name: nftName, Royalty: nftRoyalty})
+	require.NoError(t, err)
+	plainData, err := codectypes.NewAnyWithValue(&types.NFTMetadata{Name: nftName})
+	require.NoError(t, err)
+	foreignData, err := codectypes.NewAnyWithValue(&types.MsgBurnNFT{Sender: address.String()})
+	require.NoError(t, err)
+
+	class := nft.Class{Id: denomID, Data: classData}
+
+	testCases := []struct {
+		name     string
+		class    nft.Class
+		token    *nft.NFT
+		expFound bool
+		expected *types.RoyaltyInfo
+	}{
+		{"class", class, nil, true, classRoyalty},
+		{"nft override", class, &nft.NFT{Data: nftData}, true, nftRoyalty},
+		{"nft without royalty", class, &nft.NFT{Data: plainData}, true, classRoyalty},
+		{"nft without data", class, &nft.NFT{}, true, classRoyalty},
+		{"nft with foreign data", class, &nft.NFT{Data: foreignData}, true, classRoyalty},
+		{"class without data", nft.Class{Id: denomID}, &nft.NFT{Data: plainData}, false, nil},
+		{"class with foreign data", nft.Class{Id: denomID, Data: foreignData}, nil, false, nil},
+	}
+	for _, tc := range testCases {
+		royalty, found := types.GetRoyalty(types.ModuleCdc, tc.class, tc.token)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expFound {
+			require.Equal(t, *tc.expected, royalty, tc.name)
+		}
+	}
+}
+
+func TestSetNFTDataRoyalty(t *testing.T) {
+	royalty := *types.NewRoyaltyInfo(address, 100)
+
+	data, err := types.SetNFTDataRoyalty(types.ModuleCdc, nil, royalty)
+	require.NoError(t, err)
+	found, ok := types.GetRoyalty(types.ModuleCdc, nft.Class{}, &nft.NFT{Data: data})
+	require.True(t, ok)
+	require.Equal(t, royalty, found)
+
+	nftData, err := codectypes.NewAnyWithValue(&types.NFTMetadata{Name: nftName, Description: tokenData})
+	require.NoError(t, err)
+	data, err = types.SetNFTDataRoyalty(types.ModuleCdc, nftData, royalty)
+	require.NoError(t, err)
+	var nftMetadata types.NFTMetadata
+	require.NoError(t, types.ModuleCdc.Unmarshal(data.GetValue(), &nftMetadata))
+	require.Equal(t, types.NFTMetadata{Name: nftName, Description: tokenData, Royalty: &royalty}, nftMetadata)
+
+	foreignData, err := codectypes.NewAnyWithValue(&types.MsgBurnNFT{Sender: address.String()})
+	require.NoError(t, err)
+	data, err = types.SetNFTDataRoyalty(types.ModuleCdc, foreignData, royalty)
+	require.NoError(t, err)
+	require.Equal(t, foreignData, data)
+}
@@ -37,6 +37,8 @@ type MsgIssueDenom struct {
 	Symbol           string `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	MintRestricted   bool   `protobuf:"varint,6,opt,name=mint_restricted,json=mintRestricted,proto3" json:"mint_restricted,omitempty"`
 	UpdateRestricted bool   `protobuf:"varint,7,opt,name=update_restricted,json=updateRestricted,proto3" json:"update_restricted,omitempty"`
+	// royalty of the nfts of the denom
+	Royalty *RoyaltyInfo `protobuf:"bytes,8,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *MsgIssueDenom) Reset()         { *m = MsgIssueDenom{} }
@@ -279,6 +281,8 @@ type MsgMintNFT struct {
 	Data      string `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 	Sender    string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient string `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// royalty of the nft, overriding the royalty of its denom
+	Royalty *RoyaltyInfo `protobuf:"bytes,8,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
@@ -524,46 +528,49 @@ func init() {
 func init() { proto.RegisterFile("uptick/collection/v1/tx.proto", fileDescriptor_c83579f0ca234cd0) }
 
 var fileDescriptor_c83579f0ca234cd0 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0xe3, 0x90, 0xb4, 0x2f, 0xea, 0x07, 0xa7, 0x52, 0x4c, 0x04, 0x4e, 0x15, 0x10, 0x54,
-	0x2a, 0xb2, 0xd5, 0x32, 0xd1, 0x31, 0x2a, 0x48, 0x19, 0x52, 0x21, 0xab, 0x5d, 0x18, 0xa8, 0x1c,
-	0xfb, 0xea, 0x9e, 0x1a, 0x7f, 0xc8, 0x77, 0x2e, 0xcd, 0xc0, 0x7f, 0xe0, 0x27, 0xf0, 0x2b, 0x98,
-	0x19, 0x3b, 0x76, 0x64, 0x0a, 0xe0, 0x2c, 0xcc, 0xfc, 0x02, 0xe4, 0xf3, 0x39, 0x76, 0xa2, 0x3a,
-	0xcd, 0xc2, 0x00, 0xdb, 0xdd, 0x73, 0xcf, 0xbd, 0xf7, 0x3e, 0xcf, 0xbd, 0xf7, 0x1e, 0x3c, 0x8e,
-	0x02, 0x46, 0xac, 0x73, 0xdd, 0xf2, 0x07, 0x03, 0x6c, 0x31, 0xe2, 0x7b, 0xfa, 0xc5, 0xae, 0xce,
-	0x2e, 0xb5, 0x20, 0xf4, 0x99, 0x8f, 0x36, 0xd2, 0x65, 0x2d, 0x5f, 0xd6, 0x2e, 0x76, 0x9b, 0x1b,
-	0x8e, 0xef, 0xf8, 0x9c, 0xa0, 0x27, 0xa3, 0x94, 0xdb, 0xfe, 0x2e, 0xc1, 0x4a, 0x8f, 0x3a, 0x5d,
-	0x4a, 0x23, 0x7c, 0x80, 0x3d, 0xdf, 0x45, 0x9b, 0x50, 0x25, 0xb6, 0x22, 0x6d, 0x49, 0xdb, 0xcb,
-	0x9d, 0x7a, 0x3c, 0x6a, 0x55, 0xbb, 0x07, 0x46, 0x95, 0xd8, 0x08, 0x41, 0xcd, 0x33, 0x5d, 0xac,
-	0x54, 0x93, 0x15, 0x83, 0x8f, 0xd1, 0x26, 0xd4, 0xa9, 0x75, 0x86, 0x5d, 0x53, 0x91, 0x39, 0x2a,
-	0x66, 0x1c, 0xc7, 0x9e, 0x8d, 0x43, 0xa5, 0x26, 0x70, 0x3e, 0xe3, 0xf8, 0xd0, 0xed, 0xfb, 0x03,
-	0xe5, 0x8e, 0xc0, 0xf9, 0x0c, 0x3d, 0x87, 0x35, 0x97, 0x78, 0xec, 0x24, 0xc4, 0x94, 0x85, 0xc4,
-	0x62, 0xd8, 0x56, 0xea, 0x5b, 0xd2, 0xf6, 0x92, 0xb1, 0x9a, 0xc0, 0xc6, 0x04, 0x45, 0x3b, 0x70,
-	0x2f, 0x0a, 0x6c, 0x93, 0xe1, 0x22, 0xb5, 0xc1, 0xa9, 0xeb, 0xe9, 0x42, 0x4e, 0xde, 0xaf, 0xfd,
-	0xfa, 0xdc, 0x92, 0xda, 0x0f, 0xe0, 0xfe, 0x94, 0x40, 0x03, 0xd3, 0xc0, 0xf7, 0x28, 0x6e, 0xc7,
-	0x12, 0xac, 0xf6, 0xa8, 0x73, 0x14, 0x9a, 0x1e, 0x3d, 0xc5, 0xe1, 0xe1, 0x9b, 0xa3, 0x52, 0xed,
-	0xaf, 0x60, 0xc9, 0x4e, 0xf6, 0x9e, 0x10, 0x3b, 0xd5, 0xdf, 0x51, 0xe3, 0x51, 0xab, 0xc1, 0xe3,
-	0x75, 0x0f, 0x7e, 0x8f, 0x5a, 0x6b, 0x43, 0xd3, 0x1d, 0xec, 0xb7, 0x33, 0x52, 0xdb, 0x68, 0xf0,
-	0x61, 0x37, 0xb7, 0x4d, 0x2e, 0xd8, 0xf6, 0x10, 0xe4, 0x28, 0x24, 0xa9, 0x37, 0x9d, 0x46, 0x3c,
-	0x6a, 0xc9, 0xc7, 0x46, 0xd7, 0x48, 0xb0, 0x84, 0x6e, 0x9b, 0xcc, 0x14, 0xfe, 0xf0, 0x71, 0xc1,
-	0xcd, 0xfa, 0x94, 0x9b, 0x8f, 0x60, 0x39, 0xc4, 0x16, 0x09, 0x08, 0xf6, 0x18, 0x37, 0x61, 0xd9,
-	0xc8, 0x01, 0xa1, 0x5e, 0x81, 0xcd, 0x69, 0x8d, 0x13, 0xf9, 0x5f, 0x25, 0x80, 0x1e, 0x75, 0x5e,
-	0xdb, 0x84, 0xfd, 0x73, 0xd2, 0x85, 0xb8, 0x0d, 0x40, 0xb9, 0x82, 0x89, 0xb0, 0x51, 0x2a, 0xac,
-	0x47, 0x3c, 0xf6, 0x7f, 0xde, 0x69, 0x2a, 0x5b, 0xe8, 0x9b, 0xc8, 0xfe, 0xc8, 0x55, 0x77, 0xa2,
-	0xd0, 0xfb, 0x4b, 0xaa, 0xf3, 0x94, 0xe5, 0xd2, 0xbb, 0x10, 0xc7, 0x4f, 0x92, 0x3a, 0x85, 0xf5,
-	0x42, 0xf9, 0xcd, 0x6f, 0x30, 0x79, 0xfc, 0x6a, 0xb9, 0x25, 0xf2, 0xcd, 0x96, 0x34, 0x41, 0x99,
-	0x3d, 0x27, 0xcb, 0x61, 0xef, 0x4b, 0x0d, 0xe4, 0x1e, 0x75, 0xd0, 0x7b, 0x80, 0x42, 0x9b, 0x7b,
-	0xa2, 0xdd, 0xd4, 0x25, 0xb5, 0xa9, 0x56, 0xd1, 0xdc, 0x59, 0x80, 0x94, 0x9d, 0x83, 0x8e, 0xa1,
-	0x91, 0xd5, 0xdc, 0x56, 0xe9, 0x3e, 0xc1, 0x68, 0x6e, 0xdf, 0xc6, 0x28, 0x86, 0xcd, 0xde, 0x68,
-	0x79, 0x58, 0xc1, 0x98, 0x13, 0x76, 0xe6, 0x95, 0x20, 0x13, 0xee, 0x16, 0x3b, 0xdf, 0xd3, 0xd2,
-	0x8d, 0x05, 0x56, 0xf3, 0xc5, 0x22, 0xac, 0x62, 0xe6, 0x59, 0x39, 0x96, 0x67, 0x2e, 0x18, 0x73,
-	0x32, 0x9f, 0xa9, 0x29, 0xe4, 0xc0, 0xca, 0x74, 0x41, 0x3d, 0xbb, 0x35, 0xab, 0xf4, 0x36, 0xb5,
-	0xc5, 0x78, 0xd9, 0x41, 0x9d, 0xb7, 0x57, 0x3f, 0xd5, 0xca, 0x55, 0xac, 0x4a, 0xd7, 0xb1, 0x2a,
-	0xfd, 0x88, 0x55, 0xe9, 0xd3, 0x58, 0xad, 0x5c, 0x8f, 0xd5, 0xca, 0xb7, 0xb1, 0x5a, 0x79, 0xb7,
-	0xe7, 0x10, 0x76, 0x16, 0xf5, 0x35, 0xcb, 0x77, 0xf5, 0x63, 0x1e, 0xf7, 0x10, 0xb3, 0x0f, 0x7e,
-	0x78, 0xae, 0x8b, 0xdf, 0xf9, 0xb2, 0xf8, 0x3f, 0xb3, 0x61, 0x80, 0x69, 0xbf, 0xce, 0x3f, 0xdd,
-	0x97, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x94, 0xaa, 0xef, 0xc1, 0x07, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x3b, 0x24, 0xed, 0xab, 0xfa, 0x03, 0xab, 0x14, 0x63, 0x81, 0x13, 0xc2, 0xaf,
+	0x48, 0x45, 0x8e, 0x5a, 0x26, 0xca, 0x16, 0x15, 0xa4, 0x0c, 0xa9, 0x90, 0xd5, 0x2e, 0x0c, 0x54,
+	0xae, 0x7d, 0x75, 0x4f, 0x8d, 0x7d, 0x91, 0x7d, 0x29, 0xcd, 0xc0, 0xff, 0xc0, 0x0e, 0x03, 0x7f,
+	0x05, 0x33, 0x63, 0xc7, 0x8e, 0x4c, 0x11, 0xb8, 0x0b, 0x33, 0x7f, 0x01, 0xca, 0xf9, 0x6c, 0x5f,
+	0x50, 0x4c, 0x32, 0xc0, 0x00, 0xdb, 0xdd, 0xbb, 0xcf, 0x7b, 0xf7, 0xde, 0xf7, 0xdd, 0x0f, 0xb8,
+	0x33, 0xe8, 0x53, 0xec, 0x9c, 0xb6, 0x1c, 0xd2, 0xeb, 0x21, 0x87, 0x62, 0x12, 0xb4, 0xce, 0xb6,
+	0x5a, 0xf4, 0xdc, 0xec, 0x87, 0x84, 0x12, 0x75, 0x3d, 0x59, 0x36, 0xf3, 0x65, 0xf3, 0x6c, 0x4b,
+	0x5f, 0xf7, 0x88, 0x47, 0x18, 0xd0, 0x1a, 0x8f, 0x12, 0x56, 0x7f, 0x30, 0x35, 0x94, 0xe0, 0xc9,
+	0xb0, 0xc6, 0x07, 0x19, 0x96, 0xbb, 0x91, 0xd7, 0x89, 0xa2, 0x01, 0xda, 0x45, 0x01, 0xf1, 0xd5,
+	0x0d, 0x90, 0xb1, 0xab, 0x49, 0x75, 0xa9, 0xb9, 0xd8, 0xae, 0xc4, 0xa3, 0x9a, 0xdc, 0xd9, 0xb5,
+	0x64, 0xec, 0xaa, 0x2a, 0x94, 0x03, 0xdb, 0x47, 0x9a, 0x3c, 0x5e, 0xb1, 0xd8, 0x58, 0xdd, 0x80,
+	0x4a, 0xe4, 0x9c, 0x20, 0xdf, 0xd6, 0x14, 0x66, 0xe5, 0x33, 0x66, 0x47, 0x81, 0x8b, 0x42, 0xad,
+	0xcc, 0xed, 0x6c, 0xc6, 0xec, 0x43, 0xff, 0x88, 0xf4, 0xb4, 0x6b, 0xdc, 0xce, 0x66, 0xea, 0x23,
+	0x58, 0xf5, 0x71, 0x40, 0x0f, 0x43, 0x14, 0xd1, 0x10, 0x3b, 0x14, 0xb9, 0x5a, 0xa5, 0x2e, 0x35,
+	0x17, 0xac, 0x95, 0xb1, 0xd9, 0xca, 0xac, 0xea, 0x26, 0x5c, 0x1f, 0xf4, 0x5d, 0x9b, 0x22, 0x11,
+	0xad, 0x32, 0x74, 0x2d, 0x59, 0x10, 0xe0, 0x67, 0x50, 0x0d, 0xc9, 0xd0, 0xee, 0xd1, 0xa1, 0xb6,
+	0x50, 0x97, 0x9a, 0x4b, 0xdb, 0x77, 0xcd, 0x69, 0x02, 0x9a, 0x56, 0x02, 0x75, 0x82, 0x63, 0x62,
+	0xa5, 0x1e, 0x3b, 0xe5, 0xef, 0x1f, 0x6b, 0x52, 0xe3, 0x26, 0xdc, 0x98, 0x50, 0xc7, 0x42, 0x51,
+	0x9f, 0x04, 0x11, 0x6a, 0xc4, 0x12, 0xac, 0x74, 0x23, 0x6f, 0x3f, 0xb4, 0x83, 0xe8, 0x18, 0x85,
+	0x7b, 0x2f, 0xf6, 0x0b, 0x85, 0x7b, 0x0a, 0x0b, 0xee, 0xd8, 0xf7, 0x10, 0xbb, 0x89, 0x78, 0x6d,
+	0x23, 0x1e, 0xd5, 0xaa, 0x2c, 0x5e, 0x67, 0xf7, 0xc7, 0xa8, 0xb6, 0x3a, 0xb4, 0xfd, 0xde, 0x4e,
+	0x23, 0x85, 0x1a, 0x56, 0x95, 0x0d, 0x3b, 0xb9, 0xe6, 0x8a, 0xa0, 0xf9, 0x2d, 0x50, 0x06, 0x21,
+	0x4e, 0x84, 0x6d, 0x57, 0xe3, 0x51, 0x4d, 0x39, 0xb0, 0x3a, 0xd6, 0xd8, 0x36, 0xc6, 0x5d, 0x9b,
+	0xda, 0x5c, 0x5c, 0x36, 0x16, 0x5a, 0x51, 0x99, 0x68, 0xc5, 0x6d, 0x58, 0x0c, 0x91, 0x83, 0xfb,
+	0x18, 0x05, 0x94, 0x29, 0xb8, 0x68, 0xe5, 0x06, 0x5e, 0xbd, 0x06, 0x1b, 0x93, 0x35, 0x66, 0xe5,
+	0x7f, 0x96, 0x00, 0xba, 0x91, 0xf7, 0xdc, 0xc5, 0xf4, 0x9f, 0x2b, 0x9d, 0x17, 0xb7, 0x0e, 0x6a,
+	0x5e, 0x41, 0x56, 0xd8, 0x7b, 0x99, 0x15, 0xd6, 0xc5, 0x01, 0xfd, 0xcf, 0x7a, 0xfa, 0x27, 0xae,
+	0x43, 0xa2, 0x19, 0x17, 0x27, 0xd3, 0xec, 0x2d, 0x93, 0xac, 0x3d, 0x08, 0x83, 0xbf, 0x24, 0x59,
+	0x5e, 0xaf, 0x52, 0xd8, 0x48, 0xbe, 0x7d, 0x96, 0xd4, 0x31, 0xac, 0x09, 0x67, 0xf7, 0xf7, 0x4f,
+	0x5b, 0x1e, 0x5f, 0x2e, 0xd6, 0x53, 0x99, 0x7e, 0x47, 0x74, 0xd0, 0x7e, 0xdd, 0x27, 0xcd, 0x61,
+	0xfb, 0x53, 0x19, 0x94, 0x6e, 0xe4, 0xa9, 0xaf, 0x01, 0x84, 0x07, 0xf6, 0xde, 0x74, 0xd9, 0x27,
+	0xde, 0x19, 0x7d, 0x73, 0x0e, 0x28, 0xdd, 0x47, 0x3d, 0x80, 0x6a, 0x7a, 0x60, 0xeb, 0x85, 0x7e,
+	0x9c, 0xd0, 0x9b, 0xb3, 0x08, 0x31, 0x6c, 0x7a, 0xc1, 0x8b, 0xc3, 0x72, 0x42, 0x6f, 0xce, 0x22,
+	0xb2, 0xb0, 0x36, 0x2c, 0x89, 0xcf, 0xe6, 0xfd, 0x42, 0x47, 0x81, 0xd2, 0x1f, 0xcf, 0x43, 0x89,
+	0x99, 0xa7, 0xc7, 0xb1, 0x38, 0x73, 0x4e, 0xe8, 0xcd, 0x59, 0x44, 0x16, 0xd6, 0x83, 0xe5, 0xc9,
+	0x03, 0xf5, 0x70, 0x66, 0x56, 0x49, 0x37, 0xcd, 0xf9, 0xb8, 0x74, 0xa3, 0xf6, 0xcb, 0x8b, 0x6f,
+	0x46, 0xe9, 0x22, 0x36, 0xa4, 0xcb, 0xd8, 0x90, 0xbe, 0xc6, 0x86, 0xf4, 0xee, 0xca, 0x28, 0x5d,
+	0x5e, 0x19, 0xa5, 0x2f, 0x57, 0x46, 0xe9, 0xd5, 0xb6, 0x87, 0xe9, 0xc9, 0xe0, 0xc8, 0x74, 0x88,
+	0xdf, 0x3a, 0x60, 0x71, 0xf7, 0x10, 0x7d, 0x43, 0xc2, 0xd3, 0x16, 0xff, 0xf3, 0xcf, 0xc5, 0x5f,
+	0x9f, 0x0e, 0xfb, 0x28, 0x3a, 0xaa, 0xb0, 0xef, 0xfe, 0xc9, 0xcf, 0x01, 0x00, 0x2f, 0x27, 0xe0,
+	0x80, 0x62, 0x08, 0x00, 0x00,
 }
 
 func (this *MsgIssueDenom) Equal(that interface{}) bool {
@@ -606,6 +613,9 @@ func (this *MsgIssueDenom) Equal(that interface{}) bool {
 	if this.UpdateRestricted != that1.UpdateRestricted {
 		return false
 	}
+	if !this.Royalty.Equal(that1.Royalty) {
+		return false
+	}
 	return true
 }
 func (this *MsgTransferNFT) Equal(that interface{}) bool {
@@ -729,6 +739,9 @@ func (this *MsgMintNFT) Equal(that interface{}) bool {
 	if this.Recipient != that1.Recipient {
 		return false
 	}
+	if !this.Royalty.Equal(that1.Royalty) {
+		return false
+	}
 	return true
 }
 func (this *MsgBurnNFT) Equal(that interface{}) bool {
@@ -1084,6 +1097,18 @@ func (m *MsgIssueDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.UpdateRestricted {
 		i--
 		if m.UpdateRestricted {
@@ -1368,6 +1393,18 @@ func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Recipient) > 0 {
 		i -= len(m.Recipient)
 		copy(dAtA[i:], m.Recipient)
@@ -1620,6 +1657,10 @@ func (m *MsgIssueDenom) Size() (n int) {
 	if m.UpdateRestricted {
 		n += 2
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1754,6 +1795,10 @@ func (m *MsgMintNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2061,6 +2106,42 @@ func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UpdateRestricted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3001,6 +3082,42 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
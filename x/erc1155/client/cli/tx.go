@@ -0,0 +1,327 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// NewTxCmd returns a root CLI command handler for erc1155 transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc1155 subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewConvertCoinsCmd(),
+		NewConvertNFTsCmd(),
+		NewConvertERC1155Cmd(),
+	)
+	return txCmd
+}
+
+// NewConvertCoinsCmd returns a CLI command handler for converting Cosmos coins
+// of a token pair
+func NewConvertCoinsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coins [coins] [receiver_hex]",
+		Short: "Convert Cosmos coins to erc1155 tokens. When the receiver [optional] is omitted, the erc1155 tokens are transferred to the sender.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			receiver := common.BytesToAddress(sender)
+			if len(args) == 2 {
+				if err := ethermint.ValidateAddress(args[1]); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+				receiver = common.HexToAddress(args[1])
+			}
+
+			msg := types.NewMsgConvertMultiToken(coins, receiver, sender)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertNFTsCmd returns a CLI command handler for converting Cosmos nfts
+// of a token pair
+func NewConvertNFTsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-nfts [class_id] [nft_ids] [receiver_hex]",
+		Short: "Convert comma-separated Cosmos nfts of a class to erc1155 tokens. When the receiver [optional] is omitted, the erc1155 tokens are transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			classID := args[0]
+			nftIDs := strings.Split(args[1], ",")
+
+			sender := cliCtx.GetFromAddress()
+			receiver := common.BytesToAddress(sender)
+			if len(args) == 3 {
+				if err := ethermint.ValidateAddress(args[2]); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+				receiver = common.HexToAddress(args[2])
+			}
+
+			msg := types.NewMsgConvertMultiTokenNFTs(classID, nftIDs, receiver, sender)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC1155Cmd returns a CLI command handler for converting erc1155
+// tokens of a contract
+func NewConvertERC1155Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc1155 [contract-address] [token_ids] [amounts] [receiver]",
+		Short: "Convert comma-separated erc1155 token ids and amounts to Cosmos coins or nfts. When the receiver [optional] is omitted, the Cosmos tokens are transferred to the sender.",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid erc1155 contract address %w", err)
+			}
+
+			tokenIDs := strings.Split(args[1], ",")
+
+			var amounts []sdk.Int
+			for _, amountStr := range strings.Split(args[2], ",") {
+				amount, ok := sdk.NewIntFromString(amountStr)
+				if !ok {
+					return fmt.Errorf("invalid amount %s", amountStr)
+				}
+				amounts = append(amounts, amount)
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 4 {
+				receiver, err = sdk.AccAddressFromBech32(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgConvertERC1155(tokenIDs, amounts, receiver, common.HexToAddress(contract), from)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterCoinsProposalCmd implements the command to submit a
+// register-erc1155-coins proposal
+func NewRegisterCoinsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-erc1155-coins [denoms] [uri]",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Submit a proposal to register Cosmos coins to an erc1155 contract",
+		Long:    "Submit a proposal to register comma-separated Cosmos coin denoms to a new erc1155 contract along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc1155-coins <denom>,<denom> <uri> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var uri string
+			if len(args) == 2 {
+				uri = args[1]
+			}
+
+			return submitProposal(cmd, func(title, description string) gov.Content {
+				return types.NewRegisterCoinsProposal(title, description, strings.Split(args[0], ","), uri)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRegisterNFTClassProposalCmd implements the command to submit a
+// register-erc1155-nft-class proposal
+func NewRegisterNFTClassProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-erc1155-nft-class [class-id] [uri]",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Submit a proposal to register a Cosmos nft class to an erc1155 contract",
+		Long:    "Submit a proposal to register a Cosmos nft class to a new erc1155 contract along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc1155-nft-class <class-id> <uri> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var uri string
+			if len(args) == 2 {
+				uri = args[1]
+			}
+
+			return submitProposal(cmd, func(title, description string) gov.Content {
+				return types.NewRegisterNFTClassProposal(title, description, args[0], uri)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRegisterERC1155ProposalCmd implements the command to submit a
+// register-erc1155 proposal
+func NewRegisterERC1155ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-erc1155 [erc1155-address] [coin|nft]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to register an erc1155 contract",
+		Long:    "Submit a proposal to register an erc1155 contract, whose tokens are represented as Cosmos coins or nfts, along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc1155 <contract-address> coin --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var tokenType types.TokenType
+			switch args[1] {
+			case "coin":
+				tokenType = types.TOKEN_TYPE_COIN
+			case "nft":
+				tokenType = types.TOKEN_TYPE_NFT
+			default:
+				return fmt.Errorf("invalid token type %s, expected coin or nft", args[1])
+			}
+
+			return submitProposal(cmd, func(title, description string) gov.Content {
+				return types.NewRegisterERC1155Proposal(title, description, args[0], tokenType)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewToggleTokenConversionProposalCmd implements the command to submit a
+// toggle-erc1155-conversion proposal
+func NewToggleTokenConversionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "toggle-erc1155-conversion [token]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a toggle erc1155 conversion proposal",
+		Long:    "Submit a proposal to toggle the conversion of an erc1155 token pair along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal toggle-erc1155-conversion <denom_class_or_contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) gov.Content {
+				return types.NewToggleTokenConversionProposal(title, description, args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// submitProposal reads the common proposal flags and broadcasts the
+// MsgSubmitProposal of the given content
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) gov.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := gov.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
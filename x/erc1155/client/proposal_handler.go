@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/client/cli"
+)
+
+var (
+	RegisterCoinsProposalHandler         = govclient.NewProposalHandler(cli.NewRegisterCoinsProposalCmd)
+	RegisterNFTClassProposalHandler      = govclient.NewProposalHandler(cli.NewRegisterNFTClassProposalCmd)
+	RegisterERC1155ProposalHandler       = govclient.NewProposalHandler(cli.NewRegisterERC1155ProposalCmd)
+	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd)
+)
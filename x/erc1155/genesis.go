@@ -0,0 +1,59 @@
+package erc1155
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/keeper"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	// ensure erc1155 module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the erc1155 module account has not been set")
+	}
+
+	for _, pair := range data.TokenPairs {
+		id := pair.GetID()
+		k.SetTokenPair(ctx, pair)
+		k.SetERC1155Map(ctx, pair.GetERC1155Contract(), id)
+		if pair.IsNFT() {
+			k.SetClassMap(ctx, pair.ClassId, id)
+		}
+	}
+
+	for _, mapping := range data.TokenMappings {
+		pair, found := k.GetTokenPair(ctx, k.GetERC1155Map(ctx, common.HexToAddress(mapping.Erc1155Address)))
+		if !found {
+			panic(fmt.Errorf("token pair not found for erc1155 contract %s", mapping.Erc1155Address))
+		}
+
+		nativeID := mapping.Denom
+		if pair.IsNFT() {
+			nativeID = mapping.NftId
+		}
+		k.SetTokenMapping(ctx, pair, mapping.TokenId, nativeID)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		TokenPairs:    k.GetTokenPairs(ctx),
+		TokenMappings: k.GetTokenMappings(ctx),
+	}
+}
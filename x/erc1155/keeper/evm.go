@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/server/config"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// DeployERC1155Contract creates and deploys an ERC1155 contract on the EVM
+// with the erc1155 module account as owner.
+func (k Keeper) DeployERC1155Contract(
+	ctx sdk.Context,
+	uri string,
+) (common.Address, error) {
+	ctorArgs, err := contracts.ERC1155PresetMinterPauserContract.ABI.Pack("", uri)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrABIPack, "uri is invalid %s: %s", uri, err.Error())
+	}
+
+	data := make([]byte, len(contracts.ERC1155PresetMinterPauserContract.Bin)+len(ctorArgs))
+	copy(data[:len(contracts.ERC1155PresetMinterPauserContract.Bin)], contracts.ERC1155PresetMinterPauserContract.Bin)
+	copy(data[len(contracts.ERC1155PresetMinterPauserContract.Bin):], ctorArgs)
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
+	if _, err = k.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true); err != nil {
+		return common.Address{}, sdkerrors.Wrap(err, "failed to deploy contract")
+	}
+
+	return contractAddr, nil
+}
+
+// QueryERC1155SupportsInterface returns true if the given contract implements
+// the ERC1155 interface, as declared through ERC165
+func (k Keeper) QueryERC1155SupportsInterface(
+	ctx sdk.Context,
+	contract common.Address,
+) (bool, error) {
+	var supportsRes types.ERC1155BoolResponse
+
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+
+	res, err := k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, false, "supportsInterface", types.ERC1155InterfaceID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := erc1155.UnpackIntoInterface(&supportsRes, "supportsInterface", res.Ret); err != nil {
+		return false, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack supportsInterface: %s", err.Error(),
+		)
+	}
+
+	return supportsRes.Value, nil
+}
+
+// QueryERC1155Balance returns the balance of a token id of the given account
+func (k Keeper) QueryERC1155Balance(
+	ctx sdk.Context,
+	contract, account common.Address,
+	tokenID *big.Int,
+) (*big.Int, error) {
+	var balanceRes types.ERC1155BalanceResponse
+
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+
+	res, err := k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, false, "balanceOf", account, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := erc1155.UnpackIntoInterface(&balanceRes, "balanceOf", res.Ret); err != nil {
+		return nil, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack balanceOf: %s", err.Error(),
+		)
+	}
+
+	return balanceRes.Value, nil
+}
+
+// CallEVM performs a smart contract method call using given args
+func (k Keeper) CallEVM(
+	ctx sdk.Context,
+	abi abi.ABI,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := abi.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrap(
+			types.ErrABIPack,
+			sdkerrors.Wrap(err, "failed to create transaction data").Error(),
+		)
+	}
+
+	resp, err := k.CallEVMWithData(ctx, from, &contract, data, commit)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "contract call failed: method '%s', contract '%s'", method, contract)
+	}
+	return resp, nil
+}
+
+// CallEVMWithData performs a smart contract method call using contract data
+func (k Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	gasCap := config.DefaultGasCap
+	if commit {
+		args, err := json.Marshal(evmtypes.TransactionArgs{
+			From: &from,
+			To:   contract,
+			Data: (*hexutil.Bytes)(&data),
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal tx args: %s", err.Error())
+		}
+
+		gasRes, err := k.evmKeeper.EstimateGas(sdk.WrapSDKContext(ctx), &evmtypes.EthCallRequest{
+			Args:   args,
+			GasCap: config.DefaultGasCap,
+		})
+		if err != nil {
+			return nil, err
+		}
+		gasCap = gasRes.Gas
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasCap,        // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		!commit,               // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), commit)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, sdkerrors.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return res, nil
+}
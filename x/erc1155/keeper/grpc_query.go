@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// TokenPairs returns all registered pairs
+func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pairs []types.TokenPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenPair returns a given registered token pair
+func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
+}
+
+// TokenMapping returns the Cosmos coin or nft mapped to an ERC1155 token ID
+func (k Keeper) TokenMapping(c context.Context, req *types.QueryTokenMappingRequest) (*types.QueryTokenMappingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.Erc1155Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ERC1155 contract address %s", req.Erc1155Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC1155Map(ctx, common.HexToAddress(req.Erc1155Address)))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with contract '%s'", req.Erc1155Address)
+	}
+
+	nativeID := k.GetNativeIDByTokenID(ctx, pair, req.TokenId)
+	if nativeID == "" {
+		return nil, status.Errorf(codes.NotFound, "token mapping with token id '%s'", req.TokenId)
+	}
+
+	return &types.QueryTokenMappingResponse{
+		TokenMapping: newTokenMapping(pair, req.TokenId, nativeID),
+	}, nil
+}
+
+// Params returns the params of the erc1155 module
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// RegisterInvariants registers all erc1155 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrowed-tokens", EscrowedTokensInvariant(k))
+}
+
+// EscrowedTokensInvariant checks that the converted tokens are escrowed as
+// expected by the module:
+//  - native nft pairs: the nft is owned by the module account
+//  - native ERC1155 nft pairs: the nft exists and its ERC1155 token is held by
+//    the module address
+//  - native ERC1155 coin pairs: the ERC1155 tokens held by the module address
+//    are at least the bank supply of the coin
+//
+// NOTE: the coins of native coin pairs aren't checked, as the ERC1155 contract
+// doesn't track the total supply of its token ids.
+func EscrowedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			for _, mapping := range k.GetTokenMappingsByTokenPair(ctx, pair) {
+				if err := k.checkEscrowedToken(ctx, pair, mapping); err != nil {
+					count++
+					msg += fmt.Sprintf("\terc1155 token %s of %s: %s\n", mapping.TokenId, mapping.Erc1155Address, err.Error())
+				}
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrowed-tokens",
+			fmt.Sprintf("%d escrowed token invariants found\n%s", count, msg),
+		), broken
+	}
+}
+
+func (k Keeper) checkEscrowedToken(ctx sdk.Context, pair types.TokenPair, mapping types.TokenMapping) error {
+	if pair.IsNativeCosmos() {
+		if pair.IsNFT() {
+			if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, mapping.NftId); !owner.Equals(sdk.AccAddress(types.ModuleAddress.Bytes())) {
+				return fmt.Errorf("nft is owned by %s instead of the module account", owner)
+			}
+		}
+		return nil
+	}
+	if !pair.IsNativeERC1155() {
+		return types.ErrUndefinedOwner
+	}
+
+	tokenID, ok := new(big.Int).SetString(mapping.TokenId, 10)
+	if !ok {
+		return fmt.Errorf("invalid erc1155 token id")
+	}
+	escrowed, err := k.QueryERC1155Balance(ctx, pair.GetERC1155Contract(), types.ModuleAddress, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if pair.IsNFT() {
+		if !k.nftKeeper.HasNFT(ctx, pair.ClassId, mapping.NftId) {
+			return fmt.Errorf("nft doesn't exist")
+		}
+		if escrowed.Sign() <= 0 {
+			return fmt.Errorf("erc1155 token isn't held by the module address")
+		}
+		return nil
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, mapping.Denom).Amount; supply.BigInt().Cmp(escrowed) > 0 {
+		return fmt.Errorf("escrowed erc1155 tokens %s don't cover the coin supply %s", escrowed, supply)
+	}
+	return nil
+}
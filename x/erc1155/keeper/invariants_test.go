@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/keeper"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowedTokensInvariant() {
+	tokenID := big.NewInt(7)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"ok - no token pairs",
+			func() {},
+			false,
+		},
+		{
+			"ok - converted native nft",
+			func() {
+				suite.setupNativeNFTPair("nft1")
+				msg := types.NewMsgConvertMultiTokenNFTs(classID, []string{"nft1"}, suite.address, sdk.AccAddress(suite.address.Bytes()))
+				_, err := suite.app.Erc1155Keeper.ConvertMultiToken(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - converted native nft no longer escrowed",
+			func() {
+				suite.setupNativeNFTPair("nft1")
+				msg := types.NewMsgConvertMultiTokenNFTs(classID, []string{"nft1"}, suite.address, sdk.AccAddress(suite.address.Bytes()))
+				_, err := suite.app.Erc1155Keeper.ConvertMultiToken(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+				err = suite.app.NFTKeeper.Transfer(suite.ctx, classID, "nft1", tests.GenerateAddress().Bytes())
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"ok - converted native ERC1155 coins",
+			func() {
+				pair := suite.setupNativeERC1155Pair(types.TOKEN_TYPE_COIN, tokenID.Int64(), 100)
+				suite.convertERC1155(pair, tokenID, 60)
+			},
+			false,
+		},
+		{
+			"fail - coin supply of a native ERC1155 pair not backed by escrowed tokens",
+			func() {
+				pair := suite.setupNativeERC1155Pair(types.TOKEN_TYPE_COIN, tokenID.Int64(), 100)
+				suite.convertERC1155(pair, tokenID, 60)
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.CreateDenom(tokenID), 1))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			true,
+		},
+		{
+			"ok - converted native ERC1155 nft",
+			func() {
+				pair := suite.setupNativeERC1155Pair(types.TOKEN_TYPE_NFT, tokenID.Int64(), 1)
+				suite.convertERC1155(pair, tokenID, 1)
+			},
+			false,
+		},
+		{
+			"fail - nft of a native ERC1155 pair burned",
+			func() {
+				pair := suite.setupNativeERC1155Pair(types.TOKEN_TYPE_NFT, tokenID.Int64(), 1)
+				suite.convertERC1155(pair, tokenID, 1)
+				suite.Require().NoError(suite.app.NFTKeeper.Burn(suite.ctx, pair.ClassId, pair.CreateNFTID(tokenID)))
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			_, broken := keeper.EscrowedTokensInvariant(suite.app.Erc1155Keeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/app"
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+const (
+	denom1     = "coin1"
+	denom2     = "coin2"
+	classID    = "classid"
+	erc1155URI = "ipfs://token/{id}"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.Uptick
+	address common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+
+	// consensus key of the block proposer
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(priv.PubKey().Address())
+
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         "uptick_7777-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+
+	suite.address = tests.GenerateAddress()
+	suite.setAccount(suite.address)
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(suite.address.Bytes()), priv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+}
+
+func (suite *KeeperTestSuite) setAccount(address common.Address) {
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(address.Bytes()), nil, 0, 0),
+		CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+}
+
+// fundCoins mints the given coins to the suite address
+func (suite *KeeperTestSuite) fundCoins(coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), coins)
+	suite.Require().NoError(err)
+}
+
+// saveClass saves an nft class with nfts owned by the suite address
+func (suite *KeeperTestSuite) saveClass(nftIDs ...string) {
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(suite.ctx, nft.Class{Id: classID}))
+	for _, nftID := range nftIDs {
+		err := suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: classID, Id: nftID}, suite.address.Bytes())
+		suite.Require().NoError(err)
+	}
+}
+
+// deployContract deploys a compiled contract from the suite address
+func (suite *KeeperTestSuite) deployContract(contract evmtypes.CompiledContract, args ...interface{}) common.Address {
+	ctorArgs, err := contract.ABI.Pack("", args...)
+	suite.Require().NoError(err)
+	data := append(append([]byte{}, contract.Bin...), ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	_, err = suite.app.Erc1155Keeper.CallEVMWithData(suite.ctx, suite.address, nil, data, true)
+	suite.Require().NoError(err)
+
+	return crypto.CreateAddress(suite.address, nonce)
+}
+
+// deployERC1155 deploys an ERC1155 contract owned by the suite address
+func (suite *KeeperTestSuite) deployERC1155() common.Address {
+	return suite.deployContract(contracts.ERC1155PresetMinterPauserContract, erc1155URI)
+}
+
+// mintERC1155 mints ERC1155 tokens of a contract owned by the suite address
+func (suite *KeeperTestSuite) mintERC1155(contract, to common.Address, tokenID, amount int64) {
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+	_, err := suite.app.Erc1155Keeper.CallEVM(
+		suite.ctx, erc1155, suite.address, contract, true, "mint", to, big.NewInt(tokenID), big.NewInt(amount), []byte{},
+	)
+	suite.Require().NoError(err)
+}
+
+// balanceOf returns the ERC1155 balance of a token id of the given account
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address, tokenID *big.Int) *big.Int {
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+	res, err := suite.app.Erc1155Keeper.CallEVM(suite.ctx, erc1155, types.ModuleAddress, contract, false, "balanceOf", account, tokenID)
+	suite.Require().NoError(err)
+
+	out, err := erc1155.Unpack("balanceOf", res.Ret)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+// setupNativeCoinPair funds the suite address with the given coins and
+// registers their denoms
+func (suite *KeeperTestSuite) setupNativeCoinPair(coins sdk.Coins) types.TokenPair {
+	suite.fundCoins(coins)
+
+	denoms := make([]string, len(coins))
+	for i, coin := range coins {
+		denoms[i] = coin.Denom
+	}
+	pair, err := suite.app.Erc1155Keeper.RegisterCoins(suite.ctx, denoms, erc1155URI)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// setupNativeNFTPair saves an nft class with nfts owned by the suite address
+// and registers it
+func (suite *KeeperTestSuite) setupNativeNFTPair(nftIDs ...string) types.TokenPair {
+	suite.saveClass(nftIDs...)
+
+	pair, err := suite.app.Erc1155Keeper.RegisterNFTClass(suite.ctx, classID, erc1155URI)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// setupNativeERC1155Pair deploys an ERC1155 contract with a token id minted
+// to the suite address and registers it with the given token type
+func (suite *KeeperTestSuite) setupNativeERC1155Pair(tokenType types.TokenType, tokenID, amount int64) types.TokenPair {
+	contract := suite.deployERC1155()
+	suite.mintERC1155(contract, suite.address, tokenID, amount)
+
+	pair, err := suite.app.Erc1155Keeper.RegisterERC1155(suite.ctx, contract, tokenType)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// convertERC1155 converts an amount of a token id of the suite address
+func (suite *KeeperTestSuite) convertERC1155(pair types.TokenPair, tokenID *big.Int, amount int64) {
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := types.NewMsgConvertERC1155([]string{tokenID.String()}, []sdk.Int{sdk.NewInt(amount)}, receiver, pair.GetERC1155Contract(), suite.address)
+	_, err := suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+}
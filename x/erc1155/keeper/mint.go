@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// MintingEnabled checks that:
+//  - the global parameter for erc1155 conversion is enabled
+//  - minting is enabled for the given token pair
+//  - recipient address is not on the blocked list
+func (k Keeper) MintingEnabled(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	receiver sdk.AccAddress,
+	token string,
+) (types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableErc1155 {
+		return types.TokenPair{}, sdkerrors.Wrap(
+			types.ErrERC1155Disabled, "module is currently disabled by governance",
+		)
+	}
+
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrERC1155TokenPairDisabled, "minting token '%s' is not enabled by governance", token,
+		)
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", receiver,
+		)
+	}
+
+	return pair, nil
+}
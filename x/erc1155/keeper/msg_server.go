@@ -0,0 +1,438 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// ConvertMultiToken converts native Cosmos coins or nfts into ERC1155 tokens
+// for both Cosmos-native and ERC1155 TokenPair Owners
+func (k Keeper) ConvertMultiToken(
+	goCtx context.Context,
+	msg *types.MsgConvertMultiToken,
+) (
+	*types.MsgConvertMultiTokenResponse, error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	token := msg.ClassId
+	if len(msg.Coins) != 0 {
+		token = msg.Coins[0].Denom
+	}
+
+	pair, err := k.MintingEnabled(ctx, sender, receiver.Bytes(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.deleteSelfDestructedPair(ctx, pair) {
+		// NOTE: return nil error to persist the changes from the deletion
+		return nil, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(types.BatchConversionGasPerItem*uint64(len(msg.Coins)+len(msg.NftIds)), "erc1155 conversion")
+
+	var (
+		tokenIDs []*big.Int
+		amounts  []*big.Int
+		native   sdk.Attribute
+	)
+
+	switch {
+	case pair.IsCoin() && len(msg.Coins) != 0:
+		tokenIDs, amounts, err = k.convertCoins(ctx, pair, msg.Coins, receiver, sender)
+		native = sdk.NewAttribute(types.AttributeKeyCosmosCoins, msg.Coins.String())
+	case pair.IsNFT() && len(msg.NftIds) != 0:
+		tokenIDs, amounts, err = k.convertNFTs(ctx, pair, msg.NftIds, receiver, sender)
+		native = sdk.NewAttribute(types.AttributeKeyNFTIDs, strings.Join(msg.NftIds, ","))
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalidTokenType, "token pair of %s maps token type %s", token, pair.TokenType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertMultiToken,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+				native,
+				sdk.NewAttribute(types.AttributeKeyERC1155Token, pair.Erc1155Address),
+				sdk.NewAttribute(types.AttributeKeyERC1155TokenIDs, joinBigInts(tokenIDs)),
+				sdk.NewAttribute(types.AttributeKeyAmounts, joinBigInts(amounts)),
+			),
+		},
+	)
+
+	return &types.MsgConvertMultiTokenResponse{}, nil
+}
+
+// ConvertERC1155 converts ERC1155 tokens into native Cosmos coins or nfts for
+// both Cosmos-native and ERC1155 TokenPair Owners
+func (k Keeper) ConvertERC1155(
+	goCtx context.Context,
+	msg *types.MsgConvertERC1155,
+) (
+	*types.MsgConvertERC1155Response, error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	pair, err := k.MintingEnabled(ctx, sender.Bytes(), receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.deleteSelfDestructedPair(ctx, pair) {
+		// NOTE: return nil error to persist the changes from the deletion
+		return nil, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(types.BatchConversionGasPerItem*uint64(len(msg.TokenIds)), "erc1155 conversion")
+
+	tokenIDs := make([]*big.Int, len(msg.TokenIds))
+	amounts := make([]*big.Int, len(msg.Amounts))
+	for i, tokenID := range msg.TokenIds {
+		// Error checked during msg validation
+		tokenIDs[i], _ = new(big.Int).SetString(tokenID, 10)
+		amounts[i] = msg.Amounts[i].BigInt()
+	}
+
+	var native sdk.Attribute
+	switch {
+	case pair.IsCoin():
+		var coins sdk.Coins
+		coins, err = k.convertERC1155Coins(ctx, pair, tokenIDs, amounts, receiver, sender)
+		native = sdk.NewAttribute(types.AttributeKeyCosmosCoins, coins.String())
+	case pair.IsNFT():
+		var nftIDs []string
+		nftIDs, err = k.convertERC1155NFTs(ctx, pair, tokenIDs, amounts, receiver, sender)
+		native = sdk.NewAttribute(types.AttributeKeyNFTIDs, strings.Join(nftIDs, ","))
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalidTokenType, "%s", pair.TokenType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertERC1155,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+				native,
+				sdk.NewAttribute(types.AttributeKeyERC1155Token, pair.Erc1155Address),
+				sdk.NewAttribute(types.AttributeKeyERC1155TokenIDs, strings.Join(msg.TokenIds, ",")),
+				sdk.NewAttribute(types.AttributeKeyAmounts, joinBigInts(amounts)),
+			),
+		},
+	)
+
+	return &types.MsgConvertERC1155Response{}, nil
+}
+
+// deleteSelfDestructedPair removes the token pair if its contract is suicided
+// and returns true if the pair was deleted
+func (k Keeper) deleteSelfDestructedPair(ctx sdk.Context, pair types.TokenPair) bool {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, pair.GetERC1155Contract())
+	if acc != nil && acc.IsContract() {
+		return false
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	k.Logger(ctx).Debug(
+		"deleting selfdestructed token pair from state",
+		"contract", pair.Erc1155Address,
+	)
+	return true
+}
+
+// convertCoins handles the coins conversion for a coin token pair:
+//  - escrow coins on module account
+//  - native coins: mint tokens to the receiver
+//  - native ERC1155: burn coins and unescrow tokens that have been previously
+//    escrowed with ConvertERC1155 and send to receiver
+func (k Keeper) convertCoins(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	coins sdk.Coins,
+	receiver common.Address,
+	sender sdk.AccAddress,
+) ([]*big.Int, []*big.Int, error) {
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+	contract := pair.GetERC1155Contract()
+
+	tokenIDs := make([]*big.Int, len(coins))
+	amounts := make([]*big.Int, len(coins))
+	for i, coin := range coins {
+		if !bytes.Equal(k.GetDenomMap(ctx, coin.Denom), pair.GetID()) {
+			return nil, nil, sdkerrors.Wrapf(
+				types.ErrTokenPairNotFound, "coin %s is not registered in the token pair of %s", coin.Denom, coins[0].Denom,
+			)
+		}
+
+		tokenID := k.GetTokenIDByNativeID(ctx, pair, coin.Denom)
+		if tokenID == "" {
+			return nil, nil, sdkerrors.Wrapf(types.ErrTokenMappingNotFound, "coin %s", coin.Denom)
+		}
+
+		tokenIDs[i], _ = new(big.Int).SetString(tokenID, 10)
+		amounts[i] = coin.Amount.BigInt()
+	}
+
+	// Escrow coins on module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to escrow coins")
+	}
+
+	switch {
+	case pair.IsNativeCosmos():
+		// Mint tokens and send to receiver
+		if _, err := k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, true, "mintBatch", receiver, tokenIDs, amounts, []byte{}); err != nil {
+			return nil, nil, err
+		}
+	case pair.IsNativeERC1155():
+		// Burn escrowed coins
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, nil, sdkerrors.Wrap(err, "failed to burn coins")
+		}
+
+		// Unescrow tokens and send to receiver
+		if _, err := k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, true, "safeBatchTransferFrom", types.ModuleAddress, receiver, tokenIDs, amounts, []byte{}); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, types.ErrUndefinedOwner
+	}
+
+	return tokenIDs, amounts, nil
+}
+
+// convertNFTs handles the nfts conversion for a nft token pair:
+//  - native nfts: escrow nfts on module account and mint the tokens with the
+//    token IDs derived from the nft IDs to the receiver
+//  - native ERC1155: burn nfts and unescrow tokens that have been previously
+//    escrowed with ConvertERC1155 and send to receiver, removing their mapping
+func (k Keeper) convertNFTs(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	nftIDs []string,
+	receiver common.Address,
+	sender sdk.AccAddress,
+) ([]*big.Int, []*big.Int, error) {
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+	contract := pair.GetERC1155Contract()
+
+	tokenIDs := make([]*big.Int, len(nftIDs))
+	amounts := make([]*big.Int, len(nftIDs))
+	for i, nftID := range nftIDs {
+		if !k.nftKeeper.HasNFT(ctx, pair.ClassId, nftID) {
+			return nil, nil, sdkerrors.Wrapf(types.ErrNFTNotExist, "nft not exist: %s", nftID)
+		}
+
+		if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, nftID); !owner.Equals(sender) {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+		}
+
+		switch {
+		case pair.IsNativeCosmos():
+			// Escrow nft on module account
+			if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftID, types.ModuleAddress.Bytes()); err != nil {
+				return nil, nil, sdkerrors.Wrap(err, "failed to escrow nft")
+			}
+
+			tokenIDs[i] = types.CreateTokenID(nftID)
+			k.SetTokenMapping(ctx, pair, tokenIDs[i].String(), nftID)
+		case pair.IsNativeERC1155():
+			tokenID := k.GetTokenIDByNativeID(ctx, pair, nftID)
+			if tokenID == "" {
+				return nil, nil, sdkerrors.Wrapf(types.ErrTokenMappingNotFound, "nft %s", nftID)
+			}
+
+			// Burn nft
+			if err := k.nftKeeper.Burn(ctx, pair.ClassId, nftID); err != nil {
+				return nil, nil, err
+			}
+			k.DeleteTokenMapping(ctx, pair, tokenID, nftID)
+
+			tokenIDs[i], _ = new(big.Int).SetString(tokenID, 10)
+		default:
+			return nil, nil, types.ErrUndefinedOwner
+		}
+
+		amounts[i] = big.NewInt(1)
+	}
+
+	var err error
+	if pair.IsNativeCosmos() {
+		// Mint tokens and send to receiver
+		_, err = k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, true, "mintBatch", receiver, tokenIDs, amounts, []byte{})
+	} else {
+		// Unescrow tokens and send to receiver
+		_, err = k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, true, "safeBatchTransferFrom", types.ModuleAddress, receiver, tokenIDs, amounts, []byte{})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokenIDs, amounts, nil
+}
+
+// convertERC1155Coins handles the ERC1155 conversion for a coin token pair:
+//  - native coins: burn tokens and unescrow coins that have been previously
+//    escrowed with ConvertMultiToken and send to receiver
+//  - native ERC1155: escrow tokens on module address and mint the coins, with
+//    the denoms derived from the token IDs, to the receiver
+func (k Keeper) convertERC1155Coins(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenIDs []*big.Int,
+	amounts []*big.Int,
+	receiver sdk.AccAddress,
+	sender common.Address,
+) (sdk.Coins, error) {
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+	contract := pair.GetERC1155Contract()
+
+	converted := sdk.Coins{}
+	for i, tokenID := range tokenIDs {
+		denom := k.GetNativeIDByTokenID(ctx, pair, tokenID.String())
+		if denom == "" {
+			if pair.IsNativeCosmos() {
+				return nil, sdkerrors.Wrapf(types.ErrTokenMappingNotFound, "erc1155 token %s", tokenID)
+			}
+			denom = pair.CreateDenom(tokenID)
+			k.SetTokenMapping(ctx, pair, tokenID.String(), denom)
+		}
+		converted = converted.Add(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amounts[i])))
+	}
+
+	switch {
+	case pair.IsNativeCosmos():
+		// Burn tokens
+		if _, err := k.CallEVM(ctx, erc1155, sender, contract, true, "burnBatch", sender, tokenIDs, amounts); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeERC1155():
+		// Escrow tokens on module address
+		if _, err := k.CallEVM(ctx, erc1155, sender, contract, true, "safeBatchTransferFrom", sender, types.ModuleAddress, tokenIDs, amounts, []byte{}); err != nil {
+			return nil, err
+		}
+
+		// Mint coins
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, converted); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to mint coins")
+		}
+	default:
+		return nil, types.ErrUndefinedOwner
+	}
+
+	// Send coins to receiver
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, converted); err != nil {
+		return nil, err
+	}
+
+	return converted, nil
+}
+
+// convertERC1155NFTs handles the ERC1155 conversion for a nft token pair:
+//  - native nfts: burn tokens and unescrow nfts that have been previously
+//    escrowed with ConvertMultiToken and send to receiver, removing their
+//    mapping
+//  - native ERC1155: escrow tokens on module address and mint the nfts, with
+//    the nft IDs derived from the token IDs, to the receiver
+func (k Keeper) convertERC1155NFTs(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenIDs []*big.Int,
+	amounts []*big.Int,
+	receiver sdk.AccAddress,
+	sender common.Address,
+) ([]string, error) {
+	erc1155 := contracts.ERC1155PresetMinterPauserContract.ABI
+	contract := pair.GetERC1155Contract()
+
+	for i, amount := range amounts {
+		if amount.Cmp(big.NewInt(1)) != 0 {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "a single unit of erc1155 token %s can be converted to an nft", tokenIDs[i],
+			)
+		}
+	}
+
+	var err error
+	if pair.IsNativeCosmos() {
+		// Burn tokens
+		_, err = k.CallEVM(ctx, erc1155, sender, contract, true, "burnBatch", sender, tokenIDs, amounts)
+	} else {
+		// Escrow tokens on module address
+		_, err = k.CallEVM(ctx, erc1155, sender, contract, true, "safeBatchTransferFrom", sender, types.ModuleAddress, tokenIDs, amounts, []byte{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nftIDs := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		switch {
+		case pair.IsNativeCosmos():
+			nftIDs[i] = k.GetNativeIDByTokenID(ctx, pair, tokenID.String())
+			if nftIDs[i] == "" {
+				return nil, sdkerrors.Wrapf(types.ErrTokenMappingNotFound, "erc1155 token %s", tokenID)
+			}
+
+			// Unescrow nft and send to receiver
+			if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftIDs[i], receiver); err != nil {
+				return nil, err
+			}
+			k.DeleteTokenMapping(ctx, pair, tokenID.String(), nftIDs[i])
+		case pair.IsNativeERC1155():
+			nftIDs[i] = pair.CreateNFTID(tokenID)
+
+			// Mint nft to receiver
+			if err := k.nftKeeper.Mint(ctx, nft.NFT{ClassId: pair.ClassId, Id: nftIDs[i]}, receiver); err != nil {
+				return nil, err
+			}
+			k.SetTokenMapping(ctx, pair, tokenID.String(), nftIDs[i])
+		default:
+			return nil, types.ErrUndefinedOwner
+		}
+	}
+
+	return nftIDs, nil
+}
+
+// joinBigInts returns the comma separated decimal representation of the given
+// integers
+func joinBigInts(values []*big.Int) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = value.String()
+	}
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,348 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+func (suite *KeeperTestSuite) TestConvertNativeCoins() {
+	var pair types.TokenPair
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 50))
+
+	testCases := []struct {
+		name     string
+		malleate func() sdk.Coins
+		expPass  bool
+	}{
+		{
+			"ok - coins of the pair",
+			func() sdk.Coins {
+				return sdk.NewCoins(sdk.NewInt64Coin(denom1, 10), sdk.NewInt64Coin(denom2, 5))
+			},
+			true,
+		},
+		{
+			"fail - pair disabled",
+			func() sdk.Coins {
+				_, err := suite.app.Erc1155Keeper.ToggleConversion(suite.ctx, denom1)
+				suite.Require().NoError(err)
+				return sdk.NewCoins(sdk.NewInt64Coin(denom1, 10))
+			},
+			false,
+		},
+		{
+			"fail - coin not registered in the pair",
+			func() sdk.Coins {
+				suite.fundCoins(sdk.NewCoins(sdk.NewInt64Coin("coin3", 10)))
+				return sdk.NewCoins(sdk.NewInt64Coin(denom1, 10), sdk.NewInt64Coin("coin3", 10))
+			},
+			false,
+		},
+		{
+			"fail - insufficient funds",
+			func() sdk.Coins {
+				return sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000))
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender := sdk.AccAddress(suite.address.Bytes())
+			pair = suite.setupNativeCoinPair(coins)
+			converted := tc.malleate()
+
+			receiver := tests.GenerateAddress()
+			suite.setAccount(receiver)
+			msg := types.NewMsgConvertMultiToken(converted, receiver, sender)
+			_, err := suite.app.Erc1155Keeper.ConvertMultiToken(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// coins escrowed and tokens minted to the receiver
+			moduleAcc := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().Equal(converted, suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAcc))
+			tokenIDs := make([]string, len(converted))
+			amounts := make([]sdk.Int, len(converted))
+			for i, coin := range converted {
+				tokenID := types.CreateTokenID(coin.Denom)
+				suite.Require().Equal(coin.Amount.BigInt(), suite.balanceOf(pair.GetERC1155Contract(), receiver, tokenID))
+				tokenIDs[i] = tokenID.String()
+				amounts[i] = coin.Amount
+			}
+
+			// convert the tokens back: tokens burned and coins unescrowed
+			back := types.NewMsgConvertERC1155(tokenIDs, amounts, sender, pair.GetERC1155Contract(), receiver)
+			_, err = suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), back)
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAcc).IsZero())
+			suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, sender))
+			for _, tokenID := range tokenIDs {
+				id, _ := new(big.Int).SetString(tokenID, 10)
+				suite.Require().Zero(suite.balanceOf(pair.GetERC1155Contract(), receiver, id).Sign())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeNFTs() {
+	var pair types.TokenPair
+
+	testCases := []struct {
+		name     string
+		malleate func() []string
+		expPass  bool
+	}{
+		{
+			"ok - nfts of the sender",
+			func() []string {
+				return []string{"nft1", "2"}
+			},
+			true,
+		},
+		{
+			"fail - nft not exist",
+			func() []string {
+				return []string{"nft1", "nft3"}
+			},
+			false,
+		},
+		{
+			"fail - nft not owned by the sender",
+			func() []string {
+				err := suite.app.NFTKeeper.Transfer(suite.ctx, classID, "2", tests.GenerateAddress().Bytes())
+				suite.Require().NoError(err)
+				return []string{"2"}
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender := sdk.AccAddress(suite.address.Bytes())
+			pair = suite.setupNativeNFTPair("nft1", "2")
+			nftIDs := tc.malleate()
+
+			receiver := tests.GenerateAddress()
+			suite.setAccount(receiver)
+			msg := types.NewMsgConvertMultiTokenNFTs(classID, nftIDs, receiver, sender)
+			_, err := suite.app.Erc1155Keeper.ConvertMultiToken(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// nfts escrowed and tokens minted to the receiver, "2" being kept
+			// as token id 2
+			suite.Require().Equal(big.NewInt(2), types.CreateTokenID("2"))
+			tokenIDs := make([]string, len(nftIDs))
+			amounts := make([]sdk.Int, len(nftIDs))
+			for i, nftID := range nftIDs {
+				tokenID := types.CreateTokenID(nftID)
+				suite.Require().Equal(types.ModuleAddress.Bytes(), suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID).Bytes())
+				suite.Require().Equal(big.NewInt(1), suite.balanceOf(pair.GetERC1155Contract(), receiver, tokenID))
+				suite.Require().Equal(nftID, suite.app.Erc1155Keeper.GetNativeIDByTokenID(suite.ctx, pair, tokenID.String()))
+				tokenIDs[i] = tokenID.String()
+				amounts[i] = sdk.OneInt()
+			}
+
+			// more than a unit of a token can't be converted to an nft
+			invalid := types.NewMsgConvertERC1155(tokenIDs[:1], []sdk.Int{sdk.NewInt(2)}, sender, pair.GetERC1155Contract(), receiver)
+			_, err = suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), invalid)
+			suite.Require().Error(err)
+
+			// convert the tokens back: tokens burned and nfts unescrowed
+			back := types.NewMsgConvertERC1155(tokenIDs, amounts, sender, pair.GetERC1155Contract(), receiver)
+			_, err = suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), back)
+			suite.Require().NoError(err)
+
+			for i, nftID := range nftIDs {
+				id, _ := new(big.Int).SetString(tokenIDs[i], 10)
+				suite.Require().Equal(sender, suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID))
+				suite.Require().Zero(suite.balanceOf(pair.GetERC1155Contract(), receiver, id).Sign())
+			}
+			suite.Require().Empty(suite.app.Erc1155Keeper.GetTokenMappingsByTokenPair(suite.ctx, pair))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeERC1155Coins() {
+	var pair types.TokenPair
+
+	tokenID := big.NewInt(7)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		amount   int64
+		expPass  bool
+	}{
+		{
+			"ok - tokens of the sender",
+			func() {},
+			60,
+			true,
+		},
+		{
+			"fail - pair disabled",
+			func() {
+				_, err := suite.app.Erc1155Keeper.ToggleConversion(suite.ctx, pair.Erc1155Address)
+				suite.Require().NoError(err)
+			},
+			60,
+			false,
+		},
+		{
+			"fail - insufficient token balance",
+			func() {},
+			101,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair = suite.setupNativeERC1155Pair(types.TOKEN_TYPE_COIN, tokenID.Int64(), 100)
+			tc.malleate()
+
+			receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			amount := sdk.NewInt(tc.amount)
+			msg := types.NewMsgConvertERC1155([]string{tokenID.String()}, []sdk.Int{amount}, receiver, pair.GetERC1155Contract(), suite.address)
+			gasMeter := sdk.NewInfiniteGasMeter()
+			_, err := suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx.WithGasMeter(gasMeter)), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().GreaterOrEqual(gasMeter.GasConsumed(), uint64(types.BatchConversionGasPerItem))
+
+			// tokens escrowed and coins of the derived denom minted to the
+			// receiver
+			denom := pair.CreateDenom(tokenID)
+			suite.Require().Equal(denom, suite.app.Erc1155Keeper.GetNativeIDByTokenID(suite.ctx, pair, tokenID.String()))
+			suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, denom))
+			suite.Require().Equal(amount, suite.app.BankKeeper.GetBalance(suite.ctx, receiver, denom).Amount)
+			suite.Require().Equal(big.NewInt(tc.amount), suite.balanceOf(pair.GetERC1155Contract(), types.ModuleAddress, tokenID))
+
+			// convert the coins back: coins burned and tokens unescrowed
+			back := types.NewMsgConvertMultiToken(sdk.NewCoins(sdk.NewCoin(denom, amount)), suite.address, receiver)
+			_, err = suite.app.Erc1155Keeper.ConvertMultiToken(sdk.WrapSDKContext(suite.ctx), back)
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, denom).IsZero())
+			suite.Require().Zero(suite.balanceOf(pair.GetERC1155Contract(), types.ModuleAddress, tokenID).Sign())
+			suite.Require().Equal(big.NewInt(100), suite.balanceOf(pair.GetERC1155Contract(), suite.address, tokenID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeERC1155NFTs() {
+	var pair types.TokenPair
+
+	tokenID := big.NewInt(7)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		amount   int64
+		expPass  bool
+	}{
+		{
+			"ok - a unit of a token",
+			func() {},
+			1,
+			true,
+		},
+		{
+			"fail - more than a unit of a token",
+			func() {},
+			2,
+			false,
+		},
+		{
+			"fail - pair disabled",
+			func() {
+				_, err := suite.app.Erc1155Keeper.ToggleConversion(suite.ctx, pair.ClassId)
+				suite.Require().NoError(err)
+			},
+			1,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair = suite.setupNativeERC1155Pair(types.TOKEN_TYPE_NFT, tokenID.Int64(), 1)
+			tc.malleate()
+
+			receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			msg := types.NewMsgConvertERC1155([]string{tokenID.String()}, []sdk.Int{sdk.NewInt(tc.amount)}, receiver, pair.GetERC1155Contract(), suite.address)
+			_, err := suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// token escrowed and nft of the derived nft ID minted to the
+			// receiver
+			nftID := pair.CreateNFTID(tokenID)
+			suite.Require().Equal(receiver, suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassId, nftID))
+			suite.Require().Equal(nftID, suite.app.Erc1155Keeper.GetNativeIDByTokenID(suite.ctx, pair, tokenID.String()))
+			suite.Require().Equal(big.NewInt(1), suite.balanceOf(pair.GetERC1155Contract(), types.ModuleAddress, tokenID))
+
+			// convert the nft back: nft burned and token unescrowed
+			back := types.NewMsgConvertMultiTokenNFTs(pair.ClassId, []string{nftID}, suite.address, receiver)
+			_, err = suite.app.Erc1155Keeper.ConvertMultiToken(sdk.WrapSDKContext(suite.ctx), back)
+			suite.Require().NoError(err)
+
+			suite.Require().False(suite.app.NFTKeeper.HasNFT(suite.ctx, pair.ClassId, nftID))
+			suite.Require().Empty(suite.app.Erc1155Keeper.GetTokenMappingsByTokenPair(suite.ctx, pair))
+			suite.Require().Zero(suite.balanceOf(pair.GetERC1155Contract(), types.ModuleAddress, tokenID).Sign())
+			suite.Require().Equal(big.NewInt(1), suite.balanceOf(pair.GetERC1155Contract(), suite.address, tokenID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertSelfDestructedPair() {
+	pair := suite.setupNativeERC1155Pair(types.TOKEN_TYPE_COIN, 1, 10)
+	suite.Require().NoError(suite.app.EvmKeeper.DeleteAccount(suite.ctx, pair.GetERC1155Contract()))
+
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := types.NewMsgConvertERC1155([]string{"1"}, []sdk.Int{sdk.NewInt(10)}, receiver, pair.GetERC1155Contract(), suite.address)
+	_, err := suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// the pair is deleted without converting
+	_, found := suite.app.Erc1155Keeper.GetTokenPair(suite.ctx, pair.GetID())
+	suite.Require().False(found)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, receiver).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestConvertERC1155DuplicatedTokenID() {
+	pair := suite.setupNativeERC1155Pair(types.TOKEN_TYPE_COIN, 1, 10)
+
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := types.NewMsgConvertERC1155([]string{"1", "01"}, []sdk.Int{sdk.NewInt(2), sdk.NewInt(3)}, receiver, pair.GetERC1155Contract(), suite.address)
+	suite.Require().Error(msg.ValidateBasic())
+
+	// the amounts of a token id converted twice are added up
+	msg.TokenIds[1] = "1"
+	_, err := suite.app.Erc1155Keeper.ConvertERC1155(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(5), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, pair.CreateDenom(big.NewInt(1))).Amount)
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// RegisterCoins deploys an erc1155 contract and creates the token pair for the
+// existing cosmos coins, each mapped to the token id derived from its denom
+func (k Keeper) RegisterCoins(ctx sdk.Context, denoms []string, uri string) (*types.TokenPair, error) {
+	// Check if the conversion is globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableErc1155 {
+		return nil, sdkerrors.Wrap(
+			types.ErrERC1155Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	for _, denom := range denoms {
+		if !k.bankKeeper.HasSupply(ctx, denom) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins, "base denomination '%s' cannot have a supply of 0", denom,
+			)
+		}
+
+		// Check if denom is already registered
+		if k.IsDenomRegistered(ctx, denom) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", denom,
+			)
+		}
+	}
+
+	addr, err := k.DeployERC1155Contract(ctx, uri)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to deploy ERC1155 contract for the coins")
+	}
+
+	pair := types.NewTokenPair(addr, types.TOKEN_TYPE_COIN, "", types.OWNER_MODULE)
+	k.SetTokenPair(ctx, pair)
+	k.SetERC1155Map(ctx, addr, pair.GetID())
+
+	for _, denom := range denoms {
+		k.SetTokenMapping(ctx, pair, types.CreateTokenID(denom).String(), denom)
+	}
+
+	return &pair, nil
+}
+
+// RegisterNFTClass deploys an erc1155 contract and creates the token pair for
+// the existing nft class, each nft being mapped to the token id derived from
+// its nft ID
+func (k Keeper) RegisterNFTClass(ctx sdk.Context, classID string, uri string) (*types.TokenPair, error) {
+	// Check if the conversion is globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableErc1155 {
+		return nil, sdkerrors.Wrap(
+			types.ErrERC1155Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrClassNotExist, "nft class not exist: %s", classID,
+		)
+	}
+
+	// Check if class is already registered
+	if k.IsClassRegistered(ctx, classID) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrTokenPairAlreadyExists, "class ID already registered: %s", classID,
+		)
+	}
+
+	addr, err := k.DeployERC1155Contract(ctx, uri)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to deploy ERC1155 contract for class %s", classID)
+	}
+
+	pair := types.NewTokenPair(addr, types.TOKEN_TYPE_NFT, classID, types.OWNER_MODULE)
+	k.SetTokenPair(ctx, pair)
+	k.SetERC1155Map(ctx, addr, pair.GetID())
+	k.SetClassMap(ctx, classID, pair.GetID())
+
+	return &pair, nil
+}
+
+// RegisterERC1155 registers the token pair between an ERC1155 contract and the
+// Cosmos coins or the nft class its token ids are mapped to
+func (k Keeper) RegisterERC1155(ctx sdk.Context, contract common.Address, tokenType types.TokenType) (*types.TokenPair, error) {
+	// Check if the conversion is globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableErc1155 {
+		return nil, sdkerrors.Wrap(types.ErrERC1155Disabled, "registration is currently disabled by governance")
+	}
+
+	// Check if ERC1155 is already registered
+	if k.IsERC1155Registered(ctx, contract) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairAlreadyExists, "token ERC1155 contract already registered: %s", contract.String())
+	}
+
+	supported, err := k.QueryERC1155SupportsInterface(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "contract %s doesn't implement ERC1155", contract)
+	}
+
+	var classID string
+	switch tokenType {
+	case types.TOKEN_TYPE_COIN:
+		// the denoms are mapped on the first conversion of each token id
+	case types.TOKEN_TYPE_NFT:
+		class, err := k.CreateNFTClass(ctx, contract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to create nft class for ERC1155")
+		}
+		classID = class.Id
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenType, "%s", tokenType)
+	}
+
+	pair := types.NewTokenPair(contract, tokenType, classID, types.OWNER_EXTERNAL)
+	k.SetTokenPair(ctx, pair)
+	k.SetERC1155Map(ctx, contract, pair.GetID())
+	if pair.IsNFT() {
+		k.SetClassMap(ctx, classID, pair.GetID())
+	}
+
+	return &pair, nil
+}
+
+// CreateNFTClass creates the nft class representing the token ids of an
+// ERC1155 contract
+func (k Keeper) CreateNFTClass(ctx sdk.Context, contract common.Address) (*nft.Class, error) {
+	classID := types.CreateClassID(contract.String())
+
+	// Check if class already exists
+	if k.nftKeeper.HasClass(ctx, classID) {
+		return nil, sdkerrors.Wrap(types.ErrInternalTokenPair, "class already exist")
+	}
+
+	if k.IsClassRegistered(ctx, classID) {
+		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "nft class already registered: %s", classID)
+	}
+
+	class := nft.Class{
+		Id:          classID,
+		Description: "internal nft from erc1155",
+	}
+
+	if err := k.nftKeeper.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	return &class, nil
+}
+
+// ToggleConversion toggles conversion for a given token pair
+func (k Keeper) ToggleConversion(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	pair.Enabled = !pair.Enabled
+
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCoins() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - conversion disabled",
+			func() {
+				suite.fundCoins(sdk.NewCoins(sdk.NewInt64Coin(denom1, 10)))
+				suite.app.Erc1155Keeper.SetParams(suite.ctx, types.NewParams(false))
+			},
+			false,
+		},
+		{
+			"fail - denom without supply",
+			func() {},
+			false,
+		},
+		{
+			"fail - denom already registered",
+			func() {
+				suite.setupNativeCoinPair(sdk.NewCoins(sdk.NewInt64Coin(denom1, 10)))
+			},
+			false,
+		},
+		{
+			"ok",
+			func() {
+				suite.fundCoins(sdk.NewCoins(sdk.NewInt64Coin(denom1, 10)))
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			pair, err := suite.app.Erc1155Keeper.RegisterCoins(suite.ctx, []string{denom1}, erc1155URI)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().True(pair.IsNativeCosmos())
+			suite.Require().True(pair.IsCoin())
+			suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, pair.Erc1155Address))
+			suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, denom1))
+
+			tokenID := types.CreateTokenID(denom1).String()
+			suite.Require().Equal(tokenID, suite.app.Erc1155Keeper.GetTokenIDByNativeID(suite.ctx, *pair, denom1))
+			suite.Require().Equal(denom1, suite.app.Erc1155Keeper.GetNativeIDByTokenID(suite.ctx, *pair, tokenID))
+
+			supported, err := suite.app.Erc1155Keeper.QueryERC1155SupportsInterface(suite.ctx, pair.GetERC1155Contract())
+			suite.Require().NoError(err)
+			suite.Require().True(supported)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterNFTClass() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - conversion disabled",
+			func() {
+				suite.saveClass()
+				suite.app.Erc1155Keeper.SetParams(suite.ctx, types.NewParams(false))
+			},
+			false,
+		},
+		{
+			"fail - class not exist",
+			func() {},
+			false,
+		},
+		{
+			"fail - class already registered",
+			func() {
+				suite.setupNativeNFTPair()
+			},
+			false,
+		},
+		{
+			"ok",
+			func() {
+				suite.saveClass()
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			pair, err := suite.app.Erc1155Keeper.RegisterNFTClass(suite.ctx, classID, erc1155URI)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().True(pair.IsNativeCosmos())
+			suite.Require().True(pair.IsNFT())
+			suite.Require().Equal(classID, pair.ClassId)
+			suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, pair.Erc1155Address))
+			suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, classID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC1155() {
+	var contract common.Address
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		tokenType types.TokenType
+		expPass   bool
+	}{
+		{
+			"fail - conversion disabled",
+			func() {
+				contract = suite.deployERC1155()
+				suite.app.Erc1155Keeper.SetParams(suite.ctx, types.NewParams(false))
+			},
+			types.TOKEN_TYPE_COIN,
+			false,
+		},
+		{
+			"fail - contract already registered",
+			func() {
+				contract = suite.setupNativeERC1155Pair(types.TOKEN_TYPE_COIN, 1, 10).GetERC1155Contract()
+			},
+			types.TOKEN_TYPE_COIN,
+			false,
+		},
+		{
+			"fail - not a contract",
+			func() {
+				contract = tests.GenerateAddress()
+			},
+			types.TOKEN_TYPE_COIN,
+			false,
+		},
+		{
+			"fail - contract doesn't implement ERC1155",
+			func() {
+				contract = suite.deployContract(contracts.ERC721PresetMinterPauserAutoIdsContract, "Token", "TKN", "")
+			},
+			types.TOKEN_TYPE_COIN,
+			false,
+		},
+		{
+			"fail - undefined token type",
+			func() {
+				contract = suite.deployERC1155()
+			},
+			types.TOKEN_TYPE_UNSPECIFIED,
+			false,
+		},
+		{
+			"ok - coin token type",
+			func() {
+				contract = suite.deployERC1155()
+			},
+			types.TOKEN_TYPE_COIN,
+			true,
+		},
+		{
+			"ok - nft token type",
+			func() {
+				contract = suite.deployERC1155()
+			},
+			types.TOKEN_TYPE_NFT,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			pair, err := suite.app.Erc1155Keeper.RegisterERC1155(suite.ctx, contract, tc.tokenType)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().True(pair.IsNativeERC1155())
+			suite.Require().Equal(tc.tokenType, pair.TokenType)
+			suite.Require().Equal(contract, pair.GetERC1155Contract())
+			suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, contract.String()))
+
+			if pair.IsNFT() {
+				suite.Require().Equal(types.CreateClassID(contract.String()), pair.ClassId)
+				suite.Require().True(suite.app.NFTKeeper.HasClass(suite.ctx, pair.ClassId))
+				suite.Require().Equal(pair.GetID(), suite.app.Erc1155Keeper.GetTokenPairID(suite.ctx, pair.ClassId))
+			} else {
+				suite.Require().Empty(pair.ClassId)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestToggleConversion() {
+	testCases := []struct {
+		name       string
+		malleate   func() string
+		expPass    bool
+		expEnabled bool
+	}{
+		{
+			"fail - token not registered",
+			func() string {
+				return denom1
+			},
+			false,
+			false,
+		},
+		{
+			"ok - disable coin pair by denom",
+			func() string {
+				suite.setupNativeCoinPair(sdk.NewCoins(sdk.NewInt64Coin(denom1, 10)))
+				return denom1
+			},
+			true,
+			false,
+		},
+		{
+			"ok - disable nft pair by contract",
+			func() string {
+				return suite.setupNativeNFTPair().Erc1155Address
+			},
+			true,
+			false,
+		},
+		{
+			"ok - enable disabled pair by class",
+			func() string {
+				suite.setupNativeNFTPair()
+				_, err := suite.app.Erc1155Keeper.ToggleConversion(suite.ctx, classID)
+				suite.Require().NoError(err)
+				return classID
+			},
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			token := tc.malleate()
+
+			pair, err := suite.app.Erc1155Keeper.ToggleConversion(suite.ctx, token)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expEnabled, pair.Enabled)
+
+			stored, found := suite.app.Erc1155Keeper.GetTokenPair(suite.ctx, pair.GetID())
+			suite.Require().True(found)
+			suite.Require().Equal(pair, stored)
+		})
+	}
+}
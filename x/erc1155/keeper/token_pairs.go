@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// GetTokenPairs - get all registered token tokenPairs
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+
+		tokenPairs = append(tokenPairs, tokenPair)
+	}
+
+	return tokenPairs
+}
+
+// GetTokenPairID returns the pair id from either of the registered tokens:
+// the ERC1155 contract, a nft class or a coin denom.
+func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		addr := common.HexToAddress(token)
+		return k.GetERC1155Map(ctx, addr)
+	}
+	if id := k.GetClassMap(ctx, token); len(id) != 0 {
+		return id
+	}
+	return k.GetDenomMap(ctx, token)
+}
+
+// GetTokenPair - get registered token pair from the identifier
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	var tokenPair types.TokenPair
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// SetTokenPair stores a token pair
+func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	key := tokenPair.GetID()
+	bz := k.cdc.MustMarshal(&tokenPair)
+	store.Set(key, bz)
+}
+
+// DeleteTokenPair removes a token pair.
+func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	key := tokenPair.GetID()
+	store.Delete(key)
+}
+
+// GetERC1155Map returns the token pair id for the given address
+func (k Keeper) GetERC1155Map(ctx sdk.Context, erc1155 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC1155)
+	return store.Get(erc1155.Bytes())
+}
+
+// SetERC1155Map sets the token pair id for the given address
+func (k Keeper) SetERC1155Map(ctx sdk.Context, erc1155 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC1155)
+	store.Set(erc1155.Bytes(), id)
+}
+
+// GetClassMap returns the token pair id for the given class
+func (k Keeper) GetClassMap(ctx sdk.Context, classID string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	return store.Get([]byte(classID))
+}
+
+// SetClassMap sets the token pair id for the classID
+func (k Keeper) SetClassMap(ctx sdk.Context, classID string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	store.Set([]byte(classID), id)
+}
+
+// GetDenomMap returns the token pair id for the given denom
+func (k Keeper) GetDenomMap(ctx sdk.Context, denom string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	return store.Get([]byte(denom))
+}
+
+// SetDenomMap sets the token pair id for the denom
+func (k Keeper) SetDenomMap(ctx sdk.Context, denom string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	store.Set([]byte(denom), id)
+}
+
+// IsERC1155Registered check if registered ERC1155 token is registered
+func (k Keeper) IsERC1155Registered(ctx sdk.Context, erc1155 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC1155)
+	return store.Has(erc1155.Bytes())
+}
+
+// IsClassRegistered check if registered nft class is registered
+func (k Keeper) IsClassRegistered(ctx sdk.Context, classID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	return store.Has([]byte(classID))
+}
+
+// IsDenomRegistered check if registered coin denom is registered
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	return store.Has([]byte(denom))
+}
+
+// SetTokenMapping stores the mapping between a token id of a token pair and
+// its bank denom or nft ID. The denoms of coin token pairs are also mapped to
+// the token pair.
+func (k Keeper) SetTokenMapping(ctx sdk.Context, pair types.TokenPair, tokenID string, nativeID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByTokenID)
+	store.Set(types.TokenMappingByTokenIDKey(pair.GetID(), tokenID), []byte(nativeID))
+
+	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByNativeID)
+	store.Set(types.TokenMappingByNativeIDKey(pair.GetID(), nativeID), []byte(tokenID))
+
+	if pair.IsCoin() {
+		k.SetDenomMap(ctx, nativeID, pair.GetID())
+	}
+}
+
+// DeleteTokenMapping removes the mapping between a token id of a token pair
+// and its nft ID, once the nft is no longer converted
+func (k Keeper) DeleteTokenMapping(ctx sdk.Context, pair types.TokenPair, tokenID string, nativeID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByTokenID)
+	store.Delete(types.TokenMappingByTokenIDKey(pair.GetID(), tokenID))
+
+	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByNativeID)
+	store.Delete(types.TokenMappingByNativeIDKey(pair.GetID(), nativeID))
+}
+
+// GetNativeIDByTokenID returns the bank denom or nft ID mapped to the given
+// token id of a token pair
+func (k Keeper) GetNativeIDByTokenID(ctx sdk.Context, pair types.TokenPair, tokenID string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByTokenID)
+	return string(store.Get(types.TokenMappingByTokenIDKey(pair.GetID(), tokenID)))
+}
+
+// GetTokenIDByNativeID returns the token id mapped to the given bank denom or
+// nft ID of a token pair
+func (k Keeper) GetTokenIDByNativeID(ctx sdk.Context, pair types.TokenPair, nativeID string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByNativeID)
+	return string(store.Get(types.TokenMappingByNativeIDKey(pair.GetID(), nativeID)))
+}
+
+// GetTokenMappings returns the token mappings of all the token pairs
+func (k Keeper) GetTokenMappings(ctx sdk.Context) []types.TokenMapping {
+	mappings := []types.TokenMapping{}
+
+	for _, pair := range k.GetTokenPairs(ctx) {
+		mappings = append(mappings, k.GetTokenMappingsByTokenPair(ctx, pair)...)
+	}
+
+	return mappings
+}
+
+// GetTokenMappingsByTokenPair returns the token mappings of the given token
+// pair
+func (k Keeper) GetTokenMappingsByTokenPair(ctx sdk.Context, pair types.TokenPair) []types.TokenMapping {
+	mappings := []types.TokenMapping{}
+
+	pairID := pair.GetID()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenMappingByTokenID)
+	iterator := sdk.KVStorePrefixIterator(store, pairID)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		mappings = append(mappings, newTokenMapping(pair, string(iterator.Key()[len(pairID):]), string(iterator.Value())))
+	}
+
+	return mappings
+}
+
+// newTokenMapping returns the mapping of a token id to the given bank denom or
+// nft ID, depending on the token type of the pair
+func newTokenMapping(pair types.TokenPair, tokenID, nativeID string) types.TokenMapping {
+	mapping := types.TokenMapping{
+		Erc1155Address: pair.Erc1155Address,
+		TokenId:        tokenID,
+	}
+	if pair.IsCoin() {
+		mapping.Denom = nativeID
+	} else {
+		mapping.NftId = nativeID
+	}
+	return mapping
+}
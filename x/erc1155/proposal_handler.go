@@ -0,0 +1,99 @@
+package erc1155
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc1155/keeper"
+	"github.com/UptickNetwork/uptick/x/erc1155/types"
+)
+
+// NewErc1155ProposalHandler creates a governance handler to manage new proposal types.
+func NewErc1155ProposalHandler(k *keeper.Keeper) gov.Handler {
+	return func(ctx sdk.Context, content gov.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterCoinsProposal:
+			return handleRegisterCoinsProposal(ctx, k, c)
+		case *types.RegisterNFTClassProposal:
+			return handleRegisterNFTClassProposal(ctx, k, c)
+		case *types.RegisterERC1155Proposal:
+			return handleRegisterERC1155Proposal(ctx, k, c)
+		case *types.ToggleTokenConversionProposal:
+			return handleToggleConversionProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleRegisterCoinsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterCoinsProposal) error {
+	pair, err := k.RegisterCoins(ctx, p.Denoms, p.Uri)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoins,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoins, strings.Join(p.Denoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyERC1155Token, pair.Erc1155Address),
+		),
+	)
+
+	return nil
+}
+
+func handleRegisterNFTClassProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterNFTClassProposal) error {
+	pair, err := k.RegisterNFTClass(ctx, p.ClassId, p.Uri)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterNFTClass,
+			sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC1155Token, pair.Erc1155Address),
+		),
+	)
+
+	return nil
+}
+
+func handleRegisterERC1155Proposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterERC1155Proposal) error {
+	pair, err := k.RegisterERC1155(ctx, common.HexToAddress(p.Erc1155Address), p.TokenType)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC1155,
+			sdk.NewAttribute(types.AttributeKeyTokenType, pair.TokenType.String()),
+			sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC1155Token, pair.Erc1155Address),
+		),
+	)
+
+	return nil
+}
+
+func handleToggleConversionProposal(ctx sdk.Context, k *keeper.Keeper, p *types.ToggleTokenConversionProposal) error {
+	pair, err := k.ToggleConversion(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleTokenConversion,
+			sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC1155Token, pair.Erc1155Address),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrERC1155Disabled          = sdkerrors.Register(ModuleName, 2, "erc1155 module is disabled")
+	ErrClassNotExist            = sdkerrors.Register(ModuleName, 3, "nft class not exist")
+	ErrNFTNotExist              = sdkerrors.Register(ModuleName, 4, "nft not exist")
+	ErrInternalTokenPair        = sdkerrors.Register(ModuleName, 5, "internal erc1155 token mapping error")
+	ErrTokenPairNotFound        = sdkerrors.Register(ModuleName, 6, "token pair not found")
+	ErrTokenPairAlreadyExists   = sdkerrors.Register(ModuleName, 7, "token pair already exists")
+	ErrUndefinedOwner           = sdkerrors.Register(ModuleName, 8, "undefined owner of contract pair")
+	ErrABIPack                  = sdkerrors.Register(ModuleName, 9, "contract ABI pack failed")
+	ErrABIUnpack                = sdkerrors.Register(ModuleName, 10, "contract ABI unpack failed")
+	ErrEVMCall                  = sdkerrors.Register(ModuleName, 11, "EVM call unexpected error")
+	ErrERC1155TokenPairDisabled = sdkerrors.Register(ModuleName, 12, "erc1155 token pair is disabled")
+	ErrInvalidTokenType         = sdkerrors.Register(ModuleName, 13, "invalid token type")
+	ErrTokenMappingNotFound     = sdkerrors.Register(ModuleName, 14, "token mapping not found")
+)
@@ -0,0 +1,20 @@
+package types
+
+// erc1155 events
+const (
+	EventTypeConvertMultiToken     = "convert_multi_token"
+	EventTypeConvertERC1155        = "convert_erc1155"
+	EventTypeRegisterCoins         = "register_coins"
+	EventTypeRegisterNFTClass      = "register_nft_class"
+	EventTypeRegisterERC1155       = "register_erc1155"
+	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+
+	AttributeKeyCosmosCoins     = "cosmos_coins"
+	AttributeKeyNFTClass        = "nft_class"
+	AttributeKeyNFTIDs          = "nft_ids"
+	AttributeKeyERC1155Token    = "erc1155_token"     // #nosec
+	AttributeKeyERC1155TokenIDs = "erc1155_token_ids" // #nosec
+	AttributeKeyAmounts         = "amounts"
+	AttributeKeyTokenType       = "token_type"
+	AttributeKeyReceiver        = "receiver"
+)
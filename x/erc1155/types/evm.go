@@ -0,0 +1,16 @@
+package types
+
+import "math/big"
+
+// ERC1155InterfaceID is the ERC165 interface id of the ERC1155 standard
+var ERC1155InterfaceID = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+
+// ERC1155BoolResponse defines the bool value from the call response
+type ERC1155BoolResponse struct {
+	Value bool
+}
+
+// ERC1155BalanceResponse defines the balance value from the call response
+type ERC1155BalanceResponse struct {
+	Value *big.Int
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pairs []TokenPair, mappings []TokenMapping) GenesisState {
+	return GenesisState{
+		Params:        params,
+		TokenPairs:    pairs,
+		TokenMappings: mappings,
+	}
+}
+
+// DefaultGenesisState sets default evm genesis state with empty accounts and
+// default params and chain config values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenErc1155 := make(map[string]bool)
+	seenClass := make(map[string]bool)
+	pairByContract := make(map[string]TokenPair)
+
+	for _, b := range gs.TokenPairs {
+		if seenErc1155[b.Erc1155Address] {
+			return fmt.Errorf("token ERC1155 contract duplicated on genesis '%s'", b.Erc1155Address)
+		}
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if b.IsNFT() {
+			if seenClass[b.ClassId] {
+				return fmt.Errorf("nft class duplicated on genesis: '%s'", b.ClassId)
+			}
+			seenClass[b.ClassId] = true
+		}
+		seenErc1155[b.Erc1155Address] = true
+		pairByContract[b.Erc1155Address] = b
+	}
+
+	seenToken := make(map[string]bool)
+	seenDenom := make(map[string]bool)
+	seenNFT := make(map[string]bool)
+
+	for _, m := range gs.TokenMappings {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		pair, found := pairByContract[m.Erc1155Address]
+		if !found {
+			return fmt.Errorf("token mapping contract '%s' is not a registered token pair", m.Erc1155Address)
+		}
+		if pair.IsCoin() != (m.Denom != "") {
+			return fmt.Errorf("token mapping %s/%s doesn't match the token type of its pair", m.Erc1155Address, m.TokenId)
+		}
+
+		tokenKey := m.Erc1155Address + "/" + m.TokenId
+		if seenToken[tokenKey] {
+			return fmt.Errorf("token id duplicated on genesis token mappings: '%s'", tokenKey)
+		}
+		seenToken[tokenKey] = true
+
+		if m.Denom != "" {
+			if seenDenom[m.Denom] {
+				return fmt.Errorf("denom duplicated on genesis token mappings: '%s'", m.Denom)
+			}
+			seenDenom[m.Denom] = true
+		} else {
+			nftKey := pair.ClassId + "/" + m.NftId
+			if seenNFT[nftKey] {
+				return fmt.Errorf("nft duplicated on genesis token mappings: '%s'", nftKey)
+			}
+			seenNFT[nftKey] = true
+		}
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	erc1155Address  = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+	erc1155Address2 = "0xB8c77482e45F1F44dE1745F52C74426C631bDD52"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	newGen := NewGenesisState(DefaultParams(), []TokenPair{}, []TokenMapping{})
+
+	coinPair := TokenPair{
+		Erc1155Address: erc1155Address,
+		Enabled:        true,
+		ContractOwner:  OWNER_MODULE,
+		TokenType:      TOKEN_TYPE_COIN,
+	}
+	nftPair := TokenPair{
+		Erc1155Address: erc1155Address2,
+		Enabled:        true,
+		ContractOwner:  OWNER_EXTERNAL,
+		TokenType:      TOKEN_TYPE_NFT,
+		ClassId:        "classid",
+	}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "valid genesis constructor",
+			genState: &newGen,
+			expPass:  true,
+		},
+		{
+			name:     "default",
+			genState: DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			name: "valid genesis - with token pairs and mappings",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair, nftPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address, TokenId: "1", Denom: "coin1"},
+					{Erc1155Address: erc1155Address, TokenId: "2", Denom: "coin2"},
+					{Erc1155Address: erc1155Address2, TokenId: "1", NftId: "nft1"},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated token pair",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair, coinPair},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated nft class",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					nftPair,
+					{
+						Erc1155Address: erc1155Address,
+						Enabled:        true,
+						ContractOwner:  OWNER_EXTERNAL,
+						TokenType:      TOKEN_TYPE_NFT,
+						ClassId:        nftPair.ClassId,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc1155Address: erc1155Address,
+						Enabled:        true,
+						ContractOwner:  OWNER_MODULE,
+						TokenType:      TOKEN_TYPE_COIN,
+						ClassId:        "classid",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - mapping of an unregistered contract",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address2, TokenId: "1", Denom: "coin1"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - nft mapping of a coin pair",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address, TokenId: "1", NftId: "nft1"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - coin mapping of an nft pair",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{nftPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address2, TokenId: "1", Denom: "coin1"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated token id",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address, TokenId: "1", Denom: "coin1"},
+					{Erc1155Address: erc1155Address, TokenId: "1", Denom: "coin2"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated denom",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address, TokenId: "1", Denom: "coin1"},
+					{Erc1155Address: erc1155Address, TokenId: "2", Denom: "coin1"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated nft",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{nftPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address2, TokenId: "1", NftId: "nft1"},
+					{Erc1155Address: erc1155Address2, TokenId: "2", NftId: "nft1"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid token id",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address, TokenId: "-1", Denom: "coin1"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - mapping without native id",
+			genState: &GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{coinPair},
+				TokenMappings: []TokenMapping{
+					{Erc1155Address: erc1155Address, TokenId: "1"},
+				},
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
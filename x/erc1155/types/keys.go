@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "erc1155"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for EVM
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+// prefix bytes for the EVM persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByERC1155
+	prefixTokenPairByClass
+	prefixTokenPairByDenom
+	prefixTokenMappingByTokenID
+	prefixTokenMappingByNativeID
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair              = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC1155     = []byte{prefixTokenPairByERC1155}
+	KeyPrefixTokenPairByClass       = []byte{prefixTokenPairByClass}
+	KeyPrefixTokenPairByDenom       = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenMappingByTokenID  = []byte{prefixTokenMappingByTokenID}
+	KeyPrefixTokenMappingByNativeID = []byte{prefixTokenMappingByNativeID}
+)
+
+// TokenMappingByTokenIDKey returns the key of the token mapping for the given
+// token pair ID and ERC1155 token ID, relative to KeyPrefixTokenMappingByTokenID
+func TokenMappingByTokenIDKey(pairID []byte, tokenID string) []byte {
+	return append(append([]byte{}, pairID...), []byte(tokenID)...)
+}
+
+// TokenMappingByNativeIDKey returns the key of the token mapping for the given
+// token pair ID and bank denom or nft ID, relative to
+// KeyPrefixTokenMappingByNativeID
+func TokenMappingByNativeIDKey(pairID []byte, nativeID string) []byte {
+	return append(append([]byte{}, pairID...), []byte(nativeID)...)
+}
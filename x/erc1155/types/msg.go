@@ -0,0 +1,181 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertMultiToken{}
+	_ sdk.Msg = &MsgConvertERC1155{}
+)
+
+const (
+	TypeMsgConvertMultiToken = "convert_multi_token"
+	TypeMsgConvertERC1155    = "convert_ERC1155"
+
+	// MaxBatchSize is the maximum number of tokens converted by a msg
+	MaxBatchSize = 500
+	// BatchConversionGasPerItem is the gas consumed by a msg for each
+	// converted token, covering the EVM execution of the conversion
+	BatchConversionGasPerItem = 50_000
+)
+
+// NewMsgConvertMultiToken creates a new instance of MsgConvertMultiToken
+// converting native Cosmos coins
+func NewMsgConvertMultiToken(coins sdk.Coins, receiver common.Address, sender sdk.AccAddress) *MsgConvertMultiToken { // nolint: interfacer
+	return &MsgConvertMultiToken{
+		Coins:    coins,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// NewMsgConvertMultiTokenNFTs creates a new instance of MsgConvertMultiToken
+// converting native Cosmos nfts
+func NewMsgConvertMultiTokenNFTs(classID string, nftIDs []string, receiver common.Address, sender sdk.AccAddress) *MsgConvertMultiToken { // nolint: interfacer
+	return &MsgConvertMultiToken{
+		ClassId:  classID,
+		NftIds:   nftIDs,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertMultiToken) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertMultiToken) Type() string { return TypeMsgConvertMultiToken }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertMultiToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+
+	switch {
+	case len(msg.Coins) != 0 && msg.ClassId == "" && len(msg.NftIds) == 0:
+		if !msg.Coins.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s", msg.Coins)
+		}
+		if err := validateBatchSize(len(msg.Coins)); err != nil {
+			return err
+		}
+	case len(msg.Coins) == 0 && msg.ClassId != "" && len(msg.NftIds) != 0:
+		if err := nft.ValidateClassID(msg.ClassId); err != nil {
+			return err
+		}
+		if err := validateBatchSize(len(msg.NftIds)); err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(msg.NftIds))
+		for _, nftID := range msg.NftIds {
+			if err := nft.ValidateNFTID(nftID); err != nil {
+				return err
+			}
+			if seen[nftID] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated nft id %s", nftID)
+			}
+			seen[nftID] = true
+		}
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either coins or an nft class and nft ids must be converted")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertMultiToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertMultiToken) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC1155 creates a new instance of MsgConvertERC1155
+func NewMsgConvertERC1155(tokenIDs []string, amounts []sdk.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC1155 { // nolint: interfacer
+	return &MsgConvertERC1155{
+		ContractAddress: contract.String(),
+		TokenIds:        tokenIDs,
+		Amounts:         amounts,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC1155) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC1155) Type() string { return TypeMsgConvertERC1155 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC1155) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+
+	if len(msg.TokenIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no token ids to convert")
+	}
+	if len(msg.TokenIds) != len(msg.Amounts) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d token ids and %d amounts", len(msg.TokenIds), len(msg.Amounts))
+	}
+	if err := validateBatchSize(len(msg.TokenIds)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(msg.TokenIds))
+	for i, tokenID := range msg.TokenIds {
+		if err := ValidateTokenID(tokenID); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if seen[tokenID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated token id %s", tokenID)
+		}
+		seen[tokenID] = true
+
+		if msg.Amounts[i].IsNil() || !msg.Amounts[i].IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount of token id %s", tokenID)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC1155) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC1155) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// validateBatchSize checks that the number of tokens converted by a msg is
+// within the batch size limit
+func validateBatchSize(size int) error {
+	if size > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch size %d exceeds the maximum %d", size, MaxBatchSize)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC1155ValidateBasic() {
+	receiver := sdk.AccAddress(common.HexToAddress(erc1155Address).Bytes())
+	sender := common.HexToAddress(erc1155Address2)
+
+	batch := make([]string, MaxBatchSize+1)
+	batchAmounts := make([]sdk.Int, MaxBatchSize+1)
+	for i := range batch {
+		batch[i] = sdk.NewInt(int64(i)).String()
+		batchAmounts[i] = sdk.OneInt()
+	}
+
+	testCases := []struct {
+		name     string
+		tokenIDs []string
+		amounts  []sdk.Int
+		expPass  bool
+	}{
+		{"ok", []string{"1", "10"}, []sdk.Int{sdk.OneInt(), sdk.NewInt(2)}, true},
+		{"fail - no token ids", []string{}, []sdk.Int{}, false},
+		{"fail - token ids and amounts mismatch", []string{"1"}, []sdk.Int{sdk.OneInt(), sdk.OneInt()}, false},
+		{"fail - duplicated token id", []string{"1", "1"}, []sdk.Int{sdk.OneInt(), sdk.OneInt()}, false},
+		{"fail - token id with leading zeros", []string{"1", "01"}, []sdk.Int{sdk.OneInt(), sdk.OneInt()}, false},
+		{"fail - token id with sign", []string{"+1"}, []sdk.Int{sdk.OneInt()}, false},
+		{"fail - non positive amount", []string{"1"}, []sdk.Int{sdk.ZeroInt()}, false},
+		{"fail - batch size exceeded", batch, batchAmounts, false},
+	}
+	for _, tc := range testCases {
+		msg := NewMsgConvertERC1155(tc.tokenIDs, tc.amounts, receiver, common.HexToAddress(erc1155Address), sender)
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertMultiTokenValidateBasic() {
+	receiver := common.HexToAddress(erc1155Address)
+	sender := sdk.AccAddress(common.HexToAddress(erc1155Address2).Bytes())
+
+	batch := make([]string, MaxBatchSize+1)
+	for i := range batch {
+		batch[i] = sdk.NewInt(int64(i)).String()
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertMultiToken
+		expPass bool
+	}{
+		{"ok - coins", NewMsgConvertMultiToken(sdk.NewCoins(sdk.NewInt64Coin("coin", 1)), receiver, sender), true},
+		{"ok - nfts", NewMsgConvertMultiTokenNFTs("classid", []string{"nft1", "nft2"}, receiver, sender), true},
+		{"fail - nothing to convert", NewMsgConvertMultiToken(sdk.Coins{}, receiver, sender), false},
+		{"fail - duplicated nft id", NewMsgConvertMultiTokenNFTs("classid", []string{"nft1", "nft1"}, receiver, sender), false},
+		{"fail - batch size exceeded", NewMsgConvertMultiTokenNFTs("classid", batch, receiver, sender), false},
+	}
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
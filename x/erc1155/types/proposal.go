@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// constants
+const (
+	ProposalTypeRegisterCoins         string = "RegisterERC1155Coins"
+	ProposalTypeRegisterNFTClass      string = "RegisterERC1155NFTClass"
+	ProposalTypeRegisterERC1155       string = "RegisterERC1155"
+	ProposalTypeToggleTokenConversion string = "ToggleERC1155Conversion" // #nosec
+)
+
+// Implements Proposal Interface
+var (
+	_ gov.Content = &RegisterCoinsProposal{}
+	_ gov.Content = &RegisterNFTClassProposal{}
+	_ gov.Content = &RegisterERC1155Proposal{}
+	_ gov.Content = &ToggleTokenConversionProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeRegisterCoins)
+	gov.RegisterProposalType(ProposalTypeRegisterNFTClass)
+	gov.RegisterProposalType(ProposalTypeRegisterERC1155)
+	gov.RegisterProposalType(ProposalTypeToggleTokenConversion)
+}
+
+// NewRegisterCoinsProposal returns new instance of RegisterCoinsProposal
+func NewRegisterCoinsProposal(title, description string, denoms []string, uri string) gov.Content {
+	return &RegisterCoinsProposal{
+		Title:       title,
+		Description: description,
+		Denoms:      denoms,
+		Uri:         uri,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterCoinsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterCoinsProposal) ProposalType() string {
+	return ProposalTypeRegisterCoins
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rcp *RegisterCoinsProposal) ValidateBasic() error {
+	if len(rcp.Denoms) == 0 {
+		return fmt.Errorf("no denoms to register")
+	}
+
+	seen := make(map[string]bool, len(rcp.Denoms))
+	for _, denom := range rcp.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicated denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return gov.ValidateAbstract(rcp)
+}
+
+// NewRegisterNFTClassProposal returns new instance of RegisterNFTClassProposal
+func NewRegisterNFTClassProposal(title, description, classID, uri string) gov.Content {
+	return &RegisterNFTClassProposal{
+		Title:       title,
+		Description: description,
+		ClassId:     classID,
+		Uri:         uri,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterNFTClassProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterNFTClassProposal) ProposalType() string {
+	return ProposalTypeRegisterNFTClass
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rncp *RegisterNFTClassProposal) ValidateBasic() error {
+	if err := nft.ValidateClassID(rncp.ClassId); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(rncp)
+}
+
+// NewRegisterERC1155Proposal returns new instance of RegisterERC1155Proposal
+func NewRegisterERC1155Proposal(title, description, erc1155Addr string, tokenType TokenType) gov.Content {
+	return &RegisterERC1155Proposal{
+		Title:          title,
+		Description:    description,
+		Erc1155Address: erc1155Addr,
+		TokenType:      tokenType,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterERC1155Proposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterERC1155Proposal) ProposalType() string {
+	return ProposalTypeRegisterERC1155
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rep *RegisterERC1155Proposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(rep.Erc1155Address); err != nil {
+		return sdkerrors.Wrap(err, "ERC1155 address")
+	}
+	if rep.TokenType != TOKEN_TYPE_COIN && rep.TokenType != TOKEN_TYPE_NFT {
+		return sdkerrors.Wrapf(ErrInvalidTokenType, "%s", rep.TokenType)
+	}
+	return gov.ValidateAbstract(rep)
+}
+
+// NewToggleTokenConversionProposal returns new instance of ToggleTokenConversionProposal
+func NewToggleTokenConversionProposal(title, description string, token string) gov.Content {
+	return &ToggleTokenConversionProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*ToggleTokenConversionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*ToggleTokenConversionProposal) ProposalType() string {
+	return ProposalTypeToggleTokenConversion
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (ttcp *ToggleTokenConversionProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom, which also covers the nft class IDs
+	if err := ethermint.ValidateAddress(ttcp.Token); err != nil {
+		if err := sdk.ValidateDenom(ttcp.Token); err != nil {
+			return err
+		}
+	}
+
+	return gov.ValidateAbstract(ttcp)
+}
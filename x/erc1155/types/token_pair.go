@@ -0,0 +1,148 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewTokenPair returns an instance of TokenPair
+func NewTokenPair(erc1155Address common.Address, tokenType TokenType, classID string, contractOwner Owner) TokenPair {
+	return TokenPair{
+		Erc1155Address: erc1155Address.String(),
+		Enabled:        true,
+		ContractOwner:  contractOwner,
+		TokenType:      tokenType,
+		ClassId:        classID,
+	}
+}
+
+// GetID returns the SHA256 hash of the ERC1155 address
+func (tp TokenPair) GetID() []byte {
+	return tmhash.Sum([]byte(tp.Erc1155Address))
+}
+
+// GetERC1155Contract casts the hex string address of the ERC1155 to common.Address
+func (tp TokenPair) GetERC1155Contract() common.Address {
+	return common.HexToAddress(tp.Erc1155Address)
+}
+
+// Validate performs a stateless validation of a TokenPair
+func (tp TokenPair) Validate() error {
+	if err := ethermint.ValidateAddress(tp.Erc1155Address); err != nil {
+		return err
+	}
+
+	switch tp.TokenType {
+	case TOKEN_TYPE_COIN:
+		if tp.ClassId != "" {
+			return sdkerrors.Wrapf(ErrInvalidTokenType, "coin token pair %s has nft class %s", tp.Erc1155Address, tp.ClassId)
+		}
+	case TOKEN_TYPE_NFT:
+		if err := nft.ValidateClassID(tp.ClassId); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidTokenType, "token pair %s has token type %s", tp.Erc1155Address, tp.TokenType)
+	}
+
+	if tp.ContractOwner != OWNER_MODULE && tp.ContractOwner != OWNER_EXTERNAL {
+		return sdkerrors.Wrapf(ErrUndefinedOwner, "token pair %s", tp.Erc1155Address)
+	}
+
+	return nil
+}
+
+// IsNativeCosmos returns true if the owner of the ERC1155 contract is the
+// erc1155 module account
+func (tp TokenPair) IsNativeCosmos() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC1155 returns true if the owner of the ERC1155 contract is not the
+// erc1155 module account
+func (tp TokenPair) IsNativeERC1155() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// IsCoin returns true if the token ids are mapped to bank denoms
+func (tp TokenPair) IsCoin() bool {
+	return tp.TokenType == TOKEN_TYPE_COIN
+}
+
+// IsNFT returns true if the token ids are mapped to the nfts of a class
+func (tp TokenPair) IsNFT() bool {
+	return tp.TokenType == TOKEN_TYPE_NFT
+}
+
+// CreateDenom returns the bank denom of a token id of a native ERC1155 coin
+// token pair, as "erc1155/" followed by the contract address and the hex
+// encoded token id.
+func (tp TokenPair) CreateDenom(tokenID *big.Int) string {
+	return fmt.Sprintf("%s/%s/%s", ModuleName, tp.Erc1155Address, tokenID.Text(16))
+}
+
+// CreateNFTID returns the nft ID of a token id of a native ERC1155 nft token
+// pair, as "erc1155:" followed by the hex encoded keccak256 hash of the 20
+// bytes contract address concatenated with the 32 bytes big endian token ID.
+func (tp TokenPair) CreateNFTID(tokenID *big.Int) string {
+	hash := crypto.Keccak256(tp.GetERC1155Contract().Bytes(), common.BigToHash(tokenID).Bytes())
+	return fmt.Sprintf("%s:%s", ModuleName, hex.EncodeToString(hash))
+}
+
+// CreateClassID returns the nft class ID of a native ERC1155 nft token pair
+func CreateClassID(address string) string {
+	return fmt.Sprintf("%s-%s", ModuleName, address)
+}
+
+// CreateTokenID returns the ERC1155 token ID of the given native Cosmos coin
+// denom or nft ID. Decimal IDs that fit in an uint256 are kept as is, while any
+// other ID is hashed with keccak256, so that a token is always mapped to the
+// same token ID.
+func CreateTokenID(nativeID string) *big.Int {
+	tokenID, ok := new(big.Int).SetString(nativeID, 10)
+	if ok && tokenID.Sign() >= 0 && tokenID.BitLen() <= 256 && tokenID.String() == nativeID {
+		return tokenID
+	}
+	return new(big.Int).SetBytes(crypto.Keccak256([]byte(nativeID)))
+}
+
+// ValidateTokenID checks that the given ERC1155 token ID is a decimal uint256
+// in its canonical form, without sign or leading zeros
+func ValidateTokenID(tokenID string) error {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok || id.Sign() < 0 || id.BitLen() > 256 || id.String() != tokenID {
+		return fmt.Errorf("invalid erc1155 token id '%s'", tokenID)
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a TokenMapping
+func (m TokenMapping) Validate() error {
+	if err := ethermint.ValidateAddress(m.Erc1155Address); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenID(m.TokenId); err != nil {
+		return err
+	}
+
+	switch {
+	case m.Denom != "" && m.NftId == "":
+		return sdk.ValidateDenom(m.Denom)
+	case m.NftId != "" && m.Denom == "":
+		return nft.ValidateNFTID(m.NftId)
+	default:
+		return fmt.Errorf("token mapping of %s/%s must have either a denom or a nft id", m.Erc1155Address, m.TokenId)
+	}
+}
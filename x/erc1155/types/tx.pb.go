@@ -0,0 +1,1329 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uptick/erc1155/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgConvertMultiToken defines a Msg to convert native Cosmos coins or nfts of
+// a token pair to ERC1155 tokens. Either the coins or the nft class and IDs
+// must be set.
+type MsgConvertMultiToken struct {
+	// Cosmos coins which denominations are registered on the same token pair
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// nft classID registered on a token pair
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nftIDs to convert to ERC1155
+	NftIds []string `protobuf:"bytes,3,rep,name=nft_ids,json=nftIds,proto3" json:"nft_ids,omitempty"`
+	// recipient hex address to receive ERC1155 tokens
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// cosmos bech32 address from the owner of the given Cosmos tokens
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertMultiToken) Reset()         { *m = MsgConvertMultiToken{} }
+func (m *MsgConvertMultiToken) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertMultiToken) ProtoMessage()    {}
+func (*MsgConvertMultiToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307c8652952a4426, []int{0}
+}
+func (m *MsgConvertMultiToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertMultiToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertMultiToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertMultiToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertMultiToken.Merge(m, src)
+}
+func (m *MsgConvertMultiToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertMultiToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertMultiToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertMultiToken proto.InternalMessageInfo
+
+func (m *MsgConvertMultiToken) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgConvertMultiToken) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *MsgConvertMultiToken) GetNftIds() []string {
+	if m != nil {
+		return m.NftIds
+	}
+	return nil
+}
+
+func (m *MsgConvertMultiToken) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertMultiToken) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertMultiTokenResponse returns no fields
+type MsgConvertMultiTokenResponse struct {
+}
+
+func (m *MsgConvertMultiTokenResponse) Reset()         { *m = MsgConvertMultiTokenResponse{} }
+func (m *MsgConvertMultiTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertMultiTokenResponse) ProtoMessage()    {}
+func (*MsgConvertMultiTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307c8652952a4426, []int{1}
+}
+func (m *MsgConvertMultiTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertMultiTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertMultiTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertMultiTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertMultiTokenResponse.Merge(m, src)
+}
+func (m *MsgConvertMultiTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertMultiTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertMultiTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertMultiTokenResponse proto.InternalMessageInfo
+
+// MsgConvertERC1155 defines a Msg to convert ERC1155 tokens to native Cosmos
+// coins or nfts.
+type MsgConvertERC1155 struct {
+	// ERC1155 token contract address registered in a token pair
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// tokenIDs to convert
+	TokenIds []string `protobuf:"bytes,2,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// amounts of each token ID to convert
+	Amounts []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,rep,name=amounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amounts"`
+	// bech32 address to receive native Cosmos tokens
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender hex address from the owner of the given ERC1155 tokens
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertERC1155) Reset()         { *m = MsgConvertERC1155{} }
+func (m *MsgConvertERC1155) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC1155) ProtoMessage()    {}
+func (*MsgConvertERC1155) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307c8652952a4426, []int{2}
+}
+func (m *MsgConvertERC1155) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC1155) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC1155.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC1155) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC1155.Merge(m, src)
+}
+func (m *MsgConvertERC1155) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC1155) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC1155.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC1155 proto.InternalMessageInfo
+
+func (m *MsgConvertERC1155) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgConvertERC1155) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+func (m *MsgConvertERC1155) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertERC1155) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertERC1155Response returns no fields
+type MsgConvertERC1155Response struct {
+}
+
+func (m *MsgConvertERC1155Response) Reset()         { *m = MsgConvertERC1155Response{} }
+func (m *MsgConvertERC1155Response) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC1155Response) ProtoMessage()    {}
+func (*MsgConvertERC1155Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307c8652952a4426, []int{3}
+}
+func (m *MsgConvertERC1155Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC1155Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC1155Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC1155Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC1155Response.Merge(m, src)
+}
+func (m *MsgConvertERC1155Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC1155Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC1155Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC1155Response proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertMultiToken)(nil), "uptick.erc1155.v1.MsgConvertMultiToken")
+	proto.RegisterType((*MsgConvertMultiTokenResponse)(nil), "uptick.erc1155.v1.MsgConvertMultiTokenResponse")
+	proto.RegisterType((*MsgConvertERC1155)(nil), "uptick.erc1155.v1.MsgConvertERC1155")
+	proto.RegisterType((*MsgConvertERC1155Response)(nil), "uptick.erc1155.v1.MsgConvertERC1155Response")
+}
+
+func init() { proto.RegisterFile("uptick/erc1155/v1/tx.proto", fileDescriptor_307c8652952a4426) }
+
+var fileDescriptor_307c8652952a4426 = []byte{
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcf, 0x6e, 0x12, 0x41,
+	0x1c, 0x66, 0xc1, 0x16, 0x18, 0x13, 0x95, 0x49, 0xa3, 0xb0, 0x6d, 0x16, 0x42, 0xd4, 0xd2, 0x68,
+	0x67, 0xdc, 0x9a, 0x3e, 0x80, 0x10, 0x13, 0x39, 0xe0, 0x61, 0xa3, 0x17, 0x2f, 0x64, 0x98, 0x9d,
+	0xae, 0x13, 0x60, 0x86, 0xec, 0x0c, 0x6b, 0xbd, 0xfa, 0x04, 0x26, 0x7d, 0x01, 0xcf, 0x3e, 0x84,
+	0xe7, 0x1e, 0x9b, 0x78, 0x51, 0x0f, 0x55, 0xc1, 0x07, 0x31, 0x33, 0xbb, 0xa0, 0x11, 0xd2, 0x10,
+	0x4f, 0xbb, 0xbf, 0x3f, 0xdf, 0x37, 0xdf, 0xef, 0xfb, 0xcd, 0x00, 0x77, 0x3a, 0xd1, 0x9c, 0x0e,
+	0x31, 0x8b, 0xa9, 0xef, 0x1f, 0x1f, 0xe3, 0xc4, 0xc7, 0xfa, 0x14, 0x4d, 0x62, 0xa9, 0x25, 0xac,
+	0xa4, 0x35, 0x94, 0xd5, 0x50, 0xe2, 0xbb, 0x7b, 0x91, 0x94, 0xd1, 0x88, 0x61, 0x32, 0xe1, 0x98,
+	0x08, 0x21, 0x35, 0xd1, 0x5c, 0x0a, 0x95, 0x02, 0xdc, 0x9d, 0x48, 0x46, 0xd2, 0xfe, 0x62, 0xf3,
+	0x97, 0x65, 0x3d, 0x2a, 0xd5, 0x58, 0x2a, 0x3c, 0x20, 0x8a, 0xe1, 0xc4, 0x1f, 0x30, 0x4d, 0x7c,
+	0x4c, 0x25, 0x17, 0x69, 0xbd, 0xf9, 0xd3, 0x01, 0x3b, 0x3d, 0x15, 0x75, 0xa4, 0x48, 0x58, 0xac,
+	0x7b, 0xd3, 0x91, 0xe6, 0x2f, 0xe4, 0x90, 0x09, 0x48, 0xc0, 0x96, 0x69, 0x53, 0x55, 0xa7, 0x51,
+	0x68, 0x5d, 0x3f, 0xaa, 0xa1, 0x94, 0x08, 0x19, 0x22, 0x94, 0x11, 0xa1, 0x8e, 0xe4, 0xa2, 0xfd,
+	0xe8, 0xfc, 0xb2, 0x9e, 0xfb, 0xf8, 0xbd, 0xde, 0x8a, 0xb8, 0x7e, 0x3d, 0x1d, 0x20, 0x2a, 0xc7,
+	0x38, 0x3b, 0x35, 0xfd, 0x1c, 0xaa, 0x70, 0x88, 0xf5, 0xdb, 0x09, 0x53, 0x16, 0xa0, 0x82, 0x94,
+	0x19, 0xd6, 0x40, 0x89, 0x8e, 0x88, 0x52, 0x7d, 0x1e, 0x56, 0xf3, 0x0d, 0xa7, 0x55, 0x0e, 0x8a,
+	0x36, 0xee, 0x86, 0xf0, 0x0e, 0x28, 0x8a, 0x13, 0xdd, 0xe7, 0xa1, 0xaa, 0x16, 0x1a, 0x85, 0x56,
+	0x39, 0xd8, 0x16, 0x27, 0xba, 0x1b, 0x2a, 0xe8, 0x82, 0x52, 0xcc, 0x28, 0xe3, 0x09, 0x8b, 0xab,
+	0xd7, 0x2c, 0x66, 0x19, 0xc3, 0xdb, 0x60, 0x5b, 0x31, 0x11, 0xb2, 0xb8, 0xba, 0x65, 0x2b, 0x59,
+	0xd4, 0xf4, 0xc0, 0xde, 0xba, 0x11, 0x03, 0xa6, 0x26, 0x52, 0x28, 0xd6, 0xfc, 0xea, 0x80, 0xca,
+	0x9f, 0x86, 0xa7, 0x41, 0xc7, 0x18, 0x0e, 0x0f, 0xc0, 0x2d, 0x2a, 0x85, 0x8e, 0x09, 0xd5, 0x7d,
+	0x12, 0x86, 0x31, 0x53, 0xc6, 0x0b, 0xc3, 0x7b, 0x73, 0x91, 0x7f, 0x92, 0xa6, 0xe1, 0x2e, 0x28,
+	0x6b, 0xc3, 0x68, 0xf5, 0xe6, 0xad, 0xde, 0x92, 0x4d, 0x18, 0xc5, 0xcf, 0x40, 0x91, 0x8c, 0xe5,
+	0x54, 0xe8, 0x6c, 0x94, 0x36, 0x32, 0x7e, 0x7d, 0xbb, 0xac, 0xdf, 0xdf, 0xc0, 0xaf, 0xae, 0xd0,
+	0xc1, 0x02, 0xfe, 0x5f, 0xb3, 0xef, 0x82, 0xda, 0xca, 0x68, 0x8b, 0xc1, 0x8f, 0x3e, 0xe5, 0x41,
+	0xa1, 0xa7, 0x22, 0xf8, 0xc1, 0x01, 0x95, 0xd5, 0x1b, 0xb0, 0x8f, 0x56, 0xae, 0x20, 0x5a, 0xe7,
+	0xa3, 0x8b, 0x37, 0x6c, 0x5c, 0x1a, 0xee, 0xbf, 0xfb, 0xfc, 0xeb, 0x2c, 0xff, 0x00, 0x1e, 0xe0,
+	0x75, 0x0f, 0x00, 0xd3, 0x14, 0xd8, 0x1f, 0x1b, 0x64, 0xdf, 0x1a, 0x09, 0xcf, 0x1c, 0x70, 0xe3,
+	0x9f, 0x05, 0xdd, 0xbd, 0xf2, 0xd8, 0xac, 0xcb, 0x7d, 0xb8, 0x49, 0xd7, 0x52, 0xd9, 0xa1, 0x55,
+	0xb6, 0x0f, 0xef, 0x5d, 0xad, 0x2c, 0xcb, 0xb6, 0xbb, 0xe7, 0x33, 0xcf, 0xb9, 0x98, 0x79, 0xce,
+	0x8f, 0x99, 0xe7, 0xbc, 0x9f, 0x7b, 0xb9, 0x8b, 0xb9, 0x97, 0xfb, 0x32, 0xf7, 0x72, 0xaf, 0xf0,
+	0x5f, 0xcb, 0x7d, 0x69, 0xa9, 0x9e, 0x33, 0xfd, 0x46, 0xc6, 0xc3, 0x05, 0xf1, 0xe9, 0x92, 0xda,
+	0x6e, 0x7a, 0xb0, 0x6d, 0xdf, 0xe3, 0xe3, 0xdf, 0x03, 0x00, 0xf6, 0xed, 0x8e, 0x80, 0x14, 0x04,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertMultiToken mints or unescrows the ERC1155 representation of native
+	// Cosmos coins or nfts that are registered on the token mapping.
+	ConvertMultiToken(ctx context.Context, in *MsgConvertMultiToken, opts ...grpc.CallOption) (*MsgConvertMultiTokenResponse, error)
+	// ConvertERC1155 mints or unescrows the native Cosmos representation of
+	// ERC1155 tokens of a contract that is registered on the token mapping.
+	ConvertERC1155(ctx context.Context, in *MsgConvertERC1155, opts ...grpc.CallOption) (*MsgConvertERC1155Response, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertMultiToken(ctx context.Context, in *MsgConvertMultiToken, opts ...grpc.CallOption) (*MsgConvertMultiTokenResponse, error) {
+	out := new(MsgConvertMultiTokenResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc1155.v1.Msg/ConvertMultiToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC1155(ctx context.Context, in *MsgConvertERC1155, opts ...grpc.CallOption) (*MsgConvertERC1155Response, error) {
+	out := new(MsgConvertERC1155Response)
+	err := c.cc.Invoke(ctx, "/uptick.erc1155.v1.Msg/ConvertERC1155", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertMultiToken mints or unescrows the ERC1155 representation of native
+	// Cosmos coins or nfts that are registered on the token mapping.
+	ConvertMultiToken(context.Context, *MsgConvertMultiToken) (*MsgConvertMultiTokenResponse, error)
+	// ConvertERC1155 mints or unescrows the native Cosmos representation of
+	// ERC1155 tokens of a contract that is registered on the token mapping.
+	ConvertERC1155(context.Context, *MsgConvertERC1155) (*MsgConvertERC1155Response, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ConvertMultiToken(ctx context.Context, req *MsgConvertMultiToken) (*MsgConvertMultiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertMultiToken not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC1155(ctx context.Context, req *MsgConvertERC1155) (*MsgConvertERC1155Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC1155 not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConvertMultiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertMultiToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertMultiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc1155.v1.Msg/ConvertMultiToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertMultiToken(ctx, req.(*MsgConvertMultiToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC1155_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC1155)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC1155(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc1155.v1.Msg/ConvertERC1155",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC1155(ctx, req.(*MsgConvertERC1155))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "uptick.erc1155.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertMultiToken",
+			Handler:    _Msg_ConvertMultiToken_Handler,
+		},
+		{
+			MethodName: "ConvertERC1155",
+			Handler:    _Msg_ConvertERC1155_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "uptick/erc1155/v1/tx.proto",
+}
+
+func (m *MsgConvertMultiToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertMultiToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertMultiToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NftIds) > 0 {
+		for iNdEx := len(m.NftIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NftIds[iNdEx])
+			copy(dAtA[i:], m.NftIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NftIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertMultiTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertMultiTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertMultiTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC1155) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC1155) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC1155) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC1155Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC1155Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC1155Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertMultiToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NftIds) > 0 {
+		for _, s := range m.NftIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertMultiTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC1155) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC1155Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertMultiToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertMultiToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertMultiToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertMultiTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertMultiTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertMultiTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC1155) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC1155: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC1155: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amounts = append(m.Amounts, v)
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC1155Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC1155Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC1155Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,9 @@
+package cli
+
+const (
+	FlagSlash  = "slash"
+	FlagRemove = "remove"
+
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+)
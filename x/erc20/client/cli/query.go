@@ -24,6 +24,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetPairSupplyCmd(),
+		GetEstimateConversionCmd(),
+		GetAutoConvertCmd(),
+		GetFailedConversionsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -98,6 +102,144 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPairSupplyCmd queries the supplies of a token pair
+func GetPairSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-supply [token]",
+		Short: "Get the supplies of a token pair and the amounts escrowed by the module",
+		Long:  "Get the supplies of a token pair and the amounts escrowed by the module",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPairSupplyRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.PairSupply(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEstimateConversionCmd queries the fee charged on a conversion
+func GetEstimateConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-conversion [token] [amount]",
+		Short: "Get the fee charged on the conversion of an amount of a token",
+		Long:  "Get the fee charged on the conversion of an amount of a token. The token is either the hex address of the ERC20 to convert to Cosmos coins or the Cosmos denomination to convert to ERC20 tokens.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEstimateConversionRequest{
+				Token:  args[0],
+				Amount: args[1],
+			}
+
+			res, err := queryClient.EstimateConversion(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAutoConvertCmd queries whether the coins received over IBC by an account
+// are converted
+func GetAutoConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-convert [address]",
+		Short: "Get whether the coins received over IBC by an account are converted to ERC20 tokens",
+		Long:  "Get whether the coins received over IBC by an account are converted to ERC20 tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAutoConvertRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.AutoConvert(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFailedConversionsCmd queries the coins received over IBC by an account
+// that couldn't be converted
+func GetFailedConversionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-conversions [receiver]",
+		Short: "Gets the coins received over IBC by an account that couldn't be converted to ERC20 tokens",
+		Long:  "Gets the coins received over IBC by an account that couldn't be converted to ERC20 tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedConversionsRequest{
+				Receiver:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FailedConversions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed conversions")
+	return cmd
+}
+
 // GetHubParamsCmd queries hub info
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,7 +17,11 @@ import (
 	//govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethermint "github.com/evmos/ethermint/types"
 
 	"github.com/UptickNetwork/uptick/x/erc20/types"
@@ -33,6 +40,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
+		NewRegisterERC20Cmd(),
+		NewTransferERC20Cmd(),
+		NewSetAutoConvertCmd(),
+		NewRetryIBCConversionCmd(),
+		NewConvertERC20WithPermitCmd(),
 	)
 	return txCmd
 }
@@ -135,6 +147,251 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewRegisterERC20Cmd returns a CLI command handler for registering an ERC20
+// deployed by the sender without a governance proposal
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [contract-address] [deploy-nonce]",
+		Short: "Register an ERC20 token deployed by the sender, escrowing the registration deposit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid deploy nonce %w", err)
+			}
+
+			msg := types.NewMsgRegisterERC20(common.HexToAddress(contract), nonce, cliCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTransferERC20Cmd returns a CLI command handler for converting an ERC20
+// token and sending the Cosmos coins over IBC
+func NewTransferERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-erc20 [contract-address] [amount] [src-port] [src-channel] [receiver]",
+		Short: "Convert an ERC20 token and transfer the Cosmos coins through IBC",
+		Long: `Convert an ERC20 token and transfer the Cosmos coins through IBC.
+The coins refunded on a timeout or a failed transfer are converted back to the ERC20 token.
+Timeout height is absolute and timeout timestamp is relative to the current time.`,
+		Example: fmt.Sprintf("%s tx %s transfer-erc20 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd 1000 transfer channel-0 cosmos1...", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(FlagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			msg := types.NewMsgTransferERC20(
+				amount, common.HexToAddress(contract), from,
+				args[2], args[3], args[4],
+				timeoutHeight, timeoutTimestamp,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPacketTimeoutHeight, "0-0", "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, transfertypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. The timeout is disabled when set to 0.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetAutoConvertCmd returns a CLI command handler for opting in or out of
+// the conversion of the coins received over IBC
+func NewSetAutoConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-convert [enabled]",
+		Short: "Set whether the registered coins received over IBC are converted to ERC20 tokens",
+		Long: `Set whether the registered coins received over IBC are converted to ERC20 tokens.
+The sender of a transfer can still keep the coins native with the "keep" action of the
+erc20 memo or receiver suffix.`,
+		Example: fmt.Sprintf("%s tx %s set-auto-convert false", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid enabled value %w", err)
+			}
+
+			msg := types.NewMsgSetAutoConvert(cliCtx.GetFromAddress(), enabled)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRetryIBCConversionCmd returns a CLI command handler for retrying the
+// conversion of the coins of a received IBC transfer
+func NewRetryIBCConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retry-ibc-conversion [dst-channel] [sequence]",
+		Short:   "Convert the coins of a transfer received over IBC that couldn't be converted to ERC20 tokens",
+		Example: fmt.Sprintf("%s tx %s retry-ibc-conversion channel-0 12", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %w", err)
+			}
+
+			msg := types.NewMsgRetryIBCConversion(cliCtx.GetFromAddress(), args[0], sequence)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20WithPermitCmd returns a CLI command handler for relaying the
+// conversion of ERC20 tokens approved by an EIP-2612 permit
+func NewConvertERC20WithPermitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20-with-permit [contract-address] [amount] [receiver] [owner] [relayer-fee] [deadline] [permit-signature] [conversion-signature]",
+		Short: "Relay the conversion of ERC20 tokens approved by the owner with an EIP-2612 permit, paying the gas",
+		Long: `Relay the conversion of ERC20 tokens approved by the owner with an EIP-2612 permit, paying the gas.
+The sender is the relayer, which receives the relayer fee from the converted coins. The signatures
+are the 65 bytes hex encoded signatures of the owner.`,
+		Args: cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			owner := args[3]
+			if err := ethermint.ValidateAddress(owner); err != nil {
+				return fmt.Errorf("invalid owner address %w", err)
+			}
+
+			relayerFee, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid relayer fee %s", args[4])
+			}
+
+			deadline, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid deadline %s", args[5])
+			}
+
+			permitSig, err := hexutil.Decode(args[6])
+			if err != nil {
+				return fmt.Errorf("invalid permit signature %w", err)
+			}
+
+			conversionSig, err := hexutil.Decode(args[7])
+			if err != nil {
+				return fmt.Errorf("invalid conversion signature %w", err)
+			}
+
+			msg := types.NewMsgConvertERC20WithPermit(
+				amount, receiver, common.HexToAddress(contract), common.HexToAddress(owner),
+				cliCtx.GetFromAddress(), relayerFee, deadline, permitSig, conversionSig,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
 func NewRegisterCoinProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -428,3 +685,391 @@ func NewUpdateTokenPairERC20ProposalCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewResolveRegistrationDepositProposalCmd implements the command to submit a resolve-registration-deposit proposal
+func NewResolveRegistrationDepositProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resolve-registration-deposit [erc20_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to refund or slash the deposit of a self-registered ERC20",
+		Long:    `Submit a proposal to refund the registration deposit of a self-registered ERC20 to its depositor, or to burn it with the --slash flag, along with an initial deposit.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal resolve-registration-deposit <erc20_address> --slash --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			slash, err := cmd.Flags().GetBool(FlagSlash)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewResolveRegistrationDepositProposal(title, description, args[0], slash)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	cmd.Flags().Bool(FlagSlash, false, "burn the registration deposit instead of refunding it")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateTokenPairRateLimitProposalCmd implements the command to submit a update-token-pair-rate-limit proposal
+func NewUpdateTokenPairRateLimitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-token-pair-rate-limit [token] [inflow_quota] [outflow_quota] [window]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "Submit a proposal to update the conversion quotas of a token pair",
+		Long:    `Submit a proposal to update the amounts of a token pair that can be converted from ERC20 to Cosmos coin (inflow) and from Cosmos coin to ERC20 (outflow) per window of blocks, along with an initial deposit. The token pair is disabled when a quota is exceeded. A zero window removes the rate limit.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-rate-limit <denom_or_contract> 1000000 1000000 14400 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			inflowQuota, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid inflow quota %s", args[1])
+			}
+
+			outflowQuota, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid outflow quota %s", args[2])
+			}
+
+			window, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window %w", err)
+			}
+
+			from := clientCtx.GetFromAddress()
+			rateLimit := types.NewRateLimit(inflowQuota, outflowQuota, window)
+			content := types.NewUpdateTokenPairRateLimitProposal(title, description, args[0], rateLimit)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateTokenPairConversionFeeProposalCmd implements the command to submit a update-token-pair-conversion-fee proposal
+func NewUpdateTokenPairConversionFeeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-token-pair-conversion-fee [token] [inflow_fee_bps] [outflow_fee_bps]",
+		Args:    cobra.RangeArgs(1, 3),
+		Short:   "Submit a proposal to override the conversion fees of a token pair",
+		Long:    `Submit a proposal to override the fee rates in basis points charged on the conversions of a token pair from ERC20 to Cosmos coin (inflow) and from Cosmos coin to ERC20 (outflow), along with an initial deposit. The --remove flag restores the fees of the module params.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-conversion-fee <denom_or_contract> 30 10 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			var conversionFee *types.ConversionFee
+			if !remove {
+				if len(args) != 3 {
+					return fmt.Errorf("inflow and outflow fee rates are required without the --%s flag", FlagRemove)
+				}
+
+				inflowFeeBps, err := strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid inflow fee rate %w", err)
+				}
+
+				outflowFeeBps, err := strconv.ParseUint(args[2], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid outflow fee rate %w", err)
+				}
+
+				fee := types.NewConversionFee(uint32(inflowFeeBps), uint32(outflowFeeBps))
+				conversionFee = &fee
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateTokenPairConversionFeeProposal(title, description, args[0], conversionFee)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	cmd.Flags().Bool(FlagRemove, false, "remove the conversion fees override of the token pair")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateTokenPairMetadataProposalCmd implements the command to submit a update-token-pair-metadata proposal
+func NewUpdateTokenPairMetadataProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-token-pair-metadata [metadata]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the metadata of a registered coin",
+		Long: `Submit a proposal to update the metadata of a registered coin along with an initial deposit.
+Upon passing, the name, symbol and decimals of the ERC20 contract deployed by the module are updated.
+The metadata of a native ERC20 token must match its symbol and decimals.
+The metadata must be supplied via a JSON file.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-metadata <path/to/metadata.json> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := ParseMetadata(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateTokenPairMetadataProposal(title, description, metadata)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateTokenPairAllowedBehavioursProposalCmd implements the command to submit a update-token-pair-allowed-behaviours proposal
+func NewUpdateTokenPairAllowedBehavioursProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-token-pair-allowed-behaviours [token] [behaviours...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to update the side effects accepted on the conversions of a native ERC20 token pair",
+		Long: fmt.Sprintf(`Submit a proposal to replace the side effects of the contract accepted on the conversions of a native ERC20 token pair, along with an initial deposit.
+The behaviours are %s and %s. Without behaviours, only the transfer of the converted amount and the Approval events of the sender are accepted.`,
+			types.BEHAVIOUR_ADDITIONAL_TRANSFERS, types.BEHAVIOUR_SUPPLY_CHANGES,
+		),
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-allowed-behaviours <contract_address> %s --from=<key_or_address>", version.AppName, types.BEHAVIOUR_ADDITIONAL_TRANSFERS),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			behaviours := make([]types.ConversionBehaviour, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				behaviour, ok := types.ConversionBehaviour_value[strings.ToUpper(arg)]
+				if !ok {
+					return fmt.Errorf("invalid conversion behaviour %s", arg)
+				}
+				behaviours = append(behaviours, types.ConversionBehaviour(behaviour))
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateTokenPairAllowedBehavioursProposal(title, description, args[0], behaviours)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -6,8 +6,12 @@ import (
 )
 
 var (
-	RegisterCoinProposalHandler         = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd)
-	RegisterERC20ProposalHandler        = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd)
-	ToggleTokenRelayProposalHandler     = govclient.NewProposalHandler(cli.NewToggleTokenRelayProposalCmd)
+	RegisterCoinProposalHandler                 = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd)
+	RegisterERC20ProposalHandler                = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd)
+	ToggleTokenRelayProposalHandler             = govclient.NewProposalHandler(cli.NewToggleTokenRelayProposalCmd)
+	ResolveRegistrationDepositProposalHandler   = govclient.NewProposalHandler(cli.NewResolveRegistrationDepositProposalCmd)
+	UpdateTokenPairRateLimitProposalHandler     = govclient.NewProposalHandler(cli.NewUpdateTokenPairRateLimitProposalCmd)
+	UpdateTokenPairConversionFeeProposalHandler = govclient.NewProposalHandler(cli.NewUpdateTokenPairConversionFeeProposalCmd)
+	UpdateTokenPairMetadataProposalHandler      = govclient.NewProposalHandler(cli.NewUpdateTokenPairMetadataProposalCmd)
+	UpdateTokenPairBehavioursProposalHandler    = govclient.NewProposalHandler(cli.NewUpdateTokenPairAllowedBehavioursProposalCmd)
 )
-
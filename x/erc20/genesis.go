@@ -33,12 +33,27 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, deposit := range data.RegistrationDeposits {
+		k.SetRegistrationDeposit(ctx, deposit)
+	}
+
+	for _, addr := range data.AutoConvertOptOuts {
+		k.SetAutoConvertEnabled(ctx, sdk.MustAccAddressFromBech32(addr), false)
+	}
+
+	for _, fc := range data.FailedConversions {
+		k.SetFailedConversion(ctx, fc)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetAllTokenPairs(ctx),
+		Params:               k.GetParams(ctx),
+		TokenPairs:           k.GetAllTokenPairs(ctx),
+		RegistrationDeposits: k.GetAllRegistrationDeposits(ctx),
+		AutoConvertOptOuts:   k.GetAutoConvertOptOuts(ctx),
+		FailedConversions:    k.GetAllFailedConversions(ctx),
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/UptickNetwork/uptick/ibc"
 	"github.com/UptickNetwork/uptick/x/erc20/keeper"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
 )
 
 var _ porttypes.Middleware = &IBCMiddleware{}
@@ -35,6 +36,9 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
+	// strip the conversion route from the packet data before it is handled
+	// by the transfer module
+	packet, route := types.ParseIBCRoute(packet)
 	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
 
 	// return if the acknowledgement is an error ACK
@@ -42,7 +46,7 @@ func (im IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
-	return im.keeper.OnRecvPacket(ctx, packet, ack)
+	return im.keeper.OnRecvPacket(ctx, packet, ack, route)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -60,6 +64,19 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
 }
 
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet)
+}
+
 // SendPacket implements the ICS4 Wrapper interface
 func (im IBCMiddleware) SendPacket(
 	ctx sdk.Context,
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// IsAutoConvertEnabled returns false if the account opted out of the
+// conversion of the coins received over IBC
+func (k Keeper) IsAutoConvertEnabled(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoConvertOptOut)
+	return !store.Has(addr)
+}
+
+// SetAutoConvertEnabled sets whether the coins received over IBC by the
+// account are converted
+func (k Keeper) SetAutoConvertEnabled(ctx sdk.Context, addr sdk.AccAddress, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoConvertOptOut)
+	if enabled {
+		store.Delete(addr)
+		return
+	}
+	store.Set(addr, []byte{1})
+}
+
+// GetAutoConvertOptOuts returns all the accounts that opted out of the
+// conversion of the coins received over IBC
+func (k Keeper) GetAutoConvertOptOuts(ctx sdk.Context) []string {
+	optOuts := []string{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoConvertOptOut)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		optOuts = append(optOuts, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return optOuts
+}
+
+// SetAutoConvert sets whether the registered coins received over IBC by the
+// sender are converted to ERC20 tokens
+func (k Keeper) SetAutoConvert(
+	goCtx context.Context,
+	msg *types.MsgSetAutoConvert,
+) (*types.MsgSetAutoConvertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	k.SetAutoConvertEnabled(ctx, sender, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetAutoConvert,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+			),
+		},
+	)
+
+	return &types.MsgSetAutoConvertResponse{}, nil
+}
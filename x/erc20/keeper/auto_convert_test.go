@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSetAutoConvert() {
+	suite.SetupTest()
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	suite.Require().True(suite.app.Erc20Keeper.IsAutoConvertEnabled(suite.ctx, addr))
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAutoConvertOptOuts(suite.ctx))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.app.Erc20Keeper.SetAutoConvert(ctx, types.NewMsgSetAutoConvert(addr, false))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.Erc20Keeper.IsAutoConvertEnabled(suite.ctx, addr))
+	suite.Require().Equal([]string{addr.String()}, suite.app.Erc20Keeper.GetAutoConvertOptOuts(suite.ctx))
+
+	res, err := suite.app.Erc20Keeper.AutoConvert(ctx, &types.QueryAutoConvertRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Enabled)
+
+	_, err = suite.app.Erc20Keeper.SetAutoConvert(ctx, types.NewMsgSetAutoConvert(addr, true))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.Erc20Keeper.IsAutoConvertEnabled(suite.ctx, addr))
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAutoConvertOptOuts(suite.ctx))
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// UpdateTokenPairAllowedBehaviours replaces the side effects of the contract
+// accepted on the conversions of a native ERC20 token pair
+func (k Keeper) UpdateTokenPairAllowedBehaviours(ctx sdk.Context, token string, behaviours []types.ConversionBehaviour) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered by id", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	// the contracts deployed by the module aren't verified
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token '%s' is not a native ERC20 token pair", token)
+	}
+
+	pair.AllowedBehaviours = behaviours
+
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// transferSnapshot records the state of a native ERC20 contract before the
+// transfer of a conversion
+type transferSnapshot struct {
+	from, to         common.Address
+	balanceFrom      *big.Int
+	balanceTo        *big.Int
+	totalSupply      *big.Int
+	expectedTransfer *big.Int
+}
+
+// snapshotTransfer records the balances of the accounts and the total supply
+// of a native ERC20 contract before transferring the amount of a conversion
+func (k Keeper) snapshotTransfer(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	contract, from, to common.Address,
+	amount *big.Int,
+) (transferSnapshot, error) {
+	balanceFrom := k.balanceOf(ctx, erc20, contract, from)
+	balanceTo := k.balanceOf(ctx, erc20, contract, to)
+	if balanceFrom == nil || balanceTo == nil {
+		return transferSnapshot{}, sdkerrors.Wrapf(types.ErrERC20Verification, "failed to query the balances of %s", contract)
+	}
+
+	supply, err := k.totalSupply(ctx, erc20, contract)
+	if err != nil {
+		return transferSnapshot{}, err
+	}
+
+	return transferSnapshot{
+		from:             from,
+		to:               to,
+		balanceFrom:      balanceFrom,
+		balanceTo:        balanceTo,
+		totalSupply:      supply,
+		expectedTransfer: amount,
+	}, nil
+}
+
+// verifyTransfer checks the state changes of the transfer of a conversion
+// against its snapshot, only the side effects allowed by the token pair are
+// accepted:
+//  - the balances of the accounts changed by exactly the converted amount
+//  - the total supply is unchanged (BEHAVIOUR_SUPPLY_CHANGES)
+//  - the contract emitted no Transfer event but the one of the conversion
+//    (BEHAVIOUR_ADDITIONAL_TRANSFERS)
+//  - the contract emitted no Approval event but the ones of the allowances of
+//    the sender, e.g. on transferFrom based transfers, as an allowance on the
+//    tokens of the module address or the receiver could move them later
+func (k Keeper) verifyTransfer(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	erc20 abi.ABI,
+	snapshot transferSnapshot,
+	res *evmtypes.MsgEthereumTxResponse,
+) error {
+	contract := pair.GetERC20Contract()
+
+	expFrom := new(big.Int).Sub(snapshot.balanceFrom, snapshot.expectedTransfer)
+	expTo := new(big.Int).Add(snapshot.balanceTo, snapshot.expectedTransfer)
+	if snapshot.from == snapshot.to {
+		expFrom, expTo = snapshot.balanceFrom, snapshot.balanceTo
+	}
+
+	if balance := k.balanceOf(ctx, erc20, contract, snapshot.from); balance == nil || balance.Cmp(expFrom) != 0 {
+		return sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance of %s - expected: %v, actual: %v",
+			snapshot.from, expFrom, balance,
+		)
+	}
+
+	if balance := k.balanceOf(ctx, erc20, contract, snapshot.to); balance == nil || balance.Cmp(expTo) != 0 {
+		return sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance of %s - expected: %v, actual: %v",
+			snapshot.to, expTo, balance,
+		)
+	}
+
+	if !pair.IsBehaviourAllowed(types.BEHAVIOUR_SUPPLY_CHANGES) {
+		supply, err := k.totalSupply(ctx, erc20, contract)
+		if err != nil {
+			return err
+		}
+		if supply.Cmp(snapshot.totalSupply) != 0 {
+			return sdkerrors.Wrapf(
+				types.ErrBalanceInvariance,
+				"unexpected total supply change - expected: %v, actual: %v",
+				snapshot.totalSupply, supply,
+			)
+		}
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	approvalID := erc20.Events["Approval"].ID
+	transferID := erc20.Events[types.ERC20EventTransfer].ID
+	transfers := 0
+
+	for _, log := range res.Logs {
+		// only the events of the token contract are relevant
+		if common.HexToAddress(log.Address) != contract || len(log.Topics) == 0 {
+			continue
+		}
+
+		switch common.HexToHash(log.Topics[0]) {
+		case approvalID:
+			if !isSenderApproval(log, snapshot) {
+				return sdkerrors.Wrap(types.ErrUnexpectedEvent, "unexpected Approval event")
+			}
+		case transferID:
+			if isExpectedTransfer(log, snapshot) && transfers == 0 {
+				transfers++
+				continue
+			}
+			if !pair.IsBehaviourAllowed(types.BEHAVIOUR_ADDITIONAL_TRANSFERS) {
+				return sdkerrors.Wrap(types.ErrUnexpectedEvent, "unexpected Transfer event")
+			}
+		}
+	}
+
+	return nil
+}
+
+// isExpectedTransfer returns true if the log is the Transfer event of the
+// conversion
+func isExpectedTransfer(log *evmtypes.Log, snapshot transferSnapshot) bool {
+	if len(log.Topics) != 3 {
+		return false
+	}
+
+	return common.HexToAddress(log.Topics[1]) == snapshot.from &&
+		common.HexToAddress(log.Topics[2]) == snapshot.to &&
+		new(big.Int).SetBytes(log.Data).Cmp(snapshot.expectedTransfer) == 0
+}
+
+// isSenderApproval returns true if the log is an Approval event of an
+// allowance of the sender of the conversion, other than the module address
+func isSenderApproval(log *evmtypes.Log, snapshot transferSnapshot) bool {
+	if len(log.Topics) != 3 {
+		return false
+	}
+
+	owner := common.HexToAddress(log.Topics[1])
+	return owner == snapshot.from && owner != types.ModuleAddress
+}
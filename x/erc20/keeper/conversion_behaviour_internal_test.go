@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func TestIsSenderApproval(t *testing.T) {
+	approvalID := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Approval"].ID
+	sender := tests.GenerateAddress()
+	receiver := tests.GenerateAddress()
+
+	approval := func(owner, spender common.Address) *evmtypes.Log {
+		return &evmtypes.Log{Topics: []string{approvalID.Hex(), owner.Hash().Hex(), spender.Hash().Hex()}}
+	}
+
+	testCases := []struct {
+		name     string
+		snapshot transferSnapshot
+		log      *evmtypes.Log
+		expPass  bool
+	}{
+		{
+			"allowance of the sender",
+			transferSnapshot{from: sender, to: types.ModuleAddress},
+			approval(sender, tests.GenerateAddress()),
+			true,
+		},
+		{
+			"allowance of the module address",
+			transferSnapshot{from: sender, to: types.ModuleAddress},
+			approval(types.ModuleAddress, tests.GenerateAddress()),
+			false,
+		},
+		{
+			"allowance of the module address sending the tokens",
+			transferSnapshot{from: types.ModuleAddress, to: receiver},
+			approval(types.ModuleAddress, tests.GenerateAddress()),
+			false,
+		},
+		{
+			"allowance of the receiver",
+			transferSnapshot{from: types.ModuleAddress, to: receiver},
+			approval(receiver, tests.GenerateAddress()),
+			false,
+		},
+		{
+			"invalid topics",
+			transferSnapshot{from: sender, to: types.ModuleAddress},
+			&evmtypes.Log{Topics: []string{approvalID.Hex(), sender.Hash().Hex()}},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc.snapshot.expectedTransfer = big.NewInt(1)
+		require.Equal(t, tc.expPass, isSenderApproval(tc.log, tc.snapshot), tc.name)
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// UpdateTokenPairConversionFee overrides the conversion fees of a token pair.
+// The fees of the module params apply again when the override is removed.
+func (k Keeper) UpdateTokenPairConversionFee(ctx sdk.Context, token string, conversionFee *types.ConversionFee) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered by id", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	pair.ConversionFee = conversionFee
+
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// chargeConversionFee sends the fee charged on the conversion of the given
+// amount from the payer to the fee recipient and returns it. The payer must
+// hold the fee in Cosmos coins of the token pair.
+func (k Keeper) chargeConversionFee(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	direction types.ConversionDirection,
+	payer sdk.AccAddress,
+	amount sdk.Int,
+) (sdk.Int, error) {
+	params := k.GetParams(ctx)
+	feeBps := params.ConversionFeeBps(pair, direction)
+
+	fee := types.ComputeConversionFee(amount, feeBps)
+	if !fee.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	if fee.GTE(amount) {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins,
+			"amount %s doesn't cover the conversion fee %s", amount, fee,
+		)
+	}
+
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: fee}}
+	if err := k.sendConversionFee(ctx, params.FeeRecipient, payer, coins); err != nil {
+		return sdk.ZeroInt(), sdkerrors.Wrap(err, "failed to charge conversion fee")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConversionFee,
+			sdk.NewAttribute(sdk.AttributeKeySender, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeBps, sdk.NewInt(int64(feeBps)).String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, params.FeeRecipient),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return fee, nil
+}
+
+// sendConversionFee sends the fee to the recipient module account. The fees
+// sent to the distribution module fund the community pool.
+func (k Keeper) sendConversionFee(ctx sdk.Context, recipient string, payer sdk.AccAddress, fee sdk.Coins) error {
+	if recipient == distrtypes.ModuleName {
+		return k.distrKeeper.FundCommunityPool(ctx, fee, payer)
+	}
+
+	if k.accountKeeper.GetModuleAddress(recipient) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient)
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, recipient, fee)
+}
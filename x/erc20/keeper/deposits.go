@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// GetAllRegistrationDeposits returns the deposits of all the self-registered
+// ERC20 token pairs
+func (k Keeper) GetAllRegistrationDeposits(ctx sdk.Context) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRegistrationDeposit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// GetRegistrationDeposit returns the deposit escrowed for the registration of
+// the given ERC20 contract
+func (k Keeper) GetRegistrationDeposit(ctx sdk.Context, erc20 common.Address) (types.RegistrationDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := store.Get(erc20.Bytes())
+	if len(bz) == 0 {
+		return types.RegistrationDeposit{}, false
+	}
+
+	var deposit types.RegistrationDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetRegistrationDeposit stores a registration deposit
+func (k Keeper) SetRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(common.HexToAddress(deposit.Erc20Address).Bytes(), bz)
+}
+
+// DeleteRegistrationDeposit removes a registration deposit
+func (k Keeper) DeleteRegistrationDeposit(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	store.Delete(erc20.Bytes())
+}
+
+// ResolveRegistrationDeposit releases the deposit escrowed for the
+// registration of the given ERC20 contract. The deposit is refunded to the
+// depositor, or burned if the registration was found to be malicious.
+func (k Keeper) ResolveRegistrationDeposit(ctx sdk.Context, erc20 common.Address, slash bool) (types.RegistrationDeposit, error) {
+	deposit, found := k.GetRegistrationDeposit(ctx, erc20)
+	if !found {
+		return types.RegistrationDeposit{}, sdkerrors.Wrapf(types.ErrDepositNotFound, "token ERC20 contract: %s", erc20)
+	}
+
+	if slash {
+		if err := k.bankKeeper.BurnCoins(ctx, types.DepositModuleName, deposit.Amount); err != nil {
+			return types.RegistrationDeposit{}, sdkerrors.Wrap(err, "failed to burn registration deposit")
+		}
+	} else {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return types.RegistrationDeposit{}, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DepositModuleName, depositor, deposit.Amount); err != nil {
+			return types.RegistrationDeposit{}, sdkerrors.Wrap(err, "failed to refund registration deposit")
+		}
+	}
+
+	k.DeleteRegistrationDeposit(ctx, erc20)
+	return deposit, nil
+}
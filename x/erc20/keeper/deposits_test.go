@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+	evm "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestResolveRegistrationDeposit() {
+	var contract common.Address
+
+	amount := sdk.NewCoins(sdk.NewCoin(evm.DefaultEVMDenom, sdk.NewInt(100)))
+	depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		slash    bool
+		expPass  bool
+	}{
+		{
+			"fail - deposit not found",
+			func() {
+				contract = tests.GenerateAddress()
+			},
+			false,
+			false,
+		},
+		{
+			"ok - deposit refunded",
+			func() {},
+			false,
+			true,
+		},
+		{
+			"ok - deposit slashed",
+			func() {},
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			// escrow a deposit for a registered contract
+			contract = tests.GenerateAddress()
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amount))
+			err := suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, types.DepositModuleName, amount)
+			suite.Require().NoError(err)
+			deposit := types.RegistrationDeposit{Erc20Address: contract.String(), Depositor: depositor.String(), Amount: amount}
+			suite.app.Erc20Keeper.SetRegistrationDeposit(suite.ctx, deposit)
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, evm.DefaultEVMDenom)
+
+			tc.malleate()
+
+			resolved, err := suite.app.Erc20Keeper.ResolveRegistrationDeposit(suite.ctx, contract, tc.slash)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrDepositNotFound)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(deposit, resolved)
+
+			_, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			suite.Require().False(found)
+			depositAcc := suite.app.AccountKeeper.GetModuleAddress(types.DepositModuleName)
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, depositAcc).IsZero())
+
+			if tc.slash {
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, depositor).IsZero())
+				suite.Require().True(supply.Sub(amount[0]).IsEqual(suite.app.BankKeeper.GetSupply(suite.ctx, evm.DefaultEVMDenom)))
+			} else {
+				suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, depositor))
+				suite.Require().True(supply.IsEqual(suite.app.BankKeeper.GetSupply(suite.ctx, evm.DefaultEVMDenom)))
+			}
+
+			// a deposit can only be resolved once
+			_, err = suite.app.Erc20Keeper.ResolveRegistrationDeposit(suite.ctx, contract, tc.slash)
+			suite.Require().ErrorIs(err, types.ErrDepositNotFound)
+		})
+	}
+}
@@ -1,10 +1,17 @@
 package keeper
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
 )
 
 // Hooks wrapper struct for erc20 keeper
@@ -19,26 +26,32 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
-// TODO: Make sure that if ConvertERC20 is called, that the Hook doesn't trigger
-// if it does, delete minting from ConvertErc20
-
-// PostTxProcessing implements EvmHooks.PostTxProcessing
+// PostTxProcessing implements EvmHooks.PostTxProcessing. It converts the
+// ERC20 tokens of a registered token pair that are transferred to the module
+// address into their Cosmos coin representation, which is sent to the bech32
+// address of the sender.
+//
+// NOTE: ConvertERC20 and ConvertCoin execute the EVM calls through
+// ApplyMessage, which doesn't run the hooks, so the conversions are never
+// processed twice.
 func (h Hooks) PostTxProcessing(
 	ctx sdk.Context,
 	msg core.Message,
 	receipt *ethtypes.Receipt,
 ) error {
-	/* params := h.k.GetParams(ctx)
+	params := h.k.GetParams(ctx)
 	if !params.EnableErc20 || !params.EnableEVMHook {
 		// no error is returned to allow for other post processing txs
 		// to pass
 		return nil
 	}
 
-	erc20 := contracts.ERC20BurnableContract.ABI
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 
 	for i, log := range receipt.Logs {
-		if len(log.Topics) < 3 {
+		// ERC20 Transfer events have 3 topics: the event ID, from and to.
+		// ERC721 Transfer events share the event ID but also index the token ID.
+		if len(log.Topics) != 3 {
 			continue
 		}
 
@@ -51,95 +64,96 @@ func (h Hooks) PostTxProcessing(
 		}
 
 		if event.Name != types.ERC20EventTransfer {
-			h.k.Logger(ctx).Info("emitted event", "name", event.Name, "signature", event.Sig)
-			continue
-		}
-
-		transferEvent, err := erc20.Unpack(event.Name, log.Data)
-		if err != nil {
-			h.k.Logger(ctx).Error("failed to unpack transfer event", "error", err.Error())
-			continue
-		}
-
-		if len(transferEvent) == 0 {
 			continue
 		}
 
-		tokens, ok := transferEvent[0].(*big.Int)
-		// safety check and ignore if amount not positive
-		if !ok || tokens == nil || tokens.Sign() != 1 {
+		// only transfers to the module address are converted
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		if to != types.ModuleAddress {
 			continue
 		}
 
 		// check that the contract is a registered token pair
 		contractAddr := log.Address
-
 		id := h.k.GetERC20Map(ctx, contractAddr)
-
 		if len(id) == 0 {
 			// no token is registered for the caller contract
 			continue
 		}
 
-		pair, found := h.k.GetTokenPair(ctx, id)
-		if !found {
-			continue
+		transferEvent, err := erc20.Unpack(event.Name, log.Data)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrABIUnpack, "failed to unpack transfer event at log %d: %s", i, err.Error())
 		}
 
-		// check that conversion for the pair is enabled
-		if !pair.Enabled {
-			// continue to allow transfers for the ERC20 in case the token pair is disabled
-			h.k.Logger(ctx).Debug(
-				"ERC20 token -> Cosmos coin conversion is disabled for pair",
-				"coin", pair.Denom, "contract", pair.Erc20Address,
-			)
+		if len(transferEvent) == 0 {
 			continue
 		}
 
-		// ignore as the burning always transfers to the zero address
-		to := common.BytesToAddress(log.Topics[2].Bytes())
-		if !bytes.Equal(to.Bytes(), types.ModuleAddress.Bytes()) {
+		tokens, ok := transferEvent[0].(*big.Int)
+		// safety check and ignore if amount not positive
+		if !ok || tokens == nil || tokens.Sign() != 1 {
 			continue
 		}
 
-		// check that the event is Burn from the ERC20Burnable interface
-		// NOTE: assume that if they are burning the token that has been registered as a pair, they want to mint a Cosmos coin
+		// Only need last 20 bytes from log.topics
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		recipient := sdk.AccAddress(from.Bytes())
+
+		// reject the transfer if the tokens can't be converted, as they would
+		// otherwise remain locked on the module address
+		pair, err := h.k.MintingEnabled(ctx, recipient, recipient, contractAddr.String())
+		if err != nil {
+			return err
+		}
+
+		// refund the tokens if the quota is exceeded, without failing the
+		// transfer so that the disabled pair is persisted
+		if !h.k.ConsumeRateLimit(ctx, pair, types.Inflow, sdk.NewIntFromBigInt(tokens)) {
+			if _, err := h.k.CallEVM(ctx, erc20, types.ModuleAddress, contractAddr, true, "transfer", from, tokens); err != nil {
+				return sdkerrors.Wrapf(err, "failed to refund ERC20 %s to %s", pair.Erc20Address, from)
+			}
+			continue
+		}
 
 		// create the corresponding sdk.Coin that is paired with ERC20
 		coins := sdk.Coins{{Denom: pair.Denom, Amount: sdk.NewIntFromBigInt(tokens)}}
 
-		// Mint the coin only if ERC20 is external
 		switch pair.ContractOwner {
 		case types.OWNER_MODULE:
+			// the coins are escrowed on the module account, burn the received
+			// tokens from the module address before unescrowing them
 			_, err = h.k.CallEVM(ctx, erc20, types.ModuleAddress, contractAddr, true, "burn", tokens)
 		case types.OWNER_EXTERNAL:
+			// mint the coins as the tokens are escrowed on the module address
 			err = h.k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 		default:
 			err = types.ErrUndefinedOwner
 		}
 
 		if err != nil {
-			h.k.Logger(ctx).Debug(
-				"failed to process EVM hook for ER20 -> coin conversion",
-				"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
-			)
-			continue
+			return sdkerrors.Wrapf(err, "failed to convert ERC20 %s to coin %s", pair.Erc20Address, pair.Denom)
 		}
 
-		// Only need last 20 bytes from log.topics
-		from := common.BytesToAddress(log.Topics[1].Bytes())
-		recipient := sdk.AccAddress(from.Bytes())
-
 		// transfer the tokens from ModuleAccount to sender address
 		if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
-			h.k.Logger(ctx).Debug(
-				"failed to process EVM hook for ER20 -> coin conversion",
-				"tx-hash", receipt.TxHash.Hex(), "log-idx", i,
-				"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
-			)
-			continue
+			return sdkerrors.Wrapf(err, "failed to send %s to %s", coins, recipient)
+		}
+
+		// charge the conversion fee on the received coins
+		if _, err := h.k.chargeConversionFee(ctx, pair, types.Inflow, recipient, coins[0].Amount); err != nil {
+			return err
 		}
-	} */
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventERC20HookConversion{
+			Sender:     from.Hex(),
+			Receiver:   recipient.String(),
+			Amount:     tokens.String(),
+			CosmosCoin: pair.Denom,
+			Erc20Token: pair.Erc20Address,
+			TxHash:     receipt.TxHash.Hex(),
+		})
+	}
 
 	return nil
 }
@@ -1,447 +1,250 @@
 package keeper_test
 
-// import (
-//	"fmt"
-//	"math/big"
-//
-//	sdk "github.com/cosmos/cosmos-sdk/types"
-//
-//	"github.com/ethereum/go-ethereum/common"
-//	ethtypes "github.com/ethereum/go-ethereum/core/types"
-//
-//	"github.com/evmos/ethermint/tests"
-//
-//	"github.com/UptickNetwork/uptick/contracts"
-//	"github.com/UptickNetwork/uptick/x/erc20/types"
-//)
-//
-//// ensureHooksSet tries to set the hooks on EVMKeeper, this will fail if the erc20 hook is already set
-//func (suite *KeeperTestSuite) ensureHooksSet() {
-//	// TODO: PR to Ethermint to add the functionality `GetHooks` or `areHooksSet` to avoid catching a panic
-//	defer func() {
-//		err := recover()
-//		suite.Require().NotNil(err)
-//	}()
-//	suite.app.EvmKeeper.SetHooks(suite.app.Erc20Keeper.Hooks())
-//}
-//
-//func (suite *KeeperTestSuite) TestEvmHooksRegisterERC20() {
-//	testCases := []struct {
-//		name     string
-//		malleate func(common.Address)
-//		result   bool
-//	}{
-//		{
-//			"correct execution",
-//			func(contractAddr common.Address) {
-//				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				// Mint 10 tokens to suite.address (owner)
-//				_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
-//				suite.Commit()
-//
-//				// Burn the 10 tokens of suite.address (owner)
-//				_ = suite.BurnERC20Token(contractAddr, suite.address, big.NewInt(10))
-//			},
-//			true,
-//		},
-//		{
-//			"unregistered pair",
-//			func(contractAddr common.Address) {
-//				// Mint 10 tokens to suite.address (owner)
-//				_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
-//				suite.Commit()
-//
-//				// Burn the 10 tokens of suite.address (owner)
-//				_ = suite.BurnERC20Token(contractAddr, suite.address, big.NewInt(10))
-//			},
-//			false,
-//		},
-//		{
-//			"wrong event",
-//			func(contractAddr common.Address) {
-//				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				// Mint 10 tokens to suite.address (owner)
-//				_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
-//			},
-//			false,
-//		},
-//		{
-//			"Pair is disabled",
-//			func(contractAddr common.Address) {
-//				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				pair.Enabled = false
-//				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
-//				// Mint 10 tokens to suite.address (owner)
-//				_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
-//				suite.Commit()
-//
-//				// Burn the 10 tokens of suite.address (owner)
-//				_ = suite.BurnERC20Token(contractAddr, suite.address, big.NewInt(10))
-//
-//			},
-//			false,
-//		},
-//		{
-//			"Pair is incorrectly loaded",
-//			func(contractAddr common.Address) {
-//				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, *pair)
-//
-//				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
-//				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, pair.GetERC20Contract(), pair.GetID())
-//				// Mint 10 tokens to suite.address (owner)
-//				_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
-//				suite.Commit()
-//
-//				// Burn the 10 tokens of suite.address (owner)
-//				_ = suite.BurnERC20Token(contractAddr, suite.address, big.NewInt(10))
-//
-//			},
-//			false,
-//		},
-//	}
-//	for _, tc := range testCases {
-//
-//		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
-//			suite.mintFeeCollector = true
-//			suite.SetupTest()
-//
-//			suite.ensureHooksSet()
-//
-//			contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
-//			suite.Require().NoError(err)
-//			suite.Commit()
-//
-//			tc.malleate(contractAddr)
-//
-//			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(suite.address.Bytes()), types.CreateDenom(contractAddr.String()))
-//			suite.Commit()
-//			if tc.result {
-//				// Check if the execution was successful
-//				suite.Require().Equal(int64(10), balance.Amount.Int64())
-//			} else {
-//				// Check that no changes were made to the account
-//				suite.Require().Equal(int64(0), balance.Amount.Int64())
-//			}
-//		})
-//	}
-//	suite.mintFeeCollector = false
-//}
-//
-//func (suite *KeeperTestSuite) TestEvmHooksRegisterCoin() {
-//	testCases := []struct {
-//		name      string
-//		mint      int64
-//		burn      int64
-//		reconvert int64
-//
-//		result bool
-//	}{
-//		{"correct execution", 100, 10, 5, true},
-//	}
-//	for _, tc := range testCases {
-//		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
-//			suite.mintFeeCollector = true
-//			suite.SetupTest()
-//
-//			suite.ensureHooksSet()
-//
-//			metadata, pair := suite.setupRegisterCoin()
-//			suite.Require().NotNil(metadata)
-//			suite.Require().NotNil(pair)
-//
-//			sender := sdk.AccAddress(suite.address.Bytes())
-//			contractAddr := common.HexToAddress(pair.Erc20Address)
-//
-//			coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(tc.mint)))
-//			suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
-//			suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
-//
-//			convertCoin := types.NewMsgConvertCoin(
-//				sdk.NewCoin(cosmosTokenBase, sdk.NewInt(tc.burn)),
-//				suite.address,
-//				sender,
-//			)
-//
-//			ctx := sdk.WrapSDKContext(suite.ctx)
-//			_, err := suite.app.Erc20Keeper.ConvertCoin(ctx, convertCoin)
-//			suite.Require().NoError(err, tc.name)
-//			suite.Commit()
-//
-//			balance := suite.BalanceOf(common.HexToAddress(pair.Erc20Address), suite.address)
-//			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, metadata.Base)
-//			suite.Require().Equal(cosmosBalance.Amount.Int64(), sdk.NewInt(tc.mint-tc.burn).Int64())
-//			suite.Require().Equal(balance, big.NewInt(tc.burn))
-//
-//			// Burn the 10 tokens of suite.address (owner)
-//			_ = suite.BurnERC20Token(contractAddr, suite.address, big.NewInt(tc.reconvert))
-//
-//			balance = suite.BalanceOf(common.HexToAddress(pair.Erc20Address), suite.address)
-//			cosmosBalance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, metadata.Base)
-//
-//			if tc.result {
-//				// Check if the execution was successful
-//				suite.Require().NoError(err)
-//				suite.Require().Equal(cosmosBalance.Amount, sdk.NewInt(tc.mint-tc.burn+tc.reconvert))
-//			} else {
-//				// Check that no changes were made to the account
-//				suite.Require().Error(err)
-//				suite.Require().Equal(cosmosBalance.Amount, sdk.NewInt(tc.mint-tc.burn))
-//			}
-//		})
-//	}
-//	suite.mintFeeCollector = false
-//}
-//
-//func (suite *KeeperTestSuite) TestEvmHooksForceError() {
-//
-//	msg := ethtypes.NewMessage(
-//		types.ModuleAddress,
-//		&common.Address{},
-//		0,
-//		big.NewInt(0), // amount
-//		uint64(0),     // gasLimit
-//		big.NewInt(0), // gasFeeCap
-//		big.NewInt(0), // gasTipCap
-//		big.NewInt(0), // gasPrice
-//		[]byte{},
-//		ethtypes.AccessList{}, // AccessList
-//		true,                  // checkNonce
-//	)
-//
-//	account := tests.GenerateAddress()
-//
-//	transferData := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
-//	transferData[31] = uint8(10)
-//	erc20 := contracts.ERC20BurnableContract.ABI
-//
-//	transferEvent := erc20.Events["Transfer"]
-//
-//	testCases := []struct {
-//		name string
-//		test func()
-//	}{
-//		{
-//			"correct transfer (non burn)",
-//			func() {
-//				contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
-//				suite.Require().NoError(err)
-//				suite.Commit()
-//
-//				_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				topics := []common.Hash{transferEvent.ID, account.Hash(), account.Hash()}
-//				log := ethtypes.Log{
-//					Topics:  topics,
-//					Data:    transferData,
-//					Address: contractAddr,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"correct burn",
-//			func() {
-//				contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
-//				suite.Require().NoError(err)
-//				suite.Commit()
-//
-//				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
-//				log := ethtypes.Log{
-//					Topics:  topics,
-//					Data:    transferData,
-//					Address: contractAddr,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//				sender := sdk.AccAddress(account.Bytes())
-//				cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
-//
-//				transferEvent, err := erc20.Unpack("Transfer", transferData)
-//				suite.Require().NoError(err)
-//
-//				tokens, _ := transferEvent[0].(*big.Int)
-//				suite.Require().Equal(cosmosBalance.Amount.String(), tokens.String())
-//
-//			},
-//		},
-//		{
-//			"Unspecified Owner",
-//			func() {
-//				contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
-//				suite.Require().NoError(err)
-//				suite.Commit()
-//
-//				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				pair.ContractOwner = types.OWNER_UNSPECIFIED
-//				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
-//
-//				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
-//				log := ethtypes.Log{
-//					Topics:  topics,
-//					Data:    transferData,
-//					Address: contractAddr,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"Fail Evm",
-//			func() {
-//				contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
-//				suite.Require().NoError(err)
-//				suite.Commit()
-//
-//				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-//				suite.Require().NoError(err)
-//
-//				pair.ContractOwner = types.OWNER_MODULE
-//				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
-//
-//				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
-//				log := ethtypes.Log{
-//					Topics:  topics,
-//					Data:    transferData,
-//					Address: contractAddr,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"No log address",
-//			func() {
-//				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
-//				log := ethtypes.Log{
-//					Topics: topics,
-//					Data:   transferData,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"No data on topic",
-//			func() {
-//				topics := []common.Hash{transferEvent.ID}
-//				log := ethtypes.Log{
-//					Topics: topics,
-//					Data:   transferData,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"Empty logs",
-//			func() {
-//				log := ethtypes.Log{}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"No log data",
-//			func() {
-//				topics := []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()}
-//				log := ethtypes.Log{
-//					Topics: topics,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"Non transfer event",
-//			func() {
-//				aprovalEvent := erc20.Events["Approval"]
-//				topics := []common.Hash{aprovalEvent.ID, account.Hash(), account.Hash()}
-//				log := ethtypes.Log{
-//					Topics: topics,
-//					Data:   transferData,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//		{
-//			"Non recognized event",
-//			func() {
-//				topics := []common.Hash{{}, account.Hash(), account.Hash()}
-//				log := ethtypes.Log{
-//					Topics: topics,
-//					Data:   transferData,
-//				}
-//				receipt := &ethtypes.Receipt{
-//					Logs: []*ethtypes.Log{&log},
-//				}
-//
-//				err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
-//				suite.Require().NoError(err)
-//			},
-//		},
-//	}
-//	for _, tc := range testCases {
-//		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
-//			suite.mintFeeCollector = true
-//			suite.SetupTest()
-//			suite.ensureHooksSet()
-//
-//			tc.test()
-//
-//		})
-//	}
-//	suite.mintFeeCollector = false
-//}
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestEvmHooksPostTxProcessing() {
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	account := tests.GenerateAddress()
+	sender := sdk.AccAddress(account.Bytes())
+
+	transferData := make([]byte, 32)
+	transferData[31] = uint8(10)
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	transferEvent := erc20.Events["Transfer"]
+
+	newReceipt := func(contractAddr common.Address, to common.Address) *ethtypes.Receipt {
+		log := ethtypes.Log{
+			Topics:  []common.Hash{transferEvent.ID, account.Hash(), to.Hash()},
+			Data:    transferData,
+			Address: contractAddr,
+		}
+		return &ethtypes.Receipt{Logs: []*ethtypes.Log{&log}}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() (*ethtypes.Receipt, string)
+		expPass  bool
+		expCoins int64
+	}{
+		{
+			"ok - transfer not sent to the module address",
+			func() (*ethtypes.Receipt, string) {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+				return newReceipt(contractAddr, tests.GenerateAddress()), pair.Denom
+			},
+			true,
+			0,
+		},
+		{
+			"ok - unregistered contract",
+			func() (*ethtypes.Receipt, string) {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				return newReceipt(contractAddr, types.ModuleAddress), types.CreateDenom(contractAddr.String())
+			},
+			true,
+			0,
+		},
+		{
+			"ok - native ERC20 transferred to the module address",
+			func() (*ethtypes.Receipt, string) {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+				return newReceipt(contractAddr, types.ModuleAddress), pair.Denom
+			},
+			true,
+			10,
+		},
+		{
+			"ok - module ERC20 transferred to the module address",
+			func() (*ethtypes.Receipt, string) {
+				_, pair := suite.setupRegisterCoin()
+				contractAddr := pair.GetERC20Contract()
+
+				// the received tokens are held by the module address and
+				// their coins escrowed on the module account
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 10))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, contractAddr, true, "mint", types.ModuleAddress, big.NewInt(10))
+				suite.Require().NoError(err)
+
+				return newReceipt(contractAddr, types.ModuleAddress), pair.Denom
+			},
+			true,
+			10,
+		},
+		{
+			"fail - pair disabled",
+			func() (*ethtypes.Receipt, string) {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+				pair.Enabled = false
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
+				return newReceipt(contractAddr, types.ModuleAddress), pair.Denom
+			},
+			false,
+			0,
+		},
+		{
+			"fail - unspecified owner",
+			func() (*ethtypes.Receipt, string) {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+				pair.ContractOwner = types.OWNER_UNSPECIFIED
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
+				return newReceipt(contractAddr, types.ModuleAddress), pair.Denom
+			},
+			false,
+			0,
+		},
+		{
+			"fail - module doesn't hold the tokens to burn",
+			func() (*ethtypes.Receipt, string) {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+				pair.ContractOwner = types.OWNER_MODULE
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
+				return newReceipt(contractAddr, types.ModuleAddress), pair.Denom
+			},
+			false,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			receipt, denom := tc.malleate()
+			err := suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom)
+			suite.Require().Equal(tc.expCoins, balance.Amount.Int64())
+
+			// the converted tokens are burned or escrowed, no coins are left
+			// on the module account
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			escrowed := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denom)
+			suite.Require().True(escrowed.IsZero())
+			if tc.expPass && tc.expCoins > 0 {
+				pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, denom))
+				suite.Require().True(found)
+				if pair.IsNativeCoin() {
+					moduleBalance := suite.BalanceOf(pair.GetERC20Contract(), types.ModuleAddress)
+					suite.Require().Zero(moduleBalance.(*big.Int).Sign())
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksRateLimit() {
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	account := tests.GenerateAddress()
+	sender := sdk.AccAddress(account.Bytes())
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Transfer"]
+
+	testCases := []struct {
+		name        string
+		quota       int64
+		expPass     bool
+		expDisabled bool
+	}{
+		{"ok - within quota", 20, true, false},
+		{"ok - transfer uses up the quota", 10, true, true},
+		{"fail - transfer exceeds the quota", 5, false, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+			suite.Require().NoError(err)
+			_, err = suite.app.Erc20Keeper.UpdateTokenPairRateLimit(
+				suite.ctx, pair.Denom, types.NewRateLimit(sdk.NewInt(tc.quota), sdk.ZeroInt(), 100),
+			)
+			suite.Require().NoError(err)
+
+			// the transferred tokens are held by the module address
+			_, err = suite.app.Erc20Keeper.CallEVM(
+				suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, contractAddr, true,
+				"mint", types.ModuleAddress, big.NewInt(10),
+			)
+			suite.Require().NoError(err)
+
+			transferData := make([]byte, 32)
+			transferData[31] = uint8(10)
+			receipt := &ethtypes.Receipt{Logs: []*ethtypes.Log{{
+				Topics:  []common.Hash{transferEvent.ID, account.Hash(), types.ModuleAddress.Hash()},
+				Data:    transferData,
+				Address: contractAddr,
+			}}}
+
+			err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+			if tc.expPass {
+				suite.Require().Equal(int64(10), balance.Amount.Int64())
+			} else {
+				// the tokens are refunded to the sender
+				suite.Require().True(balance.IsZero())
+				suite.Require().Equal(int64(10), suite.BalanceOf(contractAddr, account).(*big.Int).Int64())
+			}
+
+			stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+			suite.Require().True(found)
+			suite.Require().Equal(!tc.expDisabled, stored.Enabled)
+		})
+	}
+}
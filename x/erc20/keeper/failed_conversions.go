@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// GetFailedConversion returns the failed conversion of a packet received on
+// the given channel
+func (k Keeper) GetFailedConversion(ctx sdk.Context, receiver sdk.AccAddress, channelID string, sequence uint64) (types.FailedConversion, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	bz := store.Get(types.FailedConversionKey(receiver, channelID, sequence))
+	if len(bz) == 0 {
+		return types.FailedConversion{}, false
+	}
+
+	var fc types.FailedConversion
+	k.cdc.MustUnmarshal(bz, &fc)
+	return fc, true
+}
+
+// SetFailedConversion stores the failed conversion of a received packet
+func (k Keeper) SetFailedConversion(ctx sdk.Context, fc types.FailedConversion) {
+	receiver := sdk.MustAccAddressFromBech32(fc.Receiver)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	bz := k.cdc.MustMarshal(&fc)
+	store.Set(types.FailedConversionKey(receiver, fc.DestinationChannel, fc.Sequence), bz)
+}
+
+// DeleteFailedConversion removes the failed conversion of a packet received on
+// the given channel
+func (k Keeper) DeleteFailedConversion(ctx sdk.Context, receiver sdk.AccAddress, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	store.Delete(types.FailedConversionKey(receiver, channelID, sequence))
+}
+
+// GetAllFailedConversions returns the failed conversions of all the receivers
+func (k Keeper) GetAllFailedConversions(ctx sdk.Context) []types.FailedConversion {
+	failed := []types.FailedConversion{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fc types.FailedConversion
+		k.cdc.MustUnmarshal(iterator.Value(), &fc)
+		failed = append(failed, fc)
+	}
+
+	return failed
+}
+
+// RetryIBCConversion converts the coins of a transfer received over IBC that
+// couldn't be converted to ERC20 tokens of the receiver
+func (k Keeper) RetryIBCConversion(
+	goCtx context.Context,
+	msg *types.MsgRetryIBCConversion,
+) (*types.MsgRetryIBCConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	fc, found := k.GetFailedConversion(ctx, sender, msg.DestinationChannel, msg.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			types.ErrFailedConversionNotFound,
+			"receiver: %s, channel: %s, sequence: %d", msg.Sender, msg.DestinationChannel, msg.Sequence,
+		)
+	}
+
+	res, err := k.ConvertCoin(goCtx, types.NewMsgConvertCoin(fc.Coin, common.BytesToAddress(sender.Bytes()), sender))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		// NOTE: return nil error to persist the disabled or deleted token pair,
+		// the failed conversion is kept
+		return nil, nil
+	}
+
+	k.DeleteFailedConversion(ctx, sender, msg.DestinationChannel, msg.Sequence)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRetryIBCConversion,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fc.Coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, fc.Coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyChannel, msg.DestinationChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(msg.Sequence, 10)),
+			),
+		},
+	)
+
+	return &types.MsgRetryIBCConversionResponse{}, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestFailedConversions() {
+	suite.SetupTest()
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	coin := sdk.NewInt64Coin("ibc/uatom", 100)
+
+	fc := types.NewFailedConversion(receiver, coin, "channel-0", 1, "conversion failed")
+	suite.app.Erc20Keeper.SetFailedConversion(suite.ctx, fc)
+	suite.app.Erc20Keeper.SetFailedConversion(suite.ctx, types.NewFailedConversion(other, coin, "channel-0", 1, "conversion failed"))
+
+	res, found := suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, receiver, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(fc, res)
+	_, found = suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, receiver, "channel-0", 2)
+	suite.Require().False(found)
+	suite.Require().Len(suite.app.Erc20Keeper.GetAllFailedConversions(suite.ctx), 2)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	queryRes, err := suite.app.Erc20Keeper.FailedConversions(ctx, &types.QueryFailedConversionsRequest{Receiver: receiver.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FailedConversion{fc}, queryRes.FailedConversions)
+
+	// the failed conversion is kept when the retry fails
+	_, err = suite.app.Erc20Keeper.RetryIBCConversion(ctx, types.NewMsgRetryIBCConversion(receiver, "channel-0", 2))
+	suite.Require().Error(err)
+	_, err = suite.app.Erc20Keeper.RetryIBCConversion(ctx, types.NewMsgRetryIBCConversion(receiver, "channel-0", 1))
+	suite.Require().Error(err)
+	_, found = suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, receiver, "channel-0", 1)
+	suite.Require().True(found)
+
+	suite.app.Erc20Keeper.DeleteFailedConversion(suite.ctx, receiver, "channel-0", 1)
+	_, found = suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, receiver, "channel-0", 1)
+	suite.Require().False(found)
+}
@@ -78,6 +78,145 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// PairSupply returns the supplies of both sides of a token pair and the amounts
+// escrowed by the module
+func (k Keeper) PairSupply(c context.Context, req *types.QueryPairSupplyRequest) (*types.QueryPairSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	supply, err := k.GetPairSupply(ctx, pair)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPairSupplyResponse{
+		TokenPair: pair,
+		Supply:    supply,
+	}, nil
+}
+
+// EstimateConversion returns the fee charged on the conversion of an amount of
+// a token pair
+func (k Keeper) EstimateConversion(c context.Context, req *types.QueryEstimateConversionRequest) (*types.QueryEstimateConversionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// converting an ERC20 address mints Cosmos coins, converting a denom mints
+	// ERC20 tokens
+	direction := types.Outflow
+	if err := ethermint.ValidateAddress(req.Token); err == nil {
+		direction = types.Inflow
+	} else if err := sdk.ValidateDenom(req.Token); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+		)
+	}
+
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %s", req.Amount)
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	params := k.GetParams(ctx)
+	feeBps := params.ConversionFeeBps(pair, direction)
+	fee := types.ComputeConversionFee(amount, feeBps)
+
+	return &types.QueryEstimateConversionResponse{
+		Fee:          fee,
+		AmountOut:    amount.Sub(fee),
+		FeeBps:       feeBps,
+		FeeRecipient: params.FeeRecipient,
+	}, nil
+}
+
+// AutoConvert returns whether the coins received over IBC by an account are
+// converted to ERC20 tokens
+func (k Keeper) AutoConvert(c context.Context, req *types.QueryAutoConvertRequest) (*types.QueryAutoConvertResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAutoConvertResponse{Enabled: k.IsAutoConvertEnabled(ctx, addr)}, nil
+}
+
+// FailedConversions returns the coins received over IBC by an account that
+// couldn't be converted
+func (k Keeper) FailedConversions(c context.Context, req *types.QueryFailedConversionsRequest) (*types.QueryFailedConversionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(req.Receiver)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid receiver %s: %s", req.Receiver, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var failed []types.FailedConversion
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixFailedConversion, types.FailedConversionsKey(receiver)...))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fc types.FailedConversion
+		if err := k.cdc.Unmarshal(value, &fc); err != nil {
+			return err
+		}
+		failed = append(failed, fc)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFailedConversionsResponse{
+		FailedConversions: failed,
+		Pagination:        pageRes,
+	}, nil
+}
+
 // Params return hub contract param
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
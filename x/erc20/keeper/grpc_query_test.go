@@ -82,6 +82,99 @@ func (suite *KeeperTestSuite) TestTokenPairs() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEstimateConversion() {
+	var (
+		req    *types.QueryEstimateConversionRequest
+		expRes *types.QueryEstimateConversionResponse
+	)
+
+	setPair := func(conversionFee *types.ConversionFee) types.TokenPair {
+		addr := tests.GenerateAddress()
+		pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+		pair.ConversionFee = conversionFee
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		return pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid amount",
+			func() {
+				pair := setPair(nil)
+				req = &types.QueryEstimateConversionRequest{Token: pair.Denom, Amount: "-1"}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryEstimateConversionRequest{Token: tests.GenerateAddress().Hex(), Amount: "100"}
+			},
+			false,
+		},
+		{
+			"params fee",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.OutflowFeeBps = 100
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+				pair := setPair(nil)
+				req = &types.QueryEstimateConversionRequest{Token: pair.Denom, Amount: "1000"}
+				expRes = &types.QueryEstimateConversionResponse{
+					Fee:          sdk.NewInt(10),
+					AmountOut:    sdk.NewInt(990),
+					FeeBps:       100,
+					FeeRecipient: params.FeeRecipient,
+				}
+			},
+			true,
+		},
+		{
+			"token pair fee override",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				conversionFee := types.NewConversionFee(50, 0)
+
+				pair := setPair(&conversionFee)
+				req = &types.QueryEstimateConversionRequest{Token: pair.Erc20Address, Amount: "1000"}
+				expRes = &types.QueryEstimateConversionResponse{
+					Fee:          sdk.NewInt(5),
+					AmountOut:    sdk.NewInt(995),
+					FeeBps:       50,
+					FeeRecipient: params.FeeRecipient,
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.EstimateConversion(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Fee.String(), res.Fee.String())
+				suite.Require().Equal(expRes.AmountOut.String(), res.AmountOut.String())
+				suite.Require().Equal(expRes.FeeBps, res.FeeBps)
+				suite.Require().Equal(expRes.FeeRecipient, res.FeeRecipient)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	expParams := types.DefaultParams()
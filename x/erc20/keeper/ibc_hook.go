@@ -3,9 +3,11 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/UptickNetwork/uptick/contracts"
 	"github.com/UptickNetwork/uptick/x/erc20/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
@@ -13,11 +15,24 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
-// OnRecvPacket will get the denom name from ibc ,generate by port/channel/denom
+// OnRecvPacket will get the denom name from ibc ,generate by port/channel/denom,
+// register the vouchers received on the auto register channels and convert the received coins following the route of the packet:
+//  - keep native: the coins are kept by the receiver
+//  - default or convert: the coins are converted to ERC20 tokens unless the
+//    receiver opted out
+//  - convert and call: the coins are converted to ERC20 tokens of the IBC
+//    caller address of the sender, which approves and calls the contract
+//
+// The underlying success acknowledgement is returned when the conversion
+// fails, the receiver keeps the IBC coins and can retry the conversion with
+// MsgRetryIBCConversion. Convert and call routes are rejected with an error
+// acknowledgement instead, as their coins can't be kept by the IBC caller
+// address.
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	ack exported.Acknowledgement,
+	route types.IBCRoute,
 ) exported.Acknowledgement {
 	event := &types.EventIBCERC20{
 		Status:             types.STATUS_UNKNOWN,
@@ -33,58 +48,213 @@ func (k Keeper) OnRecvPacket(
 		event.Status = types.STATUS_FAILED
 		event.Message = err.Error()
 		_ = ctx.EventManager().EmitTypedEvent(event)
-		return nil
+		return ack
 	}
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
 		event.Status = types.STATUS_FAILED
 		event.Message = "Change data.Amount type to int error"
 		_ = ctx.EventManager().EmitTypedEvent(event)
-		return nil
+		return ack
+	}
+	// register the vouchers received on the auto register channels, even if
+	// they are kept by the receiver
+	if err := k.autoRegisterIBCCoin(ctx, packet, data.Denom); err != nil {
+		k.Logger(ctx).Error(
+			"failed to register the received ibc denom",
+			"denom", data.Denom,
+			"channel", packet.DestinationChannel,
+			"error", err.Error(),
+		)
 	}
+
 	receiver, _ := sdk.AccAddressFromBech32(data.Receiver)
+	if route.Action == types.ActionKeepNative ||
+		(route.Action != types.ActionConvertAndCall && !k.IsAutoConvertEnabled(ctx, receiver)) {
+		return ack
+	}
+
 	denom, err := types.IBCDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
 	if err != nil {
 		event.Status = types.STATUS_FAILED
 		event.Message = err.Error()
 		_ = ctx.EventManager().EmitTypedEvent(event)
-		return nil
+		return rejectCall(ack, route, err)
 	}
 
 	if !k.IsDenomRegistered(ctx, denom) {
 		event.Status = types.STATUS_FAILED
 		event.Message = fmt.Sprintf("denom %s not registered", denom)
 		_ = ctx.EventManager().EmitTypedEvent(event)
-		return nil
+		return rejectCall(ack, route, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "denom %s not registered", denom))
+	}
+
+	// record the coins kept by the receiver when the conversion fails
+	coin := sdk.NewCoin(denom, transferAmount)
+	fail := func(err error) exported.Acknowledgement {
+		event.Status = types.STATUS_FAILED
+		event.Message = err.Error()
+		_ = ctx.EventManager().EmitTypedEvent(event)
+
+		if route.Action == types.ActionConvertAndCall {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		k.SetFailedConversion(ctx, types.NewFailedConversion(receiver, coin, packet.DestinationChannel, packet.Sequence, err.Error()))
+		return ack
 	}
+
 	msg := types.NewMsgConvertCoin(
-		sdk.NewCoin(denom, transferAmount),
+		coin,
 		common.BytesToAddress(receiver.Bytes()),
 		receiver,
 	)
 	// use cctx to ConvertCoin
 	context := sdk.WrapSDKContext(cctx)
-	_, err = k.ConvertCoin(context, msg)
+	res, err := k.ConvertCoin(context, msg)
 	if err != nil {
-		event.Status = types.STATUS_FAILED
-		event.Message = err.Error()
-		_ = ctx.EventManager().EmitTypedEvent(event)
-		return nil
+		return fail(err)
+	}
+
+	// the tokens are spent by the contract call, reject the packet if it fails
+	// so that the coins are refunded to the sender
+	if res != nil && route.Action == types.ActionConvertAndCall {
+		if err := k.callIBCContract(cctx, denom, transferAmount, common.BytesToAddress(receiver.Bytes()), route); err != nil {
+			return fail(err)
+		}
 	}
 
 	write()
 	ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+
+	// the conversion was rejected but the disabled or selfdestructed pair was
+	// persisted
+	if res == nil {
+		return fail(sdkerrors.Wrapf(types.ErrERC20Disabled, "conversion of %s rejected", denom))
+	}
+
 	event.Status = types.STATUS_SUCCESS
 	_ = ctx.EventManager().EmitTypedEvent(event)
+	return ack
+}
+
+// autoRegisterIBCCoin registers a token pair for the vouchers of a denomination
+// received on an auto register channel, which deploys an ERC20 contract with
+// the metadata built from the denomination trace. The existing metadata of the
+// vouchers is kept.
+func (k Keeper) autoRegisterIBCCoin(ctx sdk.Context, packet channeltypes.Packet, denom string) error {
+	if !k.GetParams(ctx).IsAutoRegisterChannel(packet.GetDestChannel()) {
+		return nil
+	}
+
+	// the coins returning to their source chain aren't vouchers
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		return nil
+	}
+
+	denomTrace := types.IBCDenomTrace(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	voucher := denomTrace.IBCDenom()
+	if k.IsDenomRegistered(ctx, voucher) {
+		return nil
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, voucher)
+	if !found {
+		metadata = types.IBCDenomMetadata(denomTrace)
+	}
+
+	cctx, write := ctx.CacheContext()
+	pair, err := k.RegisterCoin(cctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAutoRegisterCoin,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyDenomTrace, denomTrace.GetFullDenomPath()),
+			),
+		},
+	)
+
+	return nil
+}
+
+// rejectCall returns an error acknowledgement for the convert and call routes
+// and the underlying acknowledgement otherwise
+func rejectCall(ack exported.Acknowledgement, route types.IBCRoute, err error) exported.Acknowledgement {
+	if route.Action == types.ActionConvertAndCall {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// callIBCContract approves the converted tokens to the contract of a convert
+// and call route and calls it from the IBC caller address
+func (k Keeper) callIBCContract(ctx sdk.Context, denom string, amount sdk.Int, caller common.Address, route types.IBCRoute) error {
+	id := k.GetTokenPairID(ctx, denom)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenPairNotFound, "coin '%s' not registered", denom)
+	}
+
+	// the conversion fee is charged on the converted tokens
+	fee := types.ComputeConversionFee(amount, k.GetParams(ctx).ConversionFeeBps(pair, types.Outflow))
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	if _, err := k.CallEVM(ctx, erc20, caller, pair.GetERC20Contract(), true, "approve", route.Contract, amount.Sub(fee).BigInt()); err != nil {
+		return err
+	}
+
+	if _, err := k.CallEVMWithData(ctx, caller, &route.Contract, route.Data, true); err != nil {
+		return sdkerrors.Wrapf(err, "failed to call contract %s", route.Contract)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeIBCContractCall,
+				sdk.NewAttribute(sdk.AttributeKeySender, caller.Hex()),
+				sdk.NewAttribute(types.AttributeKeyContract, route.Contract.Hex()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.Sub(fee).String()),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		},
+	)
+
 	return nil
 }
 
+// OnAcknowledgementPacket converts the refunded coins of a failed transfer
+// sent by MsgTransferERC20 back to ERC20 tokens
 func (k Keeper) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 ) error {
-	// nothing to do
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if ack.Success() {
+		k.DeleteIBCTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		return nil
+	}
+
+	k.refundIBCTransfer(ctx, packet)
+	return nil
+}
+
+// OnTimeoutPacket converts the refunded coins of a timed out transfer sent by
+// MsgTransferERC20 back to ERC20 tokens
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.refundIBCTransfer(ctx, packet)
 	return nil
 }
 
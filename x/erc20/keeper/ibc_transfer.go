@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// GetIBCTransfer returns the hex address of the sender of a packet sent by
+// MsgTransferERC20
+func (k Keeper) GetIBCTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCTransfer)
+	bz := store.Get(types.IBCTransferKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// SetIBCTransfer records the hex address of the sender of a packet sent by
+// MsgTransferERC20
+func (k Keeper) SetIBCTransfer(ctx sdk.Context, portID, channelID string, sequence uint64, sender common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCTransfer)
+	store.Set(types.IBCTransferKey(portID, channelID, sequence), sender.Bytes())
+}
+
+// DeleteIBCTransfer removes the record of a packet sent by MsgTransferERC20
+func (k Keeper) DeleteIBCTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCTransfer)
+	store.Delete(types.IBCTransferKey(portID, channelID, sequence))
+}
+
+// refundIBCTransfer converts the coins refunded by the transfer module back to
+// ERC20 tokens for the sender of a packet sent by MsgTransferERC20. The
+// refund isn't charged a conversion fee nor counted against the rate limit as
+// it reverts the conversion of the transfer.
+//
+// NOTE: the packet refund must not fail, the coins are kept by the sender if
+// they can't be converted.
+func (k Keeper) refundIBCTransfer(ctx sdk.Context, packet channeltypes.Packet) {
+	sender, found := k.GetIBCTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.DeleteIBCTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	cctx, write := ctx.CacheContext()
+	coin, err := k.convertRefund(cctx, packet, sender)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to convert the refunded coins back to erc20",
+			"sender", sender.Hex(),
+			"channel", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRefundERC20,
+				sdk.NewAttribute(types.AttributeKeyReceiver, sender.Hex()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			),
+		},
+	)
+}
+
+func (k Keeper) convertRefund(ctx sdk.Context, packet channeltypes.Packet, sender common.Address) (sdk.Coin, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data")
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", data.Amount)
+	}
+
+	// the refunded coins have the denomination of the sending chain
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	coin := sdk.NewCoin(denom, amount)
+	account := sdk.AccAddress(sender.Bytes())
+
+	pair, err := k.MintingEnabled(ctx, account, account, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	msg := types.NewMsgConvertCoin(coin, sender, account)
+	switch {
+	case pair.IsNativeCoin():
+		_, err = k.convertCoinNativeCoin(ctx, pair, msg, sender, account)
+	case pair.IsNativeERC20():
+		_, err = k.convertCoinNativeERC20(ctx, pair, msg, sender, account)
+	default:
+		err = types.ErrUndefinedOwner
+	}
+
+	return coin, err
+}
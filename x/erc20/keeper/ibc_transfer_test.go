@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"github.com/evmos/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestIBCTransfer() {
+	sender := tests.GenerateAddress()
+
+	_, found := suite.app.Erc20Keeper.GetIBCTransfer(suite.ctx, "transfer", "channel-0", 1)
+	suite.Require().False(found)
+
+	suite.app.Erc20Keeper.SetIBCTransfer(suite.ctx, "transfer", "channel-0", 1, sender)
+	res, found := suite.app.Erc20Keeper.GetIBCTransfer(suite.ctx, "transfer", "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sender, res)
+
+	_, found = suite.app.Erc20Keeper.GetIBCTransfer(suite.ctx, "transfer", "channel-1", 1)
+	suite.Require().False(found)
+	_, found = suite.app.Erc20Keeper.GetIBCTransfer(suite.ctx, "transfer", "channel-0", 2)
+	suite.Require().False(found)
+
+	suite.app.Erc20Keeper.DeleteIBCTransfer(suite.ctx, "transfer", "channel-0", 1)
+	_, found = suite.app.Erc20Keeper.GetIBCTransfer(suite.ctx, "transfer", "channel-0", 1)
+	suite.Require().False(found)
+}
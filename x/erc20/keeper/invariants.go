@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// RegisterInvariants registers all erc20 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "token-pair-supply", TokenPairSupplyInvariant(k))
+}
+
+// TokenPairSupplyInvariant checks that the escrowed amounts of the token pairs
+// back the converted supply:
+//  - native coin pairs: the coins held by the module account are at least the
+//    ERC20 total supply, as the tokens can be burned directly on the contract
+//  - native ERC20 pairs: the ERC20 tokens held by the module address are at
+//    least the bank supply of the coin
+func TokenPairSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, pair := range k.GetAllTokenPairs(ctx) {
+			if err := k.checkPairSupply(ctx, pair); err != nil {
+				count++
+				msg += fmt.Sprintf("\ttoken pair %s (%s): %s\n", pair.Denom, pair.Erc20Address, err.Error())
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "token-pair-supply",
+			fmt.Sprintf("%d token pair supply invariants found\n%s", count, msg),
+		), broken
+	}
+}
+
+func (k Keeper) checkPairSupply(ctx sdk.Context, pair types.TokenPair) error {
+	supply, err := k.GetPairSupply(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case pair.IsNativeCoin():
+		if supply.CoinEscrowed.LT(supply.Erc20Supply) {
+			return fmt.Errorf("escrowed coins %s don't cover the ERC20 total supply %s", supply.CoinEscrowed, supply.Erc20Supply)
+		}
+	case pair.IsNativeERC20():
+		if supply.Erc20Escrowed.LT(supply.CoinSupply) {
+			return fmt.Errorf("escrowed ERC20 tokens %s don't cover the coin supply %s", supply.Erc20Escrowed, supply.CoinSupply)
+		}
+	default:
+		return types.ErrUndefinedOwner
+	}
+
+	return nil
+}
+
+// GetPairSupply returns the supplies of both sides of a token pair and the
+// amounts escrowed by the module
+func (k Keeper) GetPairSupply(ctx sdk.Context, pair types.TokenPair) (types.PairSupply, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	erc20Supply, err := k.totalSupply(ctx, erc20, contract)
+	if err != nil {
+		return types.PairSupply{}, err
+	}
+
+	erc20Escrowed := k.balanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if erc20Escrowed == nil {
+		return types.PairSupply{}, sdkerrors.Wrapf(types.ErrABIUnpack, "failed to query the escrowed balance of %s", contract)
+	}
+
+	moduleAcc := sdk.AccAddress(types.ModuleAddress.Bytes())
+
+	return types.PairSupply{
+		Erc20Supply:   sdk.NewIntFromBigInt(erc20Supply),
+		Erc20Escrowed: sdk.NewIntFromBigInt(erc20Escrowed),
+		CoinSupply:    k.bankKeeper.GetSupply(ctx, pair.Denom).Amount,
+		CoinEscrowed:  k.bankKeeper.GetBalance(ctx, moduleAcc, pair.Denom).Amount,
+	}, nil
+}
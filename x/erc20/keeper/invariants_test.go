@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/keeper"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestTokenPairSupplyInvariant() {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"ok - no token pairs",
+			func() {},
+			false,
+		},
+		{
+			"ok - native ERC20 without conversions",
+			func() {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - native ERC20 tokens escrowed",
+			func() {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+
+				// mint without the EVM hooks, which would convert the tokens
+				// received by the module address
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contractAddr, true, "mint", types.ModuleAddress, big.NewInt(100))
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			false,
+		},
+		{
+			"fail - native ERC20 coins not backed by escrowed tokens",
+			func() {
+				contractAddr := suite.DeployContract("coin", "token", erc20Decimals)
+				pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			true,
+		},
+		{
+			"ok - native coins escrowed",
+			func() {
+				suite.setupConvertedCoin(100)
+			},
+			false,
+		},
+		{
+			"ok - ERC20 tokens of native coins burned on the contract",
+			func() {
+				pair := suite.setupConvertedCoin(100)
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, pair.GetERC20Contract(), true, "burn", big.NewInt(40))
+				suite.Require().NoError(err)
+
+				supply, err := suite.app.Erc20Keeper.GetPairSupply(suite.ctx, *pair)
+				suite.Require().NoError(err)
+				suite.Require().True(supply.Erc20Supply.Equal(sdk.NewInt(60)))
+				suite.Require().True(supply.CoinEscrowed.Equal(sdk.NewInt(100)))
+			},
+			false,
+		},
+		{
+			"fail - ERC20 tokens of native coins not backed by escrowed coins",
+			func() {
+				pair := suite.setupConvertedCoin(100)
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, pair.GetERC20Contract(), true, "mint", suite.address, big.NewInt(1))
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			_, broken := keeper.TokenPairSupplyInvariant(*suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+// setupConvertedCoin registers a native coin and converts the given amount of
+// coins of the suite address into ERC20 tokens
+func (suite *KeeperTestSuite) setupConvertedCoin(amount int64) *types.TokenPair {
+	_, pair := suite.setupRegisterCoin()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, amount))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	msg := types.NewMsgConvertCoin(coins[0], suite.address, sender)
+	_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	return pair
+}
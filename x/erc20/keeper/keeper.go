@@ -23,8 +23,12 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
 	evmKeeper     types.EVMKeeper
-	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+
+	ics4Wrapper    porttypes.ICS4Wrapper
+	transferKeeper types.TransferKeeper
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -34,7 +38,9 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	ek types.EVMKeeper,
+	ck types.ChannelKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -47,7 +53,9 @@ func NewKeeper(
 		paramstore:    ps,
 		accountKeeper: ak,
 		bankKeeper:    bk,
+		distrKeeper:   dk,
 		evmKeeper:     ek,
+		channelKeeper: ck,
 	}
 }
 
@@ -65,3 +73,13 @@ func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
 
 	k.ics4Wrapper = ics4Wrapper
 }
+
+// SetTransferKeeper sets the IBC transfer keeper used by MsgTransferERC20.
+// It panics if already set
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	if k.transferKeeper != nil {
+		panic("transfer keeper already set")
+	}
+
+	k.transferKeeper = transferKeeper
+}
@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -11,3 +17,19 @@ func NewMigrator(keeper Keeper) Migrator {
 		keeper: keeper,
 	}
 }
+
+// Migrate1to2 migrates the store from consensus version 1 to 2:
+//  - set the default values of the registration deposit, conversion fees, fee
+//    recipient and auto register channels parameters, added in version 2
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	ps := m.keeper.paramstore
+
+	ps.Set(ctx, types.ParamStoreKeyRegistrationDeposit, defaults.RegistrationDeposit)
+	ps.Set(ctx, types.ParamStoreKeyInflowFeeBps, defaults.InflowFeeBps)
+	ps.Set(ctx, types.ParamStoreKeyOutflowFeeBps, defaults.OutflowFeeBps)
+	ps.Set(ctx, types.ParamStoreKeyFeeRecipient, defaults.FeeRecipient)
+	ps.Set(ctx, types.ParamStoreKeyAutoRegisterChannels, defaults.AutoRegisterChannels)
+
+	return nil
+}
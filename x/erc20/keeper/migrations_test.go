@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/UptickNetwork/uptick/x/erc20/keeper"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	params.EnableEVMHook = false
+	suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+	// remove the params added in version 2 from the erc20 subspace
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.ParamStoreKeyRegistrationDeposit,
+		types.ParamStoreKeyInflowFeeBps,
+		types.ParamStoreKeyOutflowFeeBps,
+		types.ParamStoreKeyFeeRecipient,
+		types.ParamStoreKeyAutoRegisterChannels,
+	} {
+		store.Delete(key)
+	}
+	suite.Require().Panics(func() { suite.app.Erc20Keeper.GetParams(suite.ctx) })
+
+	m := keeper.NewMigrator(*suite.app.Erc20Keeper)
+	suite.Require().NoError(m.Migrate1to2(suite.ctx))
+
+	expParams := types.DefaultParams()
+	expParams.EnableEVMHook = false
+	suite.Require().Equal(expParams, suite.app.Erc20Keeper.GetParams(suite.ctx))
+}
@@ -3,14 +3,17 @@ package keeper
 import (
 	"context"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
-	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"github.com/UptickNetwork/uptick/contracts"
 	"github.com/UptickNetwork/uptick/x/erc20/types"
@@ -49,6 +52,23 @@ func (k Keeper) ConvertCoin(
 		return nil, nil
 	}
 
+	// Reject the conversion if the quota is exceeded
+	if !k.ConsumeRateLimit(ctx, pair, types.Outflow, msg.Coin.Amount) {
+		// NOTE: return nil error to persist the disabled token pair
+		return nil, nil
+	}
+
+	// Charge the conversion fee and convert the remaining coins
+	fee, err := k.chargeConversionFee(ctx, pair, types.Outflow, sender, msg.Coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if fee.IsPositive() {
+		netMsg := *msg
+		netMsg.Coin = sdk.Coin{Denom: msg.Coin.Denom, Amount: msg.Coin.Amount.Sub(fee)}
+		msg = &netMsg
+	}
+
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
@@ -91,15 +111,371 @@ func (k Keeper) ConvertERC20(
 		return nil, nil
 	}
 
+	// Reject the conversion if the quota is exceeded
+	if !k.ConsumeRateLimit(ctx, pair, types.Inflow, msg.Amount) {
+		// NOTE: return nil error to persist the disabled token pair
+		return nil, nil
+	}
+
 	// Check ownership
+	var res *types.MsgConvertERC20Response
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
+		res, err = k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
 	case pair.IsNativeERC20():
-		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
+		res, err = k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Charge the conversion fee on the received coins
+	if _, err := k.chargeConversionFee(ctx, pair, types.Inflow, receiver, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TransferERC20 converts ERC20 tokens into Cosmos coins and sends them over
+// IBC in a single transaction. The packet is recorded so that the coins
+// refunded on a timeout or an error acknowledgement are converted back to
+// ERC20 tokens for the sender.
+func (k Keeper) TransferERC20(
+	goCtx context.Context,
+	msg *types.MsgTransferERC20,
+) (*types.MsgTransferERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := common.HexToAddress(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+	account := sdk.AccAddress(sender.Bytes())
+
+	if k.transferKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "transfer keeper not set")
+	}
+
+	id := k.GetTokenPairID(ctx, msg.ContractAddress)
+	if len(id) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered by id", msg.ContractAddress)
+	}
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", msg.ContractAddress)
+	}
+
+	// Convert the tokens to the sender account, the coins received are net of
+	// the conversion fee
+	balance := k.bankKeeper.GetBalance(ctx, account, pair.Denom)
+	res, err := k.ConvertERC20(goCtx, types.NewMsgConvertERC20(msg.Amount, account, contract, sender))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		// NOTE: return nil error to persist the disabled or deleted token pair
+		return nil, nil
+	}
+	coin := k.bankKeeper.GetBalance(ctx, account, pair.Denom).Sub(balance)
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", msg.SourcePort, msg.SourceChannel,
+		)
+	}
+
+	if _, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &transfertypes.MsgTransfer{
+		SourcePort:       msg.SourcePort,
+		SourceChannel:    msg.SourceChannel,
+		Token:            coin,
+		Sender:           account.String(),
+		Receiver:         msg.Receiver,
+		TimeoutHeight:    msg.TimeoutHeight,
+		TimeoutTimestamp: msg.TimeoutTimestamp,
+	}); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to transfer %s", coin)
+	}
+
+	k.SetIBCTransfer(ctx, msg.SourcePort, msg.SourceChannel, sequence, sender)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeTransferERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyChannel, msg.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			),
+		},
+	)
+
+	return &types.MsgTransferERC20Response{Sequence: sequence}, nil
+}
+
+// RegisterERC20Pair registers the token pair of an ERC20 contract without a
+// governance proposal. The sender must be the contract deployer and escrows
+// the registration deposit, which is refunded or slashed by governance.
+func (k Keeper) RegisterERC20Pair(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20,
+) (*types.MsgRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return nil, sdkerrors.Wrap(types.ErrERC20Disabled, "registration is currently disabled by governance")
+	}
+	if params.RegistrationDeposit.Empty() {
+		return nil, sdkerrors.Wrap(types.ErrERC20Disabled, "self-serve registration is currently disabled by governance")
+	}
+
+	// Error checked during msg validation
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	// only the deployer of the contract can register it
+	deployer := common.BytesToAddress(sender.Bytes())
+	if crypto.CreateAddress(deployer, msg.DeployNonce) != contract {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not the deployer of %s with nonce %d", deployer, contract, msg.DeployNonce,
+		)
+	}
+
+	if err := k.verifyERC20Contract(ctx, contract, deployer); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.DepositModuleName, params.RegistrationDeposit); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to escrow registration deposit")
+	}
+
+	pair, err := k.RegisterERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetRegistrationDeposit(ctx, types.RegistrationDeposit{
+		Erc20Address: pair.Erc20Address,
+		Depositor:    msg.Sender,
+		Amount:       params.RegistrationDeposit,
+	})
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRegisterERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, params.RegistrationDeposit.String()),
+			),
+		},
+	)
+
+	return &types.MsgRegisterERC20Response{}, nil
+}
+
+// verifyERC20Contract checks that a contract registered without governance
+// behaves as a plain ERC20:
+//  - the bytecode doesn't contain SELFDESTRUCT, DELEGATECALL or CALLCODE
+//  - the name, symbol and decimals can be queried
+//  - a transfer of the holder balance moves exactly the transferred amount
+//    and leaves the total supply unchanged
+func (k Keeper) verifyERC20Contract(ctx sdk.Context, contract, holder common.Address) error {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return sdkerrors.Wrapf(types.ErrERC20Verification, "%s is not a contract", contract)
+	}
+
+	code := k.evmKeeper.GetCode(ctx, common.BytesToHash(acc.CodeHash))
+	if op, found := findForbiddenOpCode(code); found {
+		return sdkerrors.Wrapf(types.ErrERC20Verification, "bytecode contains the %s opcode", op)
+	}
+
+	if _, err := k.QueryERC20(ctx, contract); err != nil {
+		return sdkerrors.Wrap(types.ErrERC20Verification, err.Error())
+	}
+
+	// simulate the transfer on a cached context that is never written
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	supply, err := k.totalSupply(cacheCtx, erc20, contract)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrERC20Verification, err.Error())
+	}
+
+	balance := k.balanceOf(cacheCtx, erc20, contract, holder)
+	if balance == nil || balance.Sign() != 1 {
+		return sdkerrors.Wrapf(types.ErrERC20Verification, "deployer %s doesn't hold any token to verify the transfers", holder)
+	}
+	balanceModule := k.balanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceModule == nil {
+		return sdkerrors.Wrap(types.ErrERC20Verification, "failed to query the module balance")
+	}
+
+	transferData, err := erc20.Pack("transfer", types.ModuleAddress, balance)
+	if err != nil {
+		return err
+	}
+	if _, err := k.CallEVMWithData(cacheCtx, holder, &contract, transferData, true); err != nil {
+		return sdkerrors.Wrap(types.ErrERC20Verification, err.Error())
+	}
+
+	balanceAfter := k.balanceOf(cacheCtx, erc20, contract, holder)
+	balanceModuleAfter := k.balanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	expModule := new(big.Int).Add(balanceModule, balance)
+	if balanceAfter == nil || balanceAfter.Sign() != 0 || balanceModuleAfter == nil || balanceModuleAfter.Cmp(expModule) != 0 {
+		return sdkerrors.Wrap(types.ErrERC20Verification, "transfer doesn't move the exact amount")
+	}
+
+	supplyAfter, err := k.totalSupply(cacheCtx, erc20, contract)
+	if err != nil || supplyAfter.Cmp(supply) != 0 {
+		return sdkerrors.Wrap(types.ErrERC20Verification, "transfer changes the total supply")
+	}
+
+	return nil
+}
+
+// totalSupply queries the total supply of a given ERC20 contract
+func (k Keeper) totalSupply(ctx sdk.Context, abi abi.ABI, contract common.Address) (*big.Int, error) {
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, contract, false, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := abi.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrABIUnpack, "failed to unpack total supply")
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrABIUnpack, "invalid total supply")
+	}
+
+	return supply, nil
+}
+
+// findForbiddenOpCode scans the runtime bytecode, skipping the push data and
+// the trailing solidity metadata, for opcodes that allow a contract to change
+// its behaviour after the registration
+func findForbiddenOpCode(code []byte) (vm.OpCode, bool) {
+	// the solidity metadata is CBOR encoded and its length is appended as the
+	// last two bytes of the bytecode. It is only skipped if it decodes as the
+	// metadata emitted by solc, otherwise the whole bytecode is scanned.
+	if n := len(code); n > 2 {
+		size := int(code[n-2])<<8 | int(code[n-1])
+		if size+2 <= n && isSolcMetadata(code[n-size-2:n-2]) {
+			code = code[:n-size-2]
+		}
+	}
+
+	for i := 0; i < len(code); i++ {
+		op := vm.OpCode(code[i])
+		switch {
+		case op == vm.SELFDESTRUCT, op == vm.DELEGATECALL, op == vm.CALLCODE:
+			return op, true
+		case op >= vm.PUSH1 && op <= vm.PUSH32:
+			i += int(op - vm.PUSH1 + 1)
+		}
+	}
+
+	return 0, false
+}
+
+// solcMetadataSizes are the byte string sizes of the values of the solc
+// metadata keys, solc being either a 3 bytes release version or a prerelease
+// version text string
+var solcMetadataSizes = map[string]int{
+	"ipfs":  34,
+	"bzzr0": 32,
+	"bzzr1": 32,
+	"solc":  3,
+}
+
+// isSolcMetadata returns true if the data is exactly a CBOR map of up to 3
+// entries of the keys emitted by solc: ipfs, bzzr0, bzzr1, solc and
+// experimental
+func isSolcMetadata(data []byte) bool {
+	if len(data) == 0 || data[0] < 0xa1 || data[0] > 0xa3 {
+		return false
+	}
+
+	entries := int(data[0] - 0xa0)
+	data = data[1:]
+	for i := 0; i < entries; i++ {
+		key, rest, ok := readCBORString(data, cborTextString)
+		if !ok {
+			return false
+		}
+		data = rest
+
+		switch k := string(key); k {
+		case "experimental":
+			if len(data) == 0 || (data[0] != cborFalse && data[0] != cborTrue) {
+				return false
+			}
+			data = data[1:]
+		case "solc":
+			if _, rest, ok := readCBORString(data, cborTextString); ok {
+				data = rest
+				continue
+			}
+			fallthrough
+		case "ipfs", "bzzr0", "bzzr1":
+			value, rest, ok := readCBORString(data, cborByteString)
+			if !ok || len(value) != solcMetadataSizes[k] {
+				return false
+			}
+			data = rest
+		default:
+			return false
+		}
+	}
+
+	return len(data) == 0
+}
+
+// CBOR major types and simple values used by the solc metadata
+const (
+	cborByteString = 0x40
+	cborTextString = 0x60
+	cborFalse      = 0xf4
+	cborTrue       = 0xf5
+)
+
+// readCBORString decodes a CBOR byte or text string of at most 0xffff bytes,
+// returning its value and the remaining data
+func readCBORString(data []byte, majorType byte) ([]byte, []byte, bool) {
+	if len(data) == 0 || data[0]&0xe0 != majorType {
+		return nil, nil, false
+	}
+
+	size, header := int(data[0]&0x1f), 1
+	switch {
+	case size < 24:
+	case size == 24 && len(data) > 1:
+		size, header = int(data[1]), 2
+	case size == 25 && len(data) > 2:
+		size, header = int(data[1])<<8|int(data[2]), 3
+	default:
+		return nil, nil, false
+	}
+
+	if len(data) < header+size {
+		return nil, nil, false
+	}
+	return data[header : header+size], data[header+size:], true
 }
 
 // convertCoinNativeCoin handles the Coin conversion flow for a native coin
@@ -233,8 +609,7 @@ func (k Keeper) convertERC20NativeCoin(
 //  - Mint coins on module
 //  - Send minted coins to the receiver
 //  - Check if coin balance increased by amount
-//  - Check if token balance decreased by amount
-//  - Check for unexpected `appove` event in logs
+//  - Check the token balance changes and the side effects of the transfer
 func (k Keeper) convertERC20NativeToken(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -247,7 +622,10 @@ func (k Keeper) convertERC20NativeToken(
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
-	balanceToken := k.balanceOf(ctx, erc20, contract, types.ModuleAddress)
+	snapshot, err := k.snapshotTransfer(ctx, erc20, contract, sender, types.ModuleAddress, msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
 
 	// Escrow tokens on module account
 	transferData, err := erc20.Pack("transfer", types.ModuleAddress, msg.Amount.BigInt())
@@ -270,16 +648,10 @@ func (k Keeper) convertERC20NativeToken(
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute transfer")
 	}
 
-	// Check expected escrow balance after transfer execution
-	tokens := coins[0].Amount.BigInt()
-	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, types.ModuleAddress)
-	expToken := big.NewInt(0).Add(balanceToken, tokens)
-	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
-		return nil, sdkerrors.Wrapf(
-			types.ErrBalanceInvariance,
-			"invalid token balance - expected: %v, actual: %v",
-			expToken, balanceTokenAfter,
-		)
+	// Check expected sender and escrow balances and the side effects after
+	// transfer execution
+	if err := k.verifyTransfer(ctx, pair, erc20, snapshot, res); err != nil {
+		return nil, err
 	}
 
 	// Mint coins
@@ -303,11 +675,6 @@ func (k Keeper) convertERC20NativeToken(
 		)
 	}
 
-	// Check for unexpected `appove` event in logs
-	if err := k.monitorApprovalEvent(res); err != nil {
-		return nil, err
-	}
-
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -329,8 +696,7 @@ func (k Keeper) convertERC20NativeToken(
 //  - Escrow Coins on module account
 //  - Unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
 //  - Burn escrowed Coins
-//  - Check if token balance increased by amount
-//  - Check for unexpected `appove` event in logs
+//  - Check the token balance changes and the side effects of the transfer
 func (k Keeper) convertCoinNativeERC20(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -343,7 +709,10 @@ func (k Keeper) convertCoinNativeERC20(
 
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
-	balanceToken := k.balanceOf(ctx, erc20, contract, receiver)
+	snapshot, err := k.snapshotTransfer(ctx, erc20, contract, types.ModuleAddress, receiver, msg.Coin.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
 
 	// Escrow Coins on module account
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
@@ -366,16 +735,10 @@ func (k Keeper) convertCoinNativeERC20(
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute unescrow tokens from user")
 	}
 
-	// Check expected Receiver balance after transfer execution
-	tokens := msg.Coin.Amount.BigInt()
-	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, receiver)
-	exp := big.NewInt(0).Add(balanceToken, tokens)
-	if r := balanceTokenAfter.Cmp(exp); r != 0 {
-		return nil, sdkerrors.Wrapf(
-			types.ErrBalanceInvariance,
-			"invalid token balance - expected: %v, actual: %v",
-			exp, balanceTokenAfter,
-		)
+	// Check expected escrow and Receiver balances and the side effects after
+	// transfer execution
+	if err := k.verifyTransfer(ctx, pair, erc20, snapshot, res); err != nil {
+		return nil, err
 	}
 
 	// Burn escrowed Coins
@@ -384,11 +747,6 @@ func (k Keeper) convertCoinNativeERC20(
 		return nil, sdkerrors.Wrap(err, "failed to burn coins")
 	}
 
-	// Check for unexpected `appove` event in logs
-	if err := k.monitorApprovalEvent(res); err != nil {
-		return nil, err
-	}
-
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -428,24 +786,3 @@ func (k Keeper) balanceOf(
 
 	return balance
 }
-
-// monitorApprovalEvent returns an error if the given transactions logs include
-// an unexpected `approve` event
-func (k Keeper) monitorApprovalEvent(res *evmtypes.MsgEthereumTxResponse) error {
-	if res == nil || len(res.Logs) == 0 {
-		return nil
-	}
-
-	logApprovalSig := []byte("Approval(address,address,uint256)")
-	logApprovalSigHash := crypto.Keccak256Hash(logApprovalSig)
-
-	for _, log := range res.Logs {
-		if log.Topics[0] == logApprovalSigHash.Hex() {
-			return sdkerrors.Wrapf(
-				types.ErrUnexpectedEvent, "unexpected Approval event",
-			)
-		}
-	}
-
-	return nil
-}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/UptickNetwork/uptick/contracts"
+)
+
+// solcMetadata returns the CBOR encoded solc metadata of an ipfs hash full of
+// SELFDESTRUCT opcodes followed by its length
+func solcMetadata() []byte {
+	meta := append([]byte{0xa2, 0x64, 'i', 'p', 'f', 's', 0x58, 0x22}, common.RightPadBytes([]byte{0x12, 0x20}, 34)...)
+	for i := 10; i < len(meta); i++ {
+		meta[i] = byte(vm.SELFDESTRUCT)
+	}
+	meta = append(meta, 0x64, 's', 'o', 'l', 'c', 0x43, 0x00, 0x08, 0x0f)
+	return append(meta, byte(len(meta)>>8), byte(len(meta)))
+}
+
+func TestFindForbiddenOpCode(t *testing.T) {
+	code := []byte{byte(vm.PUSH1), 0x80, byte(vm.PUSH1), 0x40, byte(vm.MSTORE), byte(vm.STOP)}
+
+	testCases := []struct {
+		name     string
+		code     []byte
+		expFound bool
+		expOp    vm.OpCode
+	}{
+		{"empty bytecode", nil, false, 0},
+		{"plain bytecode", code, false, 0},
+		{"compiled contract", contracts.ERC20MinterBurnerDecimalsContract.Bin, false, 0},
+		{"opcode in push data", []byte{byte(vm.PUSH2), byte(vm.SELFDESTRUCT), byte(vm.DELEGATECALL), byte(vm.STOP)}, false, 0},
+		{"opcode in solc metadata", append(append([]byte{}, code...), solcMetadata()...), false, 0},
+		{
+			"opcode in solc metadata with a prerelease version",
+			append(append([]byte{}, code...), 0xa1, 0x64, 's', 'o', 'l', 'c', 0x66, '0', '.', '8', '.', '0', byte(vm.CALLCODE), 0x00, 0x0d),
+			false,
+			0,
+		},
+		{"selfdestruct", append(append([]byte{}, code...), byte(vm.SELFDESTRUCT)), true, vm.SELFDESTRUCT},
+		{"delegatecall", append([]byte{byte(vm.DELEGATECALL)}, code...), true, vm.DELEGATECALL},
+		{"solc metadata not at the end of the bytecode", append(solcMetadata(), code...), true, vm.SELFDESTRUCT},
+		{
+			"opcode hidden by a metadata length that isn't CBOR",
+			append(append([]byte{}, code...), byte(vm.SELFDESTRUCT), 0x00, 0x00, 0x03),
+			true,
+			vm.SELFDESTRUCT,
+		},
+		{
+			"opcode hidden in a CBOR map of unknown keys",
+			append(append([]byte{}, code...), 0xa1, 0x63, 'f', 'o', 'o', 0x41, byte(vm.SELFDESTRUCT), 0x00, 0x07),
+			true,
+			vm.SELFDESTRUCT,
+		},
+		{
+			"opcode hidden in a solc metadata of invalid hash size",
+			append(append([]byte{}, code...), 0xa1, 0x64, 'i', 'p', 'f', 's', 0x41, byte(vm.SELFDESTRUCT), 0x00, 0x08),
+			true,
+			vm.SELFDESTRUCT,
+		},
+		{
+			"opcode hidden after a solc metadata",
+			append(append([]byte{}, code...), 0xa1, 0x64, 's', 'o', 'l', 'c', 0x43, 0x00, 0x08, 0x0f, byte(vm.SELFDESTRUCT), 0x00, 0x0b),
+			true,
+			vm.SELFDESTRUCT,
+		},
+	}
+	for _, tc := range testCases {
+		op, found := findForbiddenOpCode(tc.code)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expOp, op, tc.name)
+	}
+}
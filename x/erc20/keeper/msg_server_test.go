@@ -5,10 +5,15 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evm "github.com/evmos/ethermint/x/evm/types"
 
 	"github.com/UptickNetwork/uptick/x/erc20/types"
 )
@@ -458,3 +463,173 @@ func (suite *KeeperTestSuite) TestConvertNativeIBC() {
 	suite.Require().NoError(err)
 	suite.Commit()
 }
+
+func (suite *KeeperTestSuite) TestRegisterERC20Pair() {
+	var (
+		contractAddr common.Address
+		nonce        uint64
+	)
+
+	deposit := sdk.NewCoins(sdk.NewCoin(evm.DefaultEVMDenom, sdk.NewInt(100)))
+
+	// deploy deploys a contract and returns the nonce of its creation
+	deploy := func(deployContract func() common.Address) {
+		nonce = suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		contractAddr = deployContract()
+	}
+	deployERC20 := func() common.Address {
+		return suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	}
+	fundDeposit := func() {
+		suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, deposit))
+		err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), deposit)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		deposit  sdk.Coins
+		expErr   error
+	}{
+		{
+			"fail - self-serve registration disabled",
+			func() {
+				deploy(deployERC20)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
+				fundDeposit()
+			},
+			nil,
+			types.ErrERC20Disabled,
+		},
+		{
+			"fail - sender is not the deployer",
+			func() {
+				deploy(deployERC20)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
+				fundDeposit()
+				nonce++
+			},
+			deposit,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"fail - no contract deployed",
+			func() {
+				nonce = suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+				contractAddr = crypto.CreateAddress(suite.address, nonce)
+				fundDeposit()
+			},
+			deposit,
+			types.ErrERC20Verification,
+		},
+		{
+			"fail - bytecode with a forbidden opcode",
+			func() {
+				// init code returning the CALLER SELFDESTRUCT runtime code
+				initCode := []byte{
+					byte(vm.PUSH1), 0x02, byte(vm.PUSH1), 0x0c, byte(vm.PUSH1), 0x00, byte(vm.CODECOPY),
+					byte(vm.PUSH1), 0x02, byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+					byte(vm.CALLER), byte(vm.SELFDESTRUCT),
+				}
+				deploy(func() common.Address {
+					_, err := suite.app.Erc20Keeper.CallEVMWithData(suite.ctx, suite.address, nil, initCode, true)
+					suite.Require().NoError(err)
+					return crypto.CreateAddress(suite.address, nonce)
+				})
+				fundDeposit()
+			},
+			deposit,
+			types.ErrERC20Verification,
+		},
+		{
+			"fail - deployer without tokens",
+			func() {
+				deploy(deployERC20)
+				fundDeposit()
+			},
+			deposit,
+			types.ErrERC20Verification,
+		},
+		{
+			"fail - transfer not moving the exact amount",
+			func() {
+				deploy(func() common.Address {
+					return suite.DeployContractDirectBalanceManipulation(erc20Name, erc20Symbol)
+				})
+				fundDeposit()
+			},
+			deposit,
+			types.ErrERC20Verification,
+		},
+		{
+			"fail - insufficient deposit",
+			func() {
+				deploy(deployERC20)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
+			},
+			deposit,
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"fail - contract already registered",
+			func() {
+				deploy(deployERC20)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
+				fundDeposit()
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+			},
+			deposit,
+			types.ErrTokenPairAlreadyExists,
+		},
+		{
+			"ok",
+			func() {
+				deploy(deployERC20)
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
+				fundDeposit()
+			},
+			deposit,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.RegistrationDeposit = tc.deposit
+			suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+			tc.malleate()
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			msg := types.NewMsgRegisterERC20(contractAddr, nonce, sender)
+			_, err := suite.app.Erc20Keeper.RegisterERC20Pair(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().True(pair.IsNativeERC20())
+
+			// the deposit is escrowed and the verification transfer reverted
+			expDeposit := types.RegistrationDeposit{Erc20Address: pair.Erc20Address, Depositor: sender.String(), Amount: deposit}
+			stored, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contractAddr)
+			suite.Require().True(found)
+			suite.Require().Equal(expDeposit, stored)
+			depositAcc := suite.app.AccountKeeper.GetModuleAddress(types.DepositModuleName)
+			suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, depositAcc))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sender, evm.DefaultEVMDenom).IsZero())
+			suite.Require().Equal(big.NewInt(10), suite.BalanceOf(contractAddr, suite.address))
+		})
+	}
+	suite.mintFeeCollector = false
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// ConvertERC20WithPermit converts the ERC20 tokens of an owner into Cosmos
+// coins on behalf of a relayer, which pays the gas. The owner signs an
+// EIP-2612 permit of the tokens to the module address and the conversion
+// terms, which bind the receiver and the relayer fee to the permit nonce:
+//  - Verify the conversion terms signature against the permit nonce
+//  - Call permit on the contract, which verifies the permit signature
+//  - Convert the tokens and restore the prior allowance of the owner to the
+//    module address, which the permit overwrote
+//  - Send the relayer fee from the converted coins of the receiver, which
+//    can't exceed the amount left after the conversion fee
+//
+// Only the ERC20 contracts implementing EIP-2612 are supported.
+func (k Keeper) ConvertERC20WithPermit(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20WithPermit,
+) (*types.MsgConvertERC20WithPermitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	relayer, _ := sdk.AccAddressFromBech32(msg.Relayer)
+	owner := common.HexToAddress(msg.Owner)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	if msg.Deadline.LT(sdk.NewInt(ctx.BlockTime().Unix())) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPermit, "permit expired at %s", msg.Deadline)
+	}
+
+	pair, err := k.MintingEnabled(ctx, owner.Bytes(), receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The inflow fee is charged on the converted coins before the relayer fee
+	inflowFee := types.ComputeConversionFee(msg.Amount, k.GetParams(ctx).ConversionFeeBps(pair, types.Inflow))
+	if msg.RelayerFee.GT(msg.Amount.Sub(inflowFee)) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins,
+			"relayer fee %s exceeds the converted amount %s after the conversion fee %s",
+			msg.RelayerFee, msg.Amount.Sub(inflowFee), inflowFee,
+		)
+	}
+
+	permit := contracts.IERC20PermitContract.ABI
+	nonce, err := k.permitNonce(ctx, contract, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := msg.ConversionHash(chainID, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(owner, hash, msg.ConversionSignature); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid conversion signature")
+	}
+
+	// The permit overwrites the allowance of the owner to the module address
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	allowance, err := k.allowance(ctx, contract, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// Consume the permit of the owner, the contract verifies its signature
+	// and increments the nonce
+	var r, s [32]byte
+	copy(r[:], msg.PermitSignature[:32])
+	copy(s[:], msg.PermitSignature[32:64])
+	v := msg.PermitSignature[64]
+	if v < 27 {
+		v += 27
+	}
+
+	if _, err := k.CallEVM(
+		ctx, permit, types.ModuleAddress, contract, true, "permit",
+		owner, types.ModuleAddress, msg.Amount.BigInt(), msg.Deadline.BigInt(), v, r, s,
+	); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPermit, err.Error())
+	}
+
+	res, err := k.ConvertERC20(goCtx, types.NewMsgConvertERC20(msg.Amount, receiver, contract, owner))
+	if err != nil {
+		return nil, err
+	}
+
+	// The conversion escrows or burns the tokens without spending the allowance
+	if _, err := k.CallEVM(ctx, erc20, owner, contract, true, "approve", types.ModuleAddress, allowance); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to restore the allowance")
+	}
+
+	if res == nil {
+		// NOTE: return nil error to persist the disabled or deleted token pair
+		return nil, nil
+	}
+
+	if msg.RelayerFee.IsPositive() {
+		fee := sdk.Coins{{Denom: pair.Denom, Amount: msg.RelayerFee}}
+		if err := k.bankKeeper.SendCoins(ctx, receiver, relayer, fee); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to pay the relayer fee %s", fee)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertWithPermit,
+				sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyRelayer, msg.Relayer),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRelayerFee, msg.RelayerFee.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		},
+	)
+
+	return &types.MsgConvertERC20WithPermitResponse{}, nil
+}
+
+// allowance returns the allowance of the owner to the module address on the
+// contract
+func (k Keeper) allowance(ctx sdk.Context, contract, owner common.Address) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, false, "allowance", owner, types.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := erc20.Unpack("allowance", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrABIUnpack, "failed to unpack allowance of %s", owner)
+	}
+
+	allowance, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrABIUnpack, "failed to unpack allowance of %s", owner)
+	}
+	return allowance, nil
+}
+
+// permitNonce returns the EIP-2612 nonce of the owner on the contract
+func (k Keeper) permitNonce(ctx sdk.Context, contract, owner common.Address) (*big.Int, error) {
+	permit := contracts.IERC20PermitContract.ABI
+	res, err := k.CallEVM(ctx, permit, types.ModuleAddress, contract, false, "nonces", owner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPermit, "contract %s doesn't support permits: %s", contract, err)
+	}
+
+	unpacked, err := permit.Unpack("nonces", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrABIUnpack, "failed to unpack nonce of %s", owner)
+	}
+
+	nonce, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrABIUnpack, "failed to unpack nonce of %s", owner)
+	}
+	return nonce, nil
+}
+
+// verifySignature checks that the 65 bytes [R || S || V] signature of the
+// hash, signed as an Ethereum signed message, is from the signer
+func verifySignature(signer common.Address, hash common.Hash, signature []byte) error {
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(hash.Bytes()), sig)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	if recovered := crypto.PubkeyToAddress(*pubKey); recovered != signer {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s doesn't match %s", recovered, signer)
+	}
+	return nil
+}
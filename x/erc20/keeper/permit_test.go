@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// deployPermitContract deploys an EIP-2612 ERC20 contract from the suite
+// address and mints the given amount to the owner without the EVM hooks
+func (suite *KeeperTestSuite) deployPermitContract(owner common.Address, amount int64) common.Address {
+	permitContract := contracts.ERC20MinterPermitContract
+	ctorArgs, err := permitContract.ABI.Pack("", "coin", "token")
+	suite.Require().NoError(err)
+	data := append(append([]byte{}, permitContract.Bin...), ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	_, err = suite.app.Erc20Keeper.CallEVMWithData(suite.ctx, suite.address, nil, data, true)
+	suite.Require().NoError(err)
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, permitContract.ABI, suite.address, contractAddr, true, "mint", owner, big.NewInt(amount))
+	suite.Require().NoError(err)
+	return contractAddr
+}
+
+// signPermit signs the EIP-2612 permit of the amount to the module address
+func (suite *KeeperTestSuite) signPermit(key *ecdsa.PrivateKey, contractAddr common.Address, amount, nonce, deadline *big.Int) []byte {
+	permitABI := contracts.ERC20MinterPermitContract.ABI
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, permitABI, types.ModuleAddress, contractAddr, false, "DOMAIN_SEPARATOR")
+	suite.Require().NoError(err)
+	domainSeparator := common.BytesToHash(res.Ret)
+
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	addressType, _ := abi.NewType("address", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	structArgs := abi.Arguments{
+		{Type: bytes32Type}, {Type: addressType}, {Type: addressType},
+		{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type},
+	}
+	typeHash := crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	bz, err := structArgs.Pack(typeHash, owner, types.ModuleAddress, amount, nonce, deadline)
+	suite.Require().NoError(err)
+
+	digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator.Bytes(), crypto.Keccak256(bz))
+	sig, err := crypto.Sign(digest, key)
+	suite.Require().NoError(err)
+	return sig
+}
+
+// signConversion signs the conversion terms of the message bound to the nonce
+func (suite *KeeperTestSuite) signConversion(key *ecdsa.PrivateKey, msg *types.MsgConvertERC20WithPermit, nonce *big.Int) []byte {
+	chainID, err := ethermint.ParseChainID(suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	hash, err := msg.ConversionHash(chainID, nonce)
+	suite.Require().NoError(err)
+
+	sig, err := crypto.Sign(accounts.TextHash(hash.Bytes()), key)
+	suite.Require().NoError(err)
+	return sig
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20WithPermit() {
+	var (
+		ownerKey   *ecdsa.PrivateKey
+		signerKey  *ecdsa.PrivateKey
+		amount     int64
+		relayerFee int64
+		deadline   *big.Int
+		permitted  *big.Int
+		allowance  int64
+	)
+
+	relayer := sdk.AccAddress(suite.address.Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		replay   bool
+		expErr   error
+	}{
+		{
+			"ok",
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"ok - prior allowance of the owner restored",
+			func() {
+				allowance = 30
+			},
+			false,
+			nil,
+		},
+		{
+			"ok - relayer fee of the amount left after the inflow fee",
+			func() {
+				relayerFee = 90
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - relayer fee exceeds the amount left after the inflow fee",
+			func() {
+				relayerFee = 91
+			},
+			false,
+			sdkerrors.ErrInvalidCoins,
+		},
+		{
+			"fail - permit expired",
+			func() {
+				deadline = big.NewInt(suite.ctx.BlockTime().Unix() - 1)
+			},
+			false,
+			types.ErrInvalidPermit,
+		},
+		{
+			"fail - conversion signed by another account",
+			func() {
+				var err error
+				signerKey, err = crypto.GenerateKey()
+				suite.Require().NoError(err)
+			},
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"fail - permit of a different amount",
+			func() {
+				permitted = big.NewInt(amount - 1)
+			},
+			false,
+			types.ErrInvalidPermit,
+		},
+		{
+			"fail - replayed conversion",
+			func() {},
+			true,
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			var err error
+			ownerKey, err = crypto.GenerateKey()
+			suite.Require().NoError(err)
+			signerKey = ownerKey
+			amount = 100
+			relayerFee = 10
+			deadline = big.NewInt(suite.ctx.BlockTime().Unix() + 3600)
+			permitted = big.NewInt(amount)
+			allowance = 0
+
+			owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+			receiver := sdk.AccAddress(owner.Bytes())
+			suite.app.AccountKeeper.SetAccount(suite.ctx, &ethermint.EthAccount{
+				BaseAccount: authtypes.NewBaseAccount(receiver, nil, 0, 0),
+				CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+			})
+
+			// charge a 10% inflow fee
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.InflowFeeBps = 1000
+			suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+			contractAddr := suite.deployPermitContract(owner, 2*amount)
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			if allowance > 0 {
+				_, err = suite.app.Erc20Keeper.CallEVM(
+					suite.ctx, contracts.ERC20MinterPermitContract.ABI, owner, contractAddr, true,
+					"approve", types.ModuleAddress, big.NewInt(allowance),
+				)
+				suite.Require().NoError(err)
+			}
+
+			msg := types.NewMsgConvertERC20WithPermit(
+				sdk.NewInt(amount), receiver, contractAddr, owner,
+				relayer, sdk.NewInt(relayerFee), sdk.NewIntFromBigInt(deadline), nil, nil,
+			)
+			msg.PermitSignature = suite.signPermit(ownerKey, contractAddr, permitted, big.NewInt(0), deadline)
+			msg.ConversionSignature = suite.signConversion(signerKey, msg, big.NewInt(0))
+			suite.Require().NoError(msg.ValidateBasic())
+
+			relayerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, relayer, pair.Denom)
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			_, err = suite.app.Erc20Keeper.ConvertERC20WithPermit(ctx, msg)
+			if tc.replay {
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.ConvertERC20WithPermit(ctx, msg)
+			}
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				if !tc.replay {
+					suite.Require().Equal(int64(2*amount), suite.BalanceOf(contractAddr, owner).(*big.Int).Int64())
+				}
+				return
+			}
+			suite.Require().NoError(err)
+
+			inflowFee := amount / 10
+			suite.Require().Equal(int64(amount), suite.BalanceOf(contractAddr, owner).(*big.Int).Int64())
+			suite.Require().Equal(amount-inflowFee-relayerFee, suite.app.BankKeeper.GetBalance(suite.ctx, receiver, pair.Denom).Amount.Int64())
+			relayerReceived := suite.app.BankKeeper.GetBalance(suite.ctx, relayer, pair.Denom).Sub(relayerBalance)
+			suite.Require().Equal(relayerFee, relayerReceived.Amount.Int64())
+
+			// the allowance overwritten by the permit is restored
+			res, err := suite.app.Erc20Keeper.CallEVM(
+				suite.ctx, contracts.ERC20MinterPermitContract.ABI, types.ModuleAddress, contractAddr, false,
+				"allowance", owner, types.ModuleAddress,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(allowance, new(big.Int).SetBytes(res.Ret).Int64())
+		})
+	}
+}
@@ -72,7 +72,11 @@ func (k Keeper) DeployERC20Contract(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
 ) (common.Address, error) {
-	decimals := uint8(coinMetadata.DenomUnits[0].Exponent)
+	decimals, err := types.ERC20Decimals(coinMetadata)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
+	}
+
 	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
 		"",
 		coinMetadata.Name,
@@ -198,6 +202,11 @@ func (k Keeper) ToggleRelay(ctx sdk.Context, token string) (types.TokenPair, err
 
 	pair.Enabled = !pair.Enabled
 
+	// restart the rate limit window of a re-enabled pair
+	if pair.Enabled {
+		k.DeleteRateLimitFlow(ctx, id)
+	}
+
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
@@ -272,17 +281,102 @@ func (k Keeper) UpdateTokenPairERC20(ctx sdk.Context, erc20Addr, newERC20Addr co
 	// Update the metadata description with the new address
 	metadata.Description = types.CreateDenomDescription(newERC20Addr.String())
 	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return k.replaceTokenPairERC20(ctx, pair, newERC20Addr), nil
+}
+
+// replaceTokenPairERC20 updates the ERC20 contract of a token pair along with
+// the id and the mappings of the pair
+func (k Keeper) replaceTokenPairERC20(ctx sdk.Context, pair types.TokenPair, newERC20Addr common.Address) types.TokenPair {
+	erc20Addr := pair.GetERC20Contract()
+	flow, hasFlow := k.GetRateLimitFlow(ctx, pair.GetID())
 	// Delete old token pair (id is changed because the ERC20 address was modifed)
 	k.DeleteTokenPair(ctx, pair)
 	// Update the address
 	pair.Erc20Address = newERC20Addr.Hex()
 	// Set the new pair
 	k.SetTokenPair(ctx, pair)
+	// Keep the amounts converted in the current window of the rate limit
+	if hasFlow {
+		k.SetRateLimitFlow(ctx, pair.GetID(), flow)
+	}
 	// Overwrite the value because id was changed
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	// Remove old address
 	k.DeleteERC20Map(ctx, erc20Addr)
 	// Add the new address
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+	return pair
+}
+
+// UpdateTokenPairMetadata updates the metadata of a registered coin, so that
+// the ERC20 details never disagree with it:
+//  - native coins: the details of the ERC20 contract deployed by the module
+//    are updated with the name, symbol and decimals of the metadata. The
+//    contracts deployed before the metadata setter was added are replaced by a
+//    new contract if they don't have any supply, the update fails otherwise
+//  - native ERC20 tokens: the symbol and decimals of the metadata must match
+//    the details of the ERC20 contract, which aren't controlled by the module
+func (k Keeper) UpdateTokenPairMetadata(ctx sdk.Context, coinMetadata banktypes.Metadata) (types.TokenPair, error) {
+	id := k.GetDenomMap(ctx, coinMetadata.Base)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "coin '%s' not registered by id", coinMetadata.Base)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "coin '%s' not registered", coinMetadata.Base)
+	}
+
+	decimals, err := types.ERC20Decimals(coinMetadata)
+	if err != nil {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin metadata is invalid %s: %s", coinMetadata.Base, err.Error())
+	}
+
+	contract := pair.GetERC20Contract()
+
+	switch {
+	case pair.IsNativeCoin():
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		if _, err := k.CallEVM(
+			ctx, erc20, types.ModuleAddress, contract, true,
+			"setMetadata", coinMetadata.Name, coinMetadata.Symbol, decimals,
+		); err != nil {
+			// the contracts deployed before the metadata setter was added can
+			// only be replaced while no coins are converted
+			supply, supplyErr := k.totalSupply(ctx, erc20, contract)
+			if supplyErr != nil || supply.Sign() != 0 {
+				return types.TokenPair{}, sdkerrors.Wrapf(
+					err, "failed to update the details of ERC20 %s, legacy contracts can't be updated with an outstanding supply",
+					pair.Erc20Address,
+				)
+			}
+
+			newERC20Addr, err := k.DeployERC20Contract(ctx, coinMetadata)
+			if err != nil {
+				return types.TokenPair{}, sdkerrors.Wrapf(err, "failed to replace ERC20 %s", pair.Erc20Address)
+			}
+			pair = k.replaceTokenPairERC20(ctx, pair, newERC20Addr)
+
+			// Update the metadata description with the new address
+			coinMetadata.Description = types.CreateDenomDescription(newERC20Addr.String())
+		}
+	case pair.IsNativeERC20():
+		erc20Data, err := k.QueryERC20(ctx, contract)
+		if err != nil {
+			return types.TokenPair{}, err
+		}
+
+		if coinMetadata.Symbol != erc20Data.Symbol || decimals != erc20Data.Decimals {
+			return types.TokenPair{}, sdkerrors.Wrapf(
+				types.ErrInternalTokenPair,
+				"metadata details (symbol, decimals) don't match the ERC20 details from %s, expected (%s, %d), got (%s, %d)",
+				pair.Erc20Address, erc20Data.Symbol, erc20Data.Decimals, coinMetadata.Symbol, decimals,
+			)
+		}
+	default:
+		return types.TokenPair{}, types.ErrUndefinedOwner
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, coinMetadata)
 	return pair, nil
 }
@@ -528,3 +528,184 @@ func (suite KeeperTestSuite) TestUpdateTokenPairERC20() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadata() {
+	var (
+		metadata banktypes.Metadata
+		legacy   common.Address
+	)
+	flow := types.NewRateLimitFlow(1).Add(types.Outflow, sdk.NewInt(10))
+
+	// setupLegacyCoin registers a coin paired with an ERC20 contract without
+	// the metadata setter, which has the given supply and converted amounts in
+	// the current window of its rate limit
+	setupLegacyCoin := func(supply int64) {
+		var pair *types.TokenPair
+		metadata, pair = suite.setupRegisterCoin()
+		legacy = suite.deployPermitContract(suite.address, supply)
+
+		suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, *pair)
+		suite.app.Erc20Keeper.DeleteERC20Map(suite.ctx, pair.GetERC20Contract())
+		pair.Erc20Address = legacy.Hex()
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, legacy, pair.GetID())
+		suite.app.Erc20Keeper.SetRateLimitFlow(suite.ctx, pair.GetID(), flow)
+
+		metadata.Name = "updated"
+		metadata.Symbol = "UPDATED"
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"coin not registered",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				metadata.Base = "unregistered"
+			},
+			false,
+		},
+		{
+			"native coin - update ERC20 details",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				metadata.Name = "updated"
+				metadata.Symbol = "UPDATED"
+				metadata.Display = cosmosTokenBase[1:]
+			},
+			true,
+		},
+		{
+			"legacy native coin - contract replaced without supply",
+			func() {
+				setupLegacyCoin(0)
+			},
+			true,
+		},
+		{
+			"legacy native coin - contract with supply can't be updated",
+			func() {
+				setupLegacyCoin(100)
+			},
+			false,
+		},
+		{
+			"native ERC20 - metadata doesn't match the ERC20 details",
+			func() {
+				contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+				metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contractAddr.String()))
+				metadata.Symbol = "UPDATED"
+			},
+			false,
+		},
+		{
+			"native ERC20 - metadata matches the ERC20 details",
+			func() {
+				contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+				metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contractAddr.String()))
+				metadata.Description = "updated"
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			legacy = common.Address{}
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(suite.ctx, metadata)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+
+				if legacy != (common.Address{}) {
+					suite.Require().NotEqual(legacy, pair.GetERC20Contract())
+					suite.Require().Empty(suite.app.Erc20Keeper.GetERC20Map(suite.ctx, legacy))
+					suite.Require().Equal(pair.GetID(), suite.app.Erc20Keeper.GetERC20Map(suite.ctx, pair.GetERC20Contract()))
+					suite.Require().Equal(pair.GetID(), suite.app.Erc20Keeper.GetDenomMap(suite.ctx, pair.Denom))
+
+					// the rate limit window is kept and the description
+					// refers to the new contract
+					stored, found := suite.app.Erc20Keeper.GetRateLimitFlow(suite.ctx, pair.GetID())
+					suite.Require().True(found)
+					suite.Require().Equal(flow, stored)
+					metadata.Description = types.CreateDenomDescription(pair.Erc20Address)
+				}
+
+				stored, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, metadata.Base)
+				suite.Require().True(found)
+				suite.Require().Equal(metadata, stored)
+
+				erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract())
+				suite.Require().NoError(err)
+				decimals, err := types.ERC20Decimals(metadata)
+				suite.Require().NoError(err)
+				suite.Require().Equal(metadata.Symbol, erc20Data.Symbol)
+				suite.Require().Equal(decimals, erc20Data.Decimals)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairAllowedBehaviours() {
+	var token string
+	behaviours := []types.ConversionBehaviour{types.BEHAVIOUR_ADDITIONAL_TRANSFERS}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"token not registered",
+			func() {
+				token = tests.GenerateAddress().String()
+			},
+			false,
+		},
+		{
+			"native coin - not verified",
+			func() {
+				_, pair := suite.setupRegisterCoin()
+				token = pair.Denom
+			},
+			false,
+		},
+		{
+			"native ERC20 - ok",
+			func() {
+				contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+				token = contractAddr.String()
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.UpdateTokenPairAllowedBehaviours(suite.ctx, token, behaviours)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(behaviours, pair.AllowedBehaviours)
+				suite.Require().True(pair.IsBehaviourAllowed(types.BEHAVIOUR_ADDITIONAL_TRANSFERS))
+
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, token)
+				stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+				suite.Require().True(found)
+				suite.Require().Equal(pair, stored)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
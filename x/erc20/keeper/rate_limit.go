@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+// GetRateLimitFlow returns the amounts converted in the current window of the
+// given token pair
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, id []byte) (types.RateLimitFlow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimitFlow)
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.RateLimitFlow{}, false
+	}
+
+	var flow types.RateLimitFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetRateLimitFlow stores the amounts converted in the current window of the
+// given token pair
+func (k Keeper) SetRateLimitFlow(ctx sdk.Context, id []byte, flow types.RateLimitFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimitFlow)
+	bz := k.cdc.MustMarshal(&flow)
+	store.Set(id, bz)
+}
+
+// DeleteRateLimitFlow removes the amounts converted in the current window of
+// the given token pair
+func (k Keeper) DeleteRateLimitFlow(ctx sdk.Context, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimitFlow)
+	store.Delete(id)
+}
+
+// ConsumeRateLimit adds the converted amount to the flow of the current window
+// of the token pair and returns false if the conversion exceeds the quota of
+// the direction. The token pair is disabled once the cumulative flow of the
+// window reaches the quota, or as soon as a conversion exceeds it. A rejected
+// conversion must not fail its msg, so that the disabled pair is persisted.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, pair types.TokenPair, direction types.ConversionDirection, amount sdk.Int) bool {
+	if !pair.HasRateLimit() {
+		return true
+	}
+
+	quota := pair.RateLimit.Quota(direction)
+	if !quota.IsPositive() {
+		return true
+	}
+
+	id := pair.GetID()
+	height := ctx.BlockHeight()
+
+	// start a new window once the current one has elapsed
+	flow, found := k.GetRateLimitFlow(ctx, id)
+	if !found || height >= flow.WindowStart+int64(pair.RateLimit.Window) {
+		flow = types.NewRateLimitFlow(height)
+	}
+
+	// trip the circuit breaker once the quota is used up or exceeded
+	flow = flow.Add(direction, amount)
+	if flow.Amount(direction).GTE(quota) {
+		k.DisableTokenPair(ctx, pair, direction)
+		return flow.Amount(direction).Equal(quota)
+	}
+
+	k.SetRateLimitFlow(ctx, id, flow)
+	return true
+}
+
+// DisableTokenPair disables the conversions of a token pair that used up or
+// exceeded its rate limit. The pair needs to be re-enabled by governance.
+func (k Keeper) DisableTokenPair(ctx sdk.Context, pair types.TokenPair, direction types.ConversionDirection) {
+	pair.Enabled = false
+	k.SetTokenPair(ctx, pair)
+	k.DeleteRateLimitFlow(ctx, pair.GetID())
+
+	k.Logger(ctx).Info(
+		"disabling token pair that used up or exceeded its rate limit",
+		"contract", pair.Erc20Address,
+		"denom", pair.Denom,
+		"direction", direction.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitExceeded,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+			sdk.NewAttribute(types.AttributeKeyQuota, pair.RateLimit.Quota(direction).String()),
+		),
+	)
+}
+
+// UpdateTokenPairRateLimit updates the conversion quotas of a token pair and
+// starts a new window
+func (k Keeper) UpdateTokenPairRateLimit(ctx sdk.Context, token string, rateLimit types.RateLimit) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered by id", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	if rateLimit.IsEnabled() {
+		pair.RateLimit = &rateLimit
+	} else {
+		pair.RateLimit = nil
+	}
+
+	k.SetTokenPair(ctx, pair)
+	k.DeleteRateLimitFlow(ctx, id)
+	return pair, nil
+}
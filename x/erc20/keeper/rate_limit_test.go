@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestConsumeRateLimit() {
+	var pair types.TokenPair
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		amount      sdk.Int
+		expPass     bool
+		expDisabled bool
+	}{
+		{
+			"ok - no rate limit",
+			func() {},
+			sdk.NewInt(1000),
+			true,
+			false,
+		},
+		{
+			"ok - within quota",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 10)
+				pair.RateLimit = &rateLimit
+			},
+			sdk.NewInt(90),
+			true,
+			false,
+		},
+		{
+			"ok - single conversion uses up the quota",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 10)
+				pair.RateLimit = &rateLimit
+			},
+			sdk.NewInt(100),
+			true,
+			true,
+		},
+		{
+			"ok - cumulative flow uses up the quota",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 10)
+				pair.RateLimit = &rateLimit
+				flow := types.NewRateLimitFlow(suite.ctx.BlockHeight()).Add(types.Inflow, sdk.NewInt(60))
+				suite.app.Erc20Keeper.SetRateLimitFlow(suite.ctx, pair.GetID(), flow)
+			},
+			sdk.NewInt(40),
+			true,
+			true,
+		},
+		{
+			"ok - direction not limited",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.ZeroInt(), sdk.NewInt(10), 10)
+				pair.RateLimit = &rateLimit
+			},
+			sdk.NewInt(100),
+			true,
+			false,
+		},
+		{
+			"ok - previous window elapsed",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 10)
+				pair.RateLimit = &rateLimit
+				flow := types.NewRateLimitFlow(suite.ctx.BlockHeight()-10).Add(types.Inflow, sdk.NewInt(100))
+				suite.app.Erc20Keeper.SetRateLimitFlow(suite.ctx, pair.GetID(), flow)
+			},
+			sdk.NewInt(90),
+			true,
+			false,
+		},
+		{
+			"fail - quota exceeded",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 10)
+				pair.RateLimit = &rateLimit
+				flow := types.NewRateLimitFlow(suite.ctx.BlockHeight()).Add(types.Inflow, sdk.NewInt(60))
+				suite.app.Erc20Keeper.SetRateLimitFlow(suite.ctx, pair.GetID(), flow)
+			},
+			sdk.NewInt(50),
+			false,
+			true,
+		},
+		{
+			"fail - single conversion exceeds the quota",
+			func() {
+				rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 10)
+				pair.RateLimit = &rateLimit
+			},
+			sdk.NewInt(101),
+			false,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pair = types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+			tc.malleate()
+			suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+
+			allowed := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, types.Inflow, tc.amount)
+			suite.Require().Equal(tc.expPass, allowed)
+
+			stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+			suite.Require().True(found)
+			suite.Require().Equal(!tc.expDisabled, stored.Enabled)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDisableTokenPair() {
+	suite.SetupTest()
+
+	rateLimit := types.NewRateLimit(sdk.NewInt(100), sdk.NewInt(100), 10)
+	pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+	pair.RateLimit = &rateLimit
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetRateLimitFlow(suite.ctx, pair.GetID(), types.NewRateLimitFlow(suite.ctx.BlockHeight()))
+
+	suite.app.Erc20Keeper.DisableTokenPair(suite.ctx, pair, types.Outflow)
+
+	disabled, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+	suite.Require().True(found)
+	suite.Require().False(disabled.Enabled)
+	_, found = suite.app.Erc20Keeper.GetRateLimitFlow(suite.ctx, pair.GetID())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConvertCoinRateLimit() {
+	testCases := []struct {
+		name        string
+		conversions []int64
+		expPass     bool
+		expErr      error
+		expDisabled bool
+	}{
+		{"ok - within quota", []int64{4, 5}, true, nil, false},
+		{"ok - cumulative flow uses up the quota", []int64{6, 4}, true, nil, true},
+		{"fail - single conversion exceeds the quota", []int64{11}, false, nil, true},
+		{"fail - cumulative flow exceeds the quota", []int64{6, 5}, false, nil, true},
+		{"fail - pair disabled once the quota is used up", []int64{10, 1}, false, types.ErrERC20Disabled, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			_, pair := suite.setupRegisterCoin()
+			_, err := suite.app.Erc20Keeper.UpdateTokenPairRateLimit(
+				suite.ctx, pair.Denom, types.NewRateLimit(sdk.ZeroInt(), sdk.NewInt(10), 100),
+			)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+			var (
+				res       *types.MsgConvertCoinResponse
+				converted int64
+			)
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			for i, amount := range tc.conversions {
+				msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, amount), suite.address, sender)
+				res, err = suite.app.Erc20Keeper.ConvertCoin(ctx, msg)
+				if i < len(tc.conversions)-1 {
+					suite.Require().NoError(err)
+					suite.Require().NotNil(res)
+					converted += amount
+				}
+			}
+
+			switch {
+			case tc.expPass:
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				converted += tc.conversions[len(tc.conversions)-1]
+			case tc.expErr != nil:
+				suite.Require().ErrorIs(err, tc.expErr)
+			default:
+				// the conversion is rejected without failing, so that the
+				// disabled pair is persisted
+				suite.Require().NoError(err)
+				suite.Require().Nil(res)
+			}
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+			suite.Require().Equal(100-converted, balance.Amount.Int64())
+
+			stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+			suite.Require().True(found)
+			suite.Require().Equal(!tc.expDisabled, stored.Enabled)
+		})
+	}
+	suite.mintFeeCollector = false
+}
@@ -66,6 +66,7 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
 	key := tokenPair.GetID()
 	store.Delete(key)
+	k.DeleteRateLimitFlow(ctx, key)
 }
 
 // GetERC20Map returns the token pair id for the given address
@@ -23,6 +23,16 @@ func NewErc20ProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 			return handleToggleRelayProposal(ctx, k, c)
 		case *types.UpdateTokenPairERC20Proposal:
 			return handleUpdateTokenPairERC20Proposal(ctx, k, c)
+		case *types.ResolveRegistrationDepositProposal:
+			return handleResolveRegistrationDepositProposal(ctx, k, c)
+		case *types.UpdateTokenPairRateLimitProposal:
+			return handleUpdateTokenPairRateLimitProposal(ctx, k, c)
+		case *types.UpdateTokenPairConversionFeeProposal:
+			return handleUpdateTokenPairConversionFeeProposal(ctx, k, c)
+		case *types.UpdateTokenPairMetadataProposal:
+			return handleUpdateTokenPairMetadataProposal(ctx, k, c)
+		case *types.UpdateTokenPairAllowedBehavioursProposal:
+			return handleUpdateTokenPairAllowedBehavioursProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -95,3 +105,94 @@ func handleUpdateTokenPairERC20Proposal(ctx sdk.Context, k *keeper.Keeper, p *ty
 
 	return nil
 }
+
+func handleResolveRegistrationDepositProposal(ctx sdk.Context, k *keeper.Keeper, p *types.ResolveRegistrationDepositProposal) error {
+	deposit, err := k.ResolveRegistrationDeposit(ctx, p.GetERC20Address(), p.Slash)
+	if err != nil {
+		return err
+	}
+
+	eventType := types.EventTypeRefundDeposit
+	if p.Slash {
+		eventType = types.EventTypeSlashDeposit
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateTokenPairRateLimitProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTokenPairRateLimitProposal) error {
+	pair, err := k.UpdateTokenPairRateLimit(ctx, p.Token, p.RateLimit)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRateLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateTokenPairConversionFeeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTokenPairConversionFeeProposal) error {
+	pair, err := k.UpdateTokenPairConversionFee(ctx, p.Token, p.ConversionFee)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateConversionFee,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateTokenPairMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTokenPairMetadataProposal) error {
+	pair, err := k.UpdateTokenPairMetadata(ctx, p.Metadata)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateTokenPairAllowedBehavioursProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTokenPairAllowedBehavioursProposal) error {
+	pair, err := k.UpdateTokenPairAllowedBehaviours(ctx, p.Token, p.AllowedBehaviours)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateBehaviours,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
@@ -22,6 +22,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
+		&MsgRegisterERC20{},
+		&MsgTransferERC20{},
+		&MsgSetAutoConvert{},
+		&MsgRetryIBCConversion{},
+		&MsgConvertERC20WithPermit{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -29,6 +34,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&RegisterERC20Proposal{},
 		&ToggleTokenRelayProposal{},
 		&UpdateTokenPairERC20Proposal{},
+		&ResolveRegistrationDepositProposal{},
+		&UpdateTokenPairRateLimitProposal{},
+		&UpdateTokenPairConversionFeeProposal{},
+		&UpdateTokenPairMetadataProposal{},
+		&UpdateTokenPairAllowedBehavioursProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ValidateBehaviours performs a stateless validation of the allowed behaviours
+// of a token pair
+func ValidateBehaviours(behaviours []ConversionBehaviour) error {
+	seen := make(map[ConversionBehaviour]bool)
+	for _, behaviour := range behaviours {
+		if _, ok := ConversionBehaviour_name[int32(behaviour)]; !ok || behaviour == BEHAVIOUR_UNSPECIFIED {
+			return fmt.Errorf("invalid conversion behaviour %d", behaviour)
+		}
+		if seen[behaviour] {
+			return fmt.Errorf("duplicated conversion behaviour %s", behaviour)
+		}
+		seen[behaviour] = true
+	}
+	return nil
+}
+
+// IsBehaviourAllowed returns true if the side effect of the contract is
+// accepted on the conversions of the token pair
+func (tp TokenPair) IsBehaviourAllowed(behaviour ConversionBehaviour) bool {
+	for _, b := range tp.AllowedBehaviours {
+		if b == behaviour {
+			return true
+		}
+	}
+	return false
+}
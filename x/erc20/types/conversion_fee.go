@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxFeeBps is the fee rate in basis points charging the whole converted amount
+const MaxFeeBps = 10000
+
+// NewConversionFee returns an instance of ConversionFee
+func NewConversionFee(inflowFeeBps, outflowFeeBps uint32) ConversionFee {
+	return ConversionFee{
+		InflowFeeBps:  inflowFeeBps,
+		OutflowFeeBps: outflowFeeBps,
+	}
+}
+
+// Validate performs a stateless validation of a ConversionFee
+func (cf ConversionFee) Validate() error {
+	if cf.InflowFeeBps > MaxFeeBps {
+		return fmt.Errorf("inflow fee rate cannot be greater than %d basis points: %d", MaxFeeBps, cf.InflowFeeBps)
+	}
+	if cf.OutflowFeeBps > MaxFeeBps {
+		return fmt.Errorf("outflow fee rate cannot be greater than %d basis points: %d", MaxFeeBps, cf.OutflowFeeBps)
+	}
+	return nil
+}
+
+// FeeBps returns the fee rate of the given direction
+func (cf ConversionFee) FeeBps(direction ConversionDirection) uint32 {
+	if direction == Inflow {
+		return cf.InflowFeeBps
+	}
+	return cf.OutflowFeeBps
+}
+
+// ComputeConversionFee returns the fee charged on the conversion of the given
+// amount, rounded down
+func ComputeConversionFee(amount sdk.Int, feeBps uint32) sdk.Int {
+	return amount.MulRaw(int64(feeBps)).QuoRaw(MaxFeeBps)
+}
+
+// ConversionFeeBps returns the fee rate in basis points of the given direction,
+// using the override of the token pair if set
+func (p Params) ConversionFeeBps(pair TokenPair, direction ConversionDirection) uint32 {
+	if pair.ConversionFee != nil {
+		return pair.ConversionFee.FeeBps(direction)
+	}
+	return NewConversionFee(p.InflowFeeBps, p.OutflowFeeBps).FeeBps(direction)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConversionFeeTestSuite struct {
+	suite.Suite
+}
+
+func TestConversionFeeSuite(t *testing.T) {
+	suite.Run(t, new(ConversionFeeTestSuite))
+}
+
+func (suite *ConversionFeeTestSuite) TestConversionFeeValidate() {
+	suite.Require().NoError(NewConversionFee(0, 0).Validate())
+	suite.Require().NoError(NewConversionFee(MaxFeeBps, 30).Validate())
+	suite.Require().Error(NewConversionFee(MaxFeeBps+1, 0).Validate())
+	suite.Require().Error(NewConversionFee(0, MaxFeeBps+1).Validate())
+}
+
+func (suite *ConversionFeeTestSuite) TestComputeConversionFee() {
+	testCases := []struct {
+		msg    string
+		amount sdk.Int
+		feeBps uint32
+		expFee sdk.Int
+	}{
+		{msg: "no fee", amount: sdk.NewInt(1000), feeBps: 0, expFee: sdk.ZeroInt()},
+		{msg: "1%", amount: sdk.NewInt(1000), feeBps: 100, expFee: sdk.NewInt(10)},
+		{msg: "rounded down", amount: sdk.NewInt(99), feeBps: 100, expFee: sdk.ZeroInt()},
+		{msg: "whole amount", amount: sdk.NewInt(1000), feeBps: MaxFeeBps, expFee: sdk.NewInt(1000)},
+	}
+
+	for i, tc := range testCases {
+		fee := ComputeConversionFee(tc.amount, tc.feeBps)
+		suite.Require().True(tc.expFee.Equal(fee), "test %d failed: %s, expected %s, got %s", i, tc.msg, tc.expFee, fee)
+	}
+}
+
+func (suite *ConversionFeeTestSuite) TestConversionFeeBps() {
+	params := NewParams(true, true, sdk.Coins{}, 30, 10, "fee_collector", nil)
+	pair := NewTokenPair(tests.GenerateAddress(), "test", true, OWNER_MODULE)
+
+	suite.Require().Equal(uint32(30), params.ConversionFeeBps(pair, Inflow))
+	suite.Require().Equal(uint32(10), params.ConversionFeeBps(pair, Outflow))
+
+	conversionFee := NewConversionFee(0, 50)
+	pair.ConversionFee = &conversionFee
+	suite.Require().Equal(uint32(0), params.ConversionFeeBps(pair, Inflow))
+	suite.Require().Equal(uint32(50), params.ConversionFeeBps(pair, Outflow))
+}
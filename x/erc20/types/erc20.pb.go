@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -56,6 +58,42 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_48d9cadaf7f73dba, []int{0}
 }
 
+// ConversionBehaviour enumerates the side effects of the contract of a native
+// ERC20 token pair that are accepted on its conversions. The Approval events
+// of the allowances of the sender are always accepted.
+type ConversionBehaviour int32
+
+const (
+	// BEHAVIOUR_UNSPECIFIED defines an invalid/undefined behaviour.
+	BEHAVIOUR_UNSPECIFIED ConversionBehaviour = 0
+	// BEHAVIOUR_ADDITIONAL_TRANSFERS accepts the Transfer events between other
+	// accounts than the ones of the conversion, e.g. transfer taxes.
+	BEHAVIOUR_ADDITIONAL_TRANSFERS ConversionBehaviour = 2
+	// BEHAVIOUR_SUPPLY_CHANGES accepts the changes of the total supply, e.g.
+	// rebasing tokens.
+	BEHAVIOUR_SUPPLY_CHANGES ConversionBehaviour = 3
+)
+
+var ConversionBehaviour_name = map[int32]string{
+	0: "BEHAVIOUR_UNSPECIFIED",
+	2: "BEHAVIOUR_ADDITIONAL_TRANSFERS",
+	3: "BEHAVIOUR_SUPPLY_CHANGES",
+}
+
+var ConversionBehaviour_value = map[string]int32{
+	"BEHAVIOUR_UNSPECIFIED":          0,
+	"BEHAVIOUR_ADDITIONAL_TRANSFERS": 2,
+	"BEHAVIOUR_SUPPLY_CHANGES":       3,
+}
+
+func (x ConversionBehaviour) String() string {
+	return proto.EnumName(ConversionBehaviour_name, int32(x))
+}
+
+func (ConversionBehaviour) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{1}
+}
+
 // TokenPair defines an instance that records pairing consisting of a Cosmos
 // native Coin and an ERC20 token address.
 type TokenPair struct {
@@ -67,6 +105,13 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// ERC20 owner address ENUM (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=uptick.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// optional conversion quotas of the token pair
+	RateLimit *RateLimit `protobuf:"bytes,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// optional conversion fees of the token pair, overriding the module params
+	ConversionFee *ConversionFee `protobuf:"bytes,6,opt,name=conversion_fee,json=conversionFee,proto3" json:"conversion_fee,omitempty"`
+	// side effects of the contract accepted on the conversions of a native ERC20
+	// token pair
+	AllowedBehaviours []ConversionBehaviour `protobuf:"varint,7,rep,packed,name=allowed_behaviours,json=allowedBehaviours,proto3,enum=uptick.erc20.v1.ConversionBehaviour" json:"allowed_behaviours,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +175,234 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetRateLimit() *RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *TokenPair) GetConversionFee() *ConversionFee {
+	if m != nil {
+		return m.ConversionFee
+	}
+	return nil
+}
+
+func (m *TokenPair) GetAllowedBehaviours() []ConversionBehaviour {
+	if m != nil {
+		return m.AllowedBehaviours
+	}
+	return nil
+}
+
+// ConversionFee defines the fee rates in basis points charged on the
+// conversions of a token pair.
+type ConversionFee struct {
+	// fee rate charged on the conversions of ERC20 tokens to Cosmos coins
+	InflowFeeBps uint32 `protobuf:"varint,1,opt,name=inflow_fee_bps,json=inflowFeeBps,proto3" json:"inflow_fee_bps,omitempty"`
+	// fee rate charged on the conversions of Cosmos coins to ERC20 tokens
+	OutflowFeeBps uint32 `protobuf:"varint,2,opt,name=outflow_fee_bps,json=outflowFeeBps,proto3" json:"outflow_fee_bps,omitempty"`
+}
+
+func (m *ConversionFee) Reset()         { *m = ConversionFee{} }
+func (m *ConversionFee) String() string { return proto.CompactTextString(m) }
+func (*ConversionFee) ProtoMessage()    {}
+func (*ConversionFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{1}
+}
+func (m *ConversionFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionFee.Merge(m, src)
+}
+func (m *ConversionFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionFee proto.InternalMessageInfo
+
+func (m *ConversionFee) GetInflowFeeBps() uint32 {
+	if m != nil {
+		return m.InflowFeeBps
+	}
+	return 0
+}
+
+func (m *ConversionFee) GetOutflowFeeBps() uint32 {
+	if m != nil {
+		return m.OutflowFeeBps
+	}
+	return 0
+}
+
+// RateLimit defines the maximum amounts of a token pair that can be converted
+// in each direction within a window of blocks. The token pair is disabled once
+// the converted amount of the window reaches a quota, or as soon as a conversion
+// exceeds it, in which case the conversion is rejected. A zero quota doesn't
+// limit its direction.
+type RateLimit struct {
+	// maximum amount of ERC20 tokens converted to Cosmos coins per window
+	InflowQuota github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=inflow_quota,json=inflowQuota,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow_quota"`
+	// maximum amount of Cosmos coins converted to ERC20 tokens per window
+	OutflowQuota github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow_quota,json=outflowQuota,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow_quota"`
+	// number of blocks of the window
+	Window uint64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{2}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// RateLimitFlow defines the amounts of a token pair converted in each direction
+// since the start of the current window.
+type RateLimitFlow struct {
+	// block height at which the window started
+	WindowStart int64 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// amount of ERC20 tokens converted to Cosmos coins
+	Inflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	// amount of Cosmos coins converted to ERC20 tokens
+	Outflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{3}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+// PairSupply defines the supplies of both sides of a token pair and the
+// amounts escrowed by the module.
+type PairSupply struct {
+	// total supply of the ERC20 token
+	Erc20Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=erc20_supply,json=erc20Supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_supply"`
+	// ERC20 tokens held by the module address
+	Erc20Escrowed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=erc20_escrowed,json=erc20Escrowed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_escrowed"`
+	// total bank supply of the Cosmos coin
+	CoinSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=coin_supply,json=coinSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_supply"`
+	// Cosmos coins held by the module account
+	CoinEscrowed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=coin_escrowed,json=coinEscrowed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_escrowed"`
+}
+
+func (m *PairSupply) Reset()         { *m = PairSupply{} }
+func (m *PairSupply) String() string { return proto.CompactTextString(m) }
+func (*PairSupply) ProtoMessage()    {}
+func (*PairSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{4}
+}
+func (m *PairSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairSupply.Merge(m, src)
+}
+func (m *PairSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairSupply proto.InternalMessageInfo
+
 // RegisterCoinProposal is a gov Content type to register a token pair
 type RegisterCoinProposal struct {
 	// title of the proposal
@@ -144,7 +417,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48d9cadaf7f73dba, []int{1}
+	return fileDescriptor_48d9cadaf7f73dba, []int{5}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +481,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48d9cadaf7f73dba, []int{2}
+	return fileDescriptor_48d9cadaf7f73dba, []int{6}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +547,7 @@ func (m *ToggleTokenRelayProposal) Reset()         { *m = ToggleTokenRelayPropos
 func (m *ToggleTokenRelayProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenRelayProposal) ProtoMessage()    {}
 func (*ToggleTokenRelayProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48d9cadaf7f73dba, []int{3}
+	return fileDescriptor_48d9cadaf7f73dba, []int{7}
 }
 func (m *ToggleTokenRelayProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +614,7 @@ func (m *UpdateTokenPairERC20Proposal) Reset()         { *m = UpdateTokenPairERC
 func (m *UpdateTokenPairERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*UpdateTokenPairERC20Proposal) ProtoMessage()    {}
 func (*UpdateTokenPairERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48d9cadaf7f73dba, []int{4}
+	return fileDescriptor_48d9cadaf7f73dba, []int{8}
 }
 func (m *UpdateTokenPairERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,516 +671,3935 @@ func (m *UpdateTokenPairERC20Proposal) GetNewErc20Address() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("uptick.erc20.v1.Owner", Owner_name, Owner_value)
-	proto.RegisterType((*TokenPair)(nil), "uptick.erc20.v1.TokenPair")
-	proto.RegisterType((*RegisterCoinProposal)(nil), "uptick.erc20.v1.RegisterCoinProposal")
-	proto.RegisterType((*RegisterERC20Proposal)(nil), "uptick.erc20.v1.RegisterERC20Proposal")
-	proto.RegisterType((*ToggleTokenRelayProposal)(nil), "uptick.erc20.v1.ToggleTokenRelayProposal")
-	proto.RegisterType((*UpdateTokenPairERC20Proposal)(nil), "uptick.erc20.v1.UpdateTokenPairERC20Proposal")
+// RegistrationDeposit defines the deposit locked by the deployer of an ERC20
+// contract to register its token pair with MsgRegisterERC20.
+type RegistrationDeposit struct {
+	// address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// bech32 address of the account that locked the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// locked deposit
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func init() { proto.RegisterFile("uptick/erc20/v1/erc20.proto", fileDescriptor_48d9cadaf7f73dba) }
-
-var fileDescriptor_48d9cadaf7f73dba = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x8e, 0xb7, 0x0e, 0x36, 0x6f, 0xeb, 0x3a, 0xab, 0x43, 0x51, 0x81, 0xac, 0x2a, 0x97, 0x6a,
-	0x12, 0xc9, 0x5a, 0x6e, 0x48, 0x08, 0x6d, 0x5d, 0x40, 0x43, 0x5b, 0x5b, 0x85, 0x56, 0x20, 0x2e,
-	0x95, 0x9b, 0xbc, 0x0a, 0x51, 0x5b, 0x3b, 0x4a, 0xbc, 0x96, 0xfe, 0x03, 0x8e, 0x5c, 0xb8, 0x23,
-	0x21, 0x8e, 0xfc, 0x8f, 0x1d, 0x77, 0xe4, 0x84, 0x50, 0x7b, 0xe1, 0x67, 0xa0, 0xd8, 0xee, 0x54,
-	0x3e, 0x6e, 0xe3, 0xe6, 0xe7, 0x79, 0x1f, 0xfb, 0x7d, 0xfc, 0x7e, 0xe0, 0xbb, 0x17, 0xb1, 0x88,
-	0xfc, 0x81, 0x03, 0x89, 0x5f, 0x3f, 0x74, 0xc6, 0x35, 0x75, 0xb0, 0xe3, 0x84, 0x0b, 0x4e, 0x76,
-	0x54, 0xd0, 0x56, 0xdc, 0xb8, 0x56, 0x2a, 0x86, 0x3c, 0xe4, 0x32, 0xe6, 0x64, 0x27, 0x25, 0x2b,
-	0x59, 0x3e, 0x4f, 0x47, 0x3c, 0x75, 0xfa, 0x94, 0x0d, 0x9c, 0x71, 0xad, 0x0f, 0x82, 0xd6, 0x24,
-	0x50, 0xf1, 0xca, 0x17, 0x84, 0x37, 0x3a, 0x7c, 0x00, 0xac, 0x4d, 0xa3, 0x84, 0x3c, 0xc0, 0xdb,
-	0xf2, 0xbd, 0x1e, 0x0d, 0x82, 0x04, 0xd2, 0xd4, 0x44, 0x65, 0x54, 0xdd, 0xf0, 0xb6, 0x24, 0x79,
-	0xa4, 0x38, 0x52, 0xc4, 0x6b, 0x01, 0x30, 0x3e, 0x32, 0x57, 0x64, 0x50, 0x01, 0x62, 0xe2, 0xdb,
-	0xc0, 0x68, 0x7f, 0x08, 0x81, 0xb9, 0x5a, 0x46, 0xd5, 0x75, 0x6f, 0x01, 0xc9, 0x13, 0x9c, 0xf7,
-	0x39, 0x13, 0x09, 0xf5, 0x45, 0x8f, 0x4f, 0x18, 0x24, 0x66, 0xae, 0x8c, 0xaa, 0xf9, 0xfa, 0x1d,
-	0xfb, 0x8f, 0x2f, 0xd8, 0xad, 0x2c, 0xea, 0x6d, 0x2f, 0xd4, 0x12, 0x3e, 0xce, 0xfd, 0xfc, 0xb4,
-	0x8f, 0x2a, 0x1f, 0x11, 0x2e, 0x7a, 0x10, 0x46, 0xa9, 0x80, 0xa4, 0xc1, 0x23, 0xd6, 0x4e, 0x78,
-	0xcc, 0x53, 0x3a, 0xcc, 0xdc, 0x88, 0x48, 0x0c, 0x41, 0x5b, 0x55, 0x80, 0x94, 0xf1, 0x66, 0x00,
-	0xa9, 0x9f, 0x44, 0xb1, 0x88, 0x38, 0xd3, 0x4e, 0x97, 0x29, 0xf2, 0x14, 0xaf, 0x8f, 0x40, 0xd0,
-	0x80, 0x0a, 0x2a, 0x0d, 0x6f, 0xd6, 0xef, 0xdb, 0xaa, 0x56, 0xb6, 0x2c, 0x8f, 0xae, 0x95, 0x7d,
-	0xae, 0x45, 0xc7, 0xb9, 0xcb, 0xef, 0xfb, 0x86, 0x77, 0x7d, 0x49, 0xfa, 0x32, 0x2a, 0x53, 0xbc,
-	0xb7, 0xb0, 0xe5, 0x7a, 0x8d, 0xfa, 0xe1, 0x8d, 0x7d, 0x55, 0xb0, 0xaa, 0xf6, 0xa2, 0x03, 0xab,
-	0x4b, 0x1d, 0xd0, 0x9c, 0x4e, 0xcd, 0xb0, 0xd9, 0xe1, 0x61, 0x38, 0x04, 0xd9, 0x3f, 0x0f, 0x86,
-	0x74, 0x7a, 0xe3, 0xec, 0xd9, 0xbd, 0xec, 0x35, 0x9d, 0x56, 0x01, 0xdd, 0x82, 0xaf, 0x08, 0xdf,
-	0xeb, 0xc6, 0x01, 0x15, 0x70, 0x3d, 0x30, 0xff, 0xe7, 0xcb, 0x7f, 0x4d, 0xdd, 0xea, 0x3f, 0xa6,
-	0xee, 0x00, 0xef, 0x32, 0x98, 0xf4, 0x7e, 0x17, 0xe6, 0xa4, 0x70, 0x87, 0xc1, 0xc4, 0x5d, 0xd2,
-	0x2a, 0xbf, 0x07, 0x2f, 0xf0, 0x9a, 0x9c, 0x20, 0xb2, 0x87, 0x77, 0x5b, 0xaf, 0x9a, 0xae, 0xd7,
-	0xeb, 0x36, 0x5f, 0xb6, 0xdd, 0xc6, 0xe9, 0xb3, 0x53, 0xf7, 0xa4, 0x60, 0x90, 0x02, 0xde, 0x52,
-	0xf4, 0x79, 0xeb, 0xa4, 0x7b, 0xe6, 0x16, 0x10, 0x21, 0x38, 0xaf, 0x18, 0xf7, 0x75, 0xc7, 0xf5,
-	0x9a, 0x47, 0x67, 0x85, 0x95, 0x52, 0xee, 0xfd, 0x67, 0xcb, 0x38, 0x7e, 0x7e, 0x39, 0xb3, 0xd0,
-	0xd5, 0xcc, 0x42, 0x3f, 0x66, 0x16, 0xfa, 0x30, 0xb7, 0x8c, 0xab, 0xb9, 0x65, 0x7c, 0x9b, 0x5b,
-	0xc6, 0x9b, 0x87, 0x61, 0x24, 0xde, 0x5e, 0xf4, 0x6d, 0x9f, 0x8f, 0x9c, 0xae, 0x9c, 0xe7, 0x26,
-	0x88, 0x09, 0x4f, 0x06, 0x8e, 0xde, 0xde, 0x77, 0x7a, 0x7f, 0xc5, 0x34, 0x86, 0xb4, 0x7f, 0x4b,
-	0xae, 0xdd, 0xa3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xab, 0x28, 0x79, 0xf2, 0xdc, 0x03, 0x00,
-	0x00,
+func (m *RegistrationDeposit) Reset()         { *m = RegistrationDeposit{} }
+func (m *RegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDeposit) ProtoMessage()    {}
+func (*RegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{9}
 }
-
-func (this *TokenPair) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *RegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDeposit.Merge(m, src)
+}
+func (m *RegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDeposit.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*TokenPair)
-	if !ok {
-		that2, ok := that.(TokenPair)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_RegistrationDeposit proto.InternalMessageInfo
+
+func (m *RegistrationDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *RegistrationDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	if this.Erc20Address != that1.Erc20Address {
-		return false
+	return ""
+}
+
+func (m *RegistrationDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if this.Denom != that1.Denom {
-		return false
+	return nil
+}
+
+// ResolveRegistrationDepositProposal is a gov Content type to refund the
+// registration deposit of an ERC20 token pair to its depositor or to slash it.
+type ResolveRegistrationDepositProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// contract address of ERC20 token
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// burn the deposit instead of refunding it
+	Slash bool `protobuf:"varint,4,opt,name=slash,proto3" json:"slash,omitempty"`
+}
+
+func (m *ResolveRegistrationDepositProposal) Reset()         { *m = ResolveRegistrationDepositProposal{} }
+func (m *ResolveRegistrationDepositProposal) String() string { return proto.CompactTextString(m) }
+func (*ResolveRegistrationDepositProposal) ProtoMessage()    {}
+func (*ResolveRegistrationDepositProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{10}
+}
+func (m *ResolveRegistrationDepositProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveRegistrationDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveRegistrationDepositProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Enabled != that1.Enabled {
-		return false
+}
+func (m *ResolveRegistrationDepositProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveRegistrationDepositProposal.Merge(m, src)
+}
+func (m *ResolveRegistrationDepositProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveRegistrationDepositProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveRegistrationDepositProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveRegistrationDepositProposal proto.InternalMessageInfo
+
+func (m *ResolveRegistrationDepositProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if this.ContractOwner != that1.ContractOwner {
-		return false
+	return ""
+}
+
+func (m *ResolveRegistrationDepositProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return true
+	return ""
 }
-func (this *ToggleTokenRelayProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResolveRegistrationDepositProposal) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
+	return ""
+}
 
-	that1, ok := that.(*ToggleTokenRelayProposal)
-	if !ok {
-		that2, ok := that.(ToggleTokenRelayProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ResolveRegistrationDepositProposal) GetSlash() bool {
+	if m != nil {
+		return m.Slash
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.Token != that1.Token {
-		return false
-	}
-	return true
+	return false
 }
-func (this *UpdateTokenPairERC20Proposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*UpdateTokenPairERC20Proposal)
-	if !ok {
-		that2, ok := that.(UpdateTokenPairERC20Proposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// UpdateTokenPairRateLimitProposal is a gov Content type to update the
+// conversion quotas of a token pair.
+type UpdateTokenPairRateLimitProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// new conversion quotas, a zero window removes the rate limit
+	RateLimit RateLimit `protobuf:"bytes,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *UpdateTokenPairRateLimitProposal) Reset()         { *m = UpdateTokenPairRateLimitProposal{} }
+func (m *UpdateTokenPairRateLimitProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTokenPairRateLimitProposal) ProtoMessage()    {}
+func (*UpdateTokenPairRateLimitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{11}
+}
+func (m *UpdateTokenPairRateLimitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTokenPairRateLimitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTokenPairRateLimitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.Erc20Address != that1.Erc20Address {
-		return false
-	}
-	if this.NewErc20Address != that1.NewErc20Address {
-		return false
-	}
-	return true
 }
-func (m *TokenPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UpdateTokenPairRateLimitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTokenPairRateLimitProposal.Merge(m, src)
 }
-
-func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateTokenPairRateLimitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTokenPairRateLimitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTokenPairRateLimitProposal.DiscardUnknown(m)
 }
 
-func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractOwner != 0 {
-		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_UpdateTokenPairRateLimitProposal proto.InternalMessageInfo
+
+func (m *UpdateTokenPairRateLimitProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *UpdateTokenPairRateLimitProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *UpdateTokenPairRateLimitProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateTokenPairRateLimitProposal) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return dAtA[:n], nil
+	return RateLimit{}
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateTokenPairConversionFeeProposal is a gov Content type to override the
+// conversion fees of a token pair.
+type UpdateTokenPairConversionFeeProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// fee rates of the token pair, the module params apply if empty
+	ConversionFee *ConversionFee `protobuf:"bytes,4,opt,name=conversion_fee,json=conversionFee,proto3" json:"conversion_fee,omitempty"`
 }
 
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *UpdateTokenPairConversionFeeProposal) Reset()         { *m = UpdateTokenPairConversionFeeProposal{} }
+func (m *UpdateTokenPairConversionFeeProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTokenPairConversionFeeProposal) ProtoMessage()    {}
+func (*UpdateTokenPairConversionFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{12}
+}
+func (m *UpdateTokenPairConversionFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTokenPairConversionFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTokenPairConversionFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintErc20(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateTokenPairConversionFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTokenPairConversionFeeProposal.Merge(m, src)
+}
+func (m *UpdateTokenPairConversionFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTokenPairConversionFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTokenPairConversionFeeProposal.DiscardUnknown(m)
 }
 
-func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateTokenPairConversionFeeProposal proto.InternalMessageInfo
+
+func (m *UpdateTokenPairConversionFeeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateTokenPairConversionFeeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpdateTokenPairConversionFeeProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ToggleTokenRelayProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateTokenPairConversionFeeProposal) GetConversionFee() *ConversionFee {
+	if m != nil {
+		return m.ConversionFee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ToggleTokenRelayProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateTokenPairAllowedBehavioursProposal is a gov Content type to update the
+// side effects of the contract accepted on the conversions of a native ERC20
+// token pair.
+type UpdateTokenPairAllowedBehavioursProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// accepted side effects, replacing the current ones
+	AllowedBehaviours []ConversionBehaviour `protobuf:"varint,4,rep,packed,name=allowed_behaviours,json=allowedBehaviours,proto3,enum=uptick.erc20.v1.ConversionBehaviour" json:"allowed_behaviours,omitempty"`
 }
 
-func (m *ToggleTokenRelayProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpdateTokenPairAllowedBehavioursProposal) Reset() {
+	*m = UpdateTokenPairAllowedBehavioursProposal{}
+}
+func (m *UpdateTokenPairAllowedBehavioursProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTokenPairAllowedBehavioursProposal) ProtoMessage()    {}
+func (*UpdateTokenPairAllowedBehavioursProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{13}
+}
+func (m *UpdateTokenPairAllowedBehavioursProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTokenPairAllowedBehavioursProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTokenPairAllowedBehavioursProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateTokenPairAllowedBehavioursProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTokenPairAllowedBehavioursProposal.Merge(m, src)
+}
+func (m *UpdateTokenPairAllowedBehavioursProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTokenPairAllowedBehavioursProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTokenPairAllowedBehavioursProposal.DiscardUnknown(m)
 }
 
-func (m *UpdateTokenPairERC20Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateTokenPairAllowedBehavioursProposal proto.InternalMessageInfo
+
+func (m *UpdateTokenPairAllowedBehavioursProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateTokenPairERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateTokenPairAllowedBehavioursProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *UpdateTokenPairERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewErc20Address) > 0 {
-		i -= len(m.NewErc20Address)
-		copy(dAtA[i:], m.NewErc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.NewErc20Address)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0x1a
+func (m *UpdateTokenPairAllowedBehavioursProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *UpdateTokenPairAllowedBehavioursProposal) GetAllowedBehaviours() []ConversionBehaviour {
+	if m != nil {
+		return m.AllowedBehaviours
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// UpdateTokenPairMetadataProposal is a gov Content type to update the bank
+// metadata of a registered coin along with the details of its ERC20 token. The
+// legacy ERC20 contracts of native coins without a metadata setter are
+// replaced only if they don't have any supply.
+type UpdateTokenPairMetadataProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// new metadata of the coin, the base denomination identifies the token pair
+	Metadata types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *UpdateTokenPairMetadataProposal) Reset()         { *m = UpdateTokenPairMetadataProposal{} }
+func (m *UpdateTokenPairMetadataProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTokenPairMetadataProposal) ProtoMessage()    {}
+func (*UpdateTokenPairMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{14}
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTokenPairMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTokenPairMetadataProposal.Merge(m, src)
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTokenPairMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTokenPairMetadataProposal.DiscardUnknown(m)
 }
 
-func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
-	offset -= sovErc20(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_UpdateTokenPairMetadataProposal proto.InternalMessageInfo
+
+func (m *UpdateTokenPairMetadataProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *TokenPair) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UpdateTokenPairMetadataProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *UpdateTokenPairMetadataProposal) GetMetadata() types.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return types.Metadata{}
+}
+
+// FailedConversion defines the coins received over IBC that couldn't be
+// converted to ERC20 tokens. The receiver can retry the conversion with
+// MsgRetryIBCConversion.
+type FailedConversion struct {
+	// bech32 address of the receiver of the transfer
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// IBC coin received
+	Coin types1.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// channel on which the packet was received
+	DestinationChannel string `protobuf:"bytes,3,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	// sequence of the received packet
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// reason of the conversion failure
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FailedConversion) Reset()         { *m = FailedConversion{} }
+func (m *FailedConversion) String() string { return proto.CompactTextString(m) }
+func (*FailedConversion) ProtoMessage()    {}
+func (*FailedConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48d9cadaf7f73dba, []int{15}
+}
+func (m *FailedConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Enabled {
-		n += 2
+}
+func (m *FailedConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedConversion.Merge(m, src)
+}
+func (m *FailedConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedConversion proto.InternalMessageInfo
+
+func (m *FailedConversion) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if m.ContractOwner != 0 {
-		n += 1 + sovErc20(uint64(m.ContractOwner))
+	return ""
+}
+
+func (m *FailedConversion) GetCoin() types1.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	return n
+	return types1.Coin{}
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FailedConversion) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *FailedConversion) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return 0
+}
+
+func (m *FailedConversion) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovErc20(uint64(l))
-	return n
+	return ""
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("uptick.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("uptick.erc20.v1.ConversionBehaviour", ConversionBehaviour_name, ConversionBehaviour_value)
+	proto.RegisterType((*TokenPair)(nil), "uptick.erc20.v1.TokenPair")
+	proto.RegisterType((*ConversionFee)(nil), "uptick.erc20.v1.ConversionFee")
+	proto.RegisterType((*RateLimit)(nil), "uptick.erc20.v1.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "uptick.erc20.v1.RateLimitFlow")
+	proto.RegisterType((*PairSupply)(nil), "uptick.erc20.v1.PairSupply")
+	proto.RegisterType((*RegisterCoinProposal)(nil), "uptick.erc20.v1.RegisterCoinProposal")
+	proto.RegisterType((*RegisterERC20Proposal)(nil), "uptick.erc20.v1.RegisterERC20Proposal")
+	proto.RegisterType((*ToggleTokenRelayProposal)(nil), "uptick.erc20.v1.ToggleTokenRelayProposal")
+	proto.RegisterType((*UpdateTokenPairERC20Proposal)(nil), "uptick.erc20.v1.UpdateTokenPairERC20Proposal")
+	proto.RegisterType((*RegistrationDeposit)(nil), "uptick.erc20.v1.RegistrationDeposit")
+	proto.RegisterType((*ResolveRegistrationDepositProposal)(nil), "uptick.erc20.v1.ResolveRegistrationDepositProposal")
+	proto.RegisterType((*UpdateTokenPairRateLimitProposal)(nil), "uptick.erc20.v1.UpdateTokenPairRateLimitProposal")
+	proto.RegisterType((*UpdateTokenPairConversionFeeProposal)(nil), "uptick.erc20.v1.UpdateTokenPairConversionFeeProposal")
+	proto.RegisterType((*UpdateTokenPairAllowedBehavioursProposal)(nil), "uptick.erc20.v1.UpdateTokenPairAllowedBehavioursProposal")
+	proto.RegisterType((*UpdateTokenPairMetadataProposal)(nil), "uptick.erc20.v1.UpdateTokenPairMetadataProposal")
+	proto.RegisterType((*FailedConversion)(nil), "uptick.erc20.v1.FailedConversion")
+}
+
+func init() { proto.RegisterFile("uptick/erc20/v1/erc20.proto", fileDescriptor_48d9cadaf7f73dba) }
+
+var fileDescriptor_48d9cadaf7f73dba = []byte{
+	// 1235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1a, 0xc7,
+	0x17, 0x67, 0x01, 0x3b, 0xf6, 0xc3, 0x38, 0x64, 0xe2, 0x44, 0xc4, 0xdf, 0x7c, 0x31, 0xa5, 0x51,
+	0x84, 0x2c, 0x05, 0x62, 0x72, 0x6a, 0xa5, 0x2a, 0x02, 0x7b, 0x49, 0x5c, 0x39, 0x40, 0x06, 0x93,
+	0xfe, 0xb8, 0xac, 0x96, 0xdd, 0x89, 0xbd, 0xf2, 0x7a, 0x87, 0xec, 0x0c, 0x50, 0xff, 0x07, 0x3d,
+	0xf6, 0xd2, 0x43, 0x0f, 0x95, 0x22, 0xf5, 0x56, 0xa9, 0xe7, 0x9e, 0x7a, 0xa8, 0x54, 0x55, 0x39,
+	0xa6, 0xea, 0xa5, 0xaa, 0xaa, 0xb4, 0x4a, 0x2e, 0xfd, 0x23, 0x7a, 0xa8, 0xe6, 0x07, 0x0b, 0xc6,
+	0x56, 0x1a, 0x91, 0xb8, 0xea, 0x09, 0xde, 0x8f, 0xf9, 0xbc, 0xcf, 0x9b, 0x79, 0xf3, 0xe6, 0x2d,
+	0xfc, 0xaf, 0xdf, 0xe3, 0x9e, 0x73, 0x50, 0x26, 0xa1, 0x53, 0xb9, 0x59, 0x1e, 0x6c, 0xa8, 0x3f,
+	0xa5, 0x5e, 0x48, 0x39, 0x45, 0xe7, 0x95, 0xb1, 0xa4, 0x74, 0x83, 0x8d, 0xd5, 0x95, 0x3d, 0xba,
+	0x47, 0xa5, 0xad, 0x2c, 0xfe, 0x29, 0xb7, 0xd5, 0x9c, 0x43, 0xd9, 0x21, 0x65, 0xe5, 0xae, 0x1d,
+	0x1c, 0x94, 0x07, 0x1b, 0x5d, 0xc2, 0xed, 0x0d, 0x29, 0x9c, 0xb0, 0x33, 0x12, 0xd9, 0x1d, 0xea,
+	0x05, 0xca, 0x5e, 0xf8, 0x2b, 0x0e, 0x8b, 0xbb, 0xf4, 0x80, 0x04, 0x2d, 0xdb, 0x0b, 0xd1, 0xdb,
+	0x90, 0x96, 0xf1, 0x2c, 0xdb, 0x75, 0x43, 0xc2, 0x58, 0xd6, 0xc8, 0x1b, 0xc5, 0x45, 0xbc, 0x24,
+	0x95, 0x55, 0xa5, 0x43, 0x2b, 0x30, 0xe7, 0x92, 0x80, 0x1e, 0x66, 0xe3, 0xd2, 0xa8, 0x04, 0x94,
+	0x85, 0x73, 0x24, 0xb0, 0xbb, 0x3e, 0x71, 0xb3, 0x89, 0xbc, 0x51, 0x5c, 0xc0, 0x23, 0x11, 0xbd,
+	0x07, 0xcb, 0x0e, 0x0d, 0x78, 0x68, 0x3b, 0xdc, 0xa2, 0xc3, 0x80, 0x84, 0xd9, 0x64, 0xde, 0x28,
+	0x2e, 0x57, 0x2e, 0x97, 0xa6, 0x52, 0x2c, 0x35, 0x85, 0x15, 0xa7, 0x47, 0xde, 0x52, 0x44, 0xef,
+	0x00, 0x84, 0x36, 0x27, 0x96, 0xef, 0x1d, 0x7a, 0x3c, 0x3b, 0x97, 0x37, 0x8a, 0xa9, 0xca, 0xea,
+	0x89, 0xa5, 0xd8, 0xe6, 0x64, 0x47, 0x78, 0xe0, 0xc5, 0x70, 0xf4, 0x17, 0x99, 0x32, 0xf2, 0x80,
+	0x84, 0xcc, 0xa3, 0x81, 0xf5, 0x90, 0x90, 0xec, 0xbc, 0x5c, 0x9e, 0x3b, 0xb1, 0x7c, 0x33, 0x72,
+	0xab, 0x13, 0x22, 0x19, 0x8c, 0x45, 0xd4, 0x06, 0x64, 0xfb, 0x3e, 0x1d, 0x12, 0xd7, 0xea, 0x92,
+	0x7d, 0x7b, 0xe0, 0xd1, 0x7e, 0xc8, 0xb2, 0xe7, 0xf2, 0x89, 0xe2, 0x72, 0xe5, 0xda, 0x4b, 0xa0,
+	0x6a, 0x23, 0x67, 0x7c, 0x41, 0xaf, 0x8f, 0x34, 0xec, 0xdd, 0xe4, 0x9f, 0x8f, 0xd7, 0x8c, 0x82,
+	0x03, 0xe9, 0x63, 0xa1, 0xd1, 0x35, 0x58, 0xf6, 0x82, 0x87, 0x3e, 0x1d, 0x0a, 0xba, 0x56, 0xb7,
+	0xa7, 0x8e, 0x20, 0x8d, 0x97, 0x94, 0xb6, 0x4e, 0x48, 0xad, 0xc7, 0xd0, 0x75, 0x38, 0x4f, 0xfb,
+	0xfc, 0x98, 0x5b, 0x5c, 0xba, 0xa5, 0xb5, 0x5a, 0xf9, 0xe9, 0x20, 0x3f, 0x19, 0xb0, 0x18, 0xed,
+	0x0f, 0xba, 0x0f, 0x1a, 0xcb, 0x7a, 0xd4, 0xa7, 0xdc, 0x56, 0x47, 0x5c, 0x2b, 0x3d, 0x79, 0xb6,
+	0x16, 0xfb, 0xf5, 0xd9, 0xda, 0xf5, 0x3d, 0x8f, 0xef, 0xf7, 0xbb, 0x25, 0x87, 0x1e, 0x96, 0x75,
+	0xe9, 0xa8, 0x9f, 0x1b, 0xcc, 0x3d, 0x28, 0xf3, 0xa3, 0x1e, 0x61, 0xa5, 0xed, 0x80, 0xe3, 0x94,
+	0xc2, 0xb8, 0x2f, 0x20, 0x50, 0x1b, 0x46, 0x71, 0x35, 0x66, 0x7c, 0x26, 0xcc, 0x25, 0x0d, 0xa2,
+	0x40, 0x2f, 0xc3, 0xfc, 0xd0, 0x0b, 0x5c, 0x3a, 0x94, 0xf5, 0x94, 0xc4, 0x5a, 0xd2, 0x39, 0xfd,
+	0x60, 0x40, 0x3a, 0xca, 0xa9, 0xee, 0xd3, 0x21, 0x7a, 0x0b, 0x96, 0x94, 0x87, 0xc5, 0xb8, 0x1d,
+	0x72, 0x99, 0x57, 0x02, 0xa7, 0x94, 0xae, 0x2d, 0x54, 0xa8, 0x0e, 0xf3, 0x8a, 0xf6, 0x8c, 0x04,
+	0xf5, 0x6a, 0x74, 0x17, 0xce, 0x69, 0xaa, 0xd9, 0xc4, 0x4c, 0x40, 0xa3, 0xe5, 0x85, 0xdf, 0xe2,
+	0x00, 0xe2, 0xe6, 0xb5, 0xfb, 0xbd, 0x9e, 0x7f, 0x24, 0xce, 0x46, 0xdd, 0x3f, 0x26, 0xe5, 0x59,
+	0xcf, 0x46, 0x62, 0x68, 0xc8, 0x0e, 0x2c, 0x2b, 0x48, 0xc2, 0x9c, 0x50, 0xd4, 0xe0, 0x8c, 0xb9,
+	0xab, 0xc6, 0x60, 0x6a, 0x10, 0xd4, 0x84, 0x94, 0xe8, 0x22, 0x23, 0xa2, 0xb3, 0x6d, 0x03, 0x08,
+	0x08, 0xcd, 0xb3, 0x0d, 0x69, 0x09, 0x18, 0xd1, 0x4c, 0xce, 0x56, 0x43, 0x02, 0x64, 0xc4, 0xb2,
+	0xf0, 0xb9, 0x01, 0x2b, 0x98, 0xec, 0x79, 0x8c, 0x93, 0x70, 0x93, 0x7a, 0x41, 0x2b, 0xa4, 0x3d,
+	0xca, 0x6c, 0x5f, 0xf4, 0x30, 0xee, 0x71, 0x9f, 0xe8, 0x06, 0xa7, 0x04, 0x94, 0x87, 0x94, 0x2b,
+	0xe2, 0x7b, 0x3d, 0xee, 0xd1, 0x40, 0xf7, 0xb7, 0x49, 0x15, 0xba, 0x0d, 0x0b, 0x87, 0x84, 0xdb,
+	0xae, 0xcd, 0x6d, 0x99, 0x73, 0xaa, 0xf2, 0xff, 0x92, 0xe2, 0x51, 0x92, 0x4d, 0x57, 0x77, 0xd8,
+	0xd2, 0x3d, 0xed, 0x54, 0x4b, 0x0a, 0xfe, 0x38, 0x5a, 0x24, 0xab, 0x37, 0x56, 0x38, 0x82, 0x4b,
+	0x23, 0x5a, 0x26, 0xde, 0xac, 0xdc, 0x7c, 0x6d, 0x5e, 0x05, 0x5d, 0x38, 0xa3, 0xbe, 0x9d, 0x98,
+	0xe8, 0xdb, 0x5a, 0xa7, 0x43, 0x07, 0x90, 0xdd, 0xa5, 0x7b, 0x7b, 0x3e, 0x91, 0x5d, 0x1f, 0x13,
+	0xdf, 0x3e, 0x7a, 0xed, 0xe8, 0x62, 0x9d, 0x40, 0xd3, 0x61, 0x95, 0xa0, 0x2f, 0xea, 0x37, 0x06,
+	0x5c, 0xed, 0xf4, 0x5c, 0x9b, 0x93, 0xe8, 0x99, 0x79, 0x33, 0x29, 0x9f, 0x78, 0xab, 0x12, 0xa7,
+	0xbc, 0x55, 0xeb, 0x70, 0x21, 0x20, 0x43, 0xeb, 0xb8, 0xa3, 0xac, 0x2c, 0x7c, 0x3e, 0x20, 0x43,
+	0x73, 0xc2, 0x57, 0xf3, 0xfd, 0xce, 0x80, 0x8b, 0xea, 0x6c, 0x42, 0x5b, 0xc4, 0xd9, 0x22, 0x3d,
+	0xca, 0x3c, 0xfe, 0x6a, 0x4f, 0xe3, 0x55, 0x58, 0x74, 0x95, 0x3f, 0x0d, 0x35, 0xe7, 0xb1, 0x02,
+	0x39, 0x30, 0x6f, 0x1f, 0xd2, 0x7e, 0xc0, 0xb3, 0x89, 0x7c, 0xa2, 0x98, 0xaa, 0x5c, 0x19, 0x97,
+	0x0e, 0x23, 0x51, 0xe9, 0x88, 0x3a, 0xad, 0xdd, 0x14, 0x65, 0xf3, 0xf5, 0xef, 0x6b, 0xc5, 0x57,
+	0x28, 0x7b, 0xb1, 0x80, 0x61, 0x0d, 0x5d, 0xf8, 0xd2, 0x80, 0x02, 0x26, 0x8c, 0xfa, 0x03, 0x72,
+	0x4a, 0x1a, 0xff, 0xce, 0xae, 0xaf, 0xc0, 0x1c, 0xf3, 0x6d, 0xb6, 0x2f, 0x77, 0x7a, 0x01, 0x2b,
+	0x41, 0xef, 0xef, 0xb7, 0x06, 0xe4, 0xa7, 0xea, 0x21, 0xea, 0xe3, 0x67, 0x53, 0x88, 0xe8, 0xf6,
+	0xb1, 0x09, 0x22, 0xf9, 0x4f, 0x13, 0x84, 0xbe, 0xb3, 0xe3, 0x39, 0x42, 0x33, 0xff, 0xde, 0x80,
+	0x6b, 0x53, 0xcc, 0x8f, 0xbd, 0xdd, 0x67, 0xc4, 0xfe, 0xe4, 0x10, 0x93, 0x9c, 0x61, 0x88, 0xd1,
+	0x39, 0xfc, 0x6c, 0x40, 0x71, 0x2a, 0x87, 0xea, 0xf4, 0x68, 0x72, 0x46, 0x79, 0x9c, 0x3e, 0x45,
+	0x25, 0xdf, 0xc4, 0x14, 0xf5, 0xd8, 0x80, 0xb5, 0xa9, 0xac, 0x46, 0x0d, 0xf8, 0xbf, 0xd2, 0xf1,
+	0x7f, 0x34, 0x20, 0x53, 0xb7, 0x3d, 0x9f, 0xb8, 0xe3, 0xcc, 0xd0, 0x2a, 0x2c, 0x84, 0xc4, 0x21,
+	0xde, 0x80, 0x84, 0x9a, 0x56, 0x24, 0xa3, 0x5b, 0x90, 0x14, 0x4f, 0x99, 0xa4, 0xf4, 0xd2, 0x56,
+	0xa1, 0xe2, 0x49, 0x67, 0x54, 0x86, 0x8b, 0x2e, 0x61, 0xdc, 0x0b, 0xe4, 0x9d, 0xb7, 0x9c, 0x7d,
+	0x3b, 0x08, 0x88, 0xaf, 0xcf, 0x01, 0x4d, 0x98, 0x36, 0x95, 0x45, 0x30, 0x60, 0xe4, 0x51, 0x9f,
+	0x04, 0x8e, 0x2a, 0xab, 0x24, 0x8e, 0x64, 0xb1, 0x63, 0x24, 0x0c, 0x69, 0x28, 0x67, 0xee, 0x45,
+	0xac, 0x84, 0xf5, 0xf7, 0x61, 0x4e, 0xcd, 0xe5, 0x97, 0xe0, 0x42, 0xf3, 0x83, 0x86, 0x89, 0xad,
+	0x4e, 0xa3, 0xdd, 0x32, 0x37, 0xb7, 0xeb, 0xdb, 0xe6, 0x56, 0x26, 0x86, 0x32, 0xb0, 0xa4, 0xd4,
+	0xf7, 0x9a, 0x5b, 0x9d, 0x1d, 0x33, 0x63, 0x20, 0x04, 0xcb, 0x4a, 0x63, 0x7e, 0xb8, 0x6b, 0xe2,
+	0x46, 0x75, 0x27, 0x13, 0x5f, 0x4d, 0x7e, 0xfa, 0x55, 0x2e, 0xb6, 0xfe, 0x85, 0x01, 0x17, 0x4f,
+	0x39, 0x68, 0x74, 0x05, 0x2e, 0xd5, 0xcc, 0xbb, 0xd5, 0x07, 0xdb, 0xcd, 0xce, 0x34, 0x7c, 0x01,
+	0x72, 0x63, 0x53, 0x75, 0x6b, 0x6b, 0x7b, 0x77, 0xbb, 0xd9, 0xa8, 0xee, 0x58, 0xbb, 0xb8, 0xda,
+	0x68, 0xd7, 0x4d, 0xdc, 0xce, 0xc4, 0xd1, 0x55, 0xc8, 0x8e, 0x7d, 0xda, 0x9d, 0x56, 0x6b, 0xe7,
+	0x23, 0x6b, 0xf3, 0x6e, 0xb5, 0x71, 0xc7, 0x6c, 0x67, 0x12, 0x2a, 0x74, 0x21, 0xb9, 0x60, 0x64,
+	0x8c, 0xf5, 0x2b, 0x13, 0x58, 0xad, 0x16, 0x6e, 0x3e, 0xa8, 0xee, 0x58, 0xe6, 0x03, 0xb3, 0xb1,
+	0xdb, 0xae, 0xdd, 0x79, 0xf2, 0x3c, 0x67, 0x3c, 0x7d, 0x9e, 0x33, 0xfe, 0x78, 0x9e, 0x33, 0x3e,
+	0x7b, 0x91, 0x8b, 0x3d, 0x7d, 0x91, 0x8b, 0xfd, 0xf2, 0x22, 0x17, 0xfb, 0xf8, 0xc6, 0x44, 0x4f,
+	0xee, 0xc8, 0xb2, 0x6d, 0x10, 0x3e, 0xa4, 0xe1, 0x41, 0x59, 0x7f, 0xcf, 0x7d, 0xa2, 0xbf, 0xe8,
+	0x64, 0x7b, 0xee, 0xce, 0xcb, 0x0f, 0xad, 0x5b, 0x7f, 0x0f, 0x00, 0x97, 0x8f, 0xc5, 0x48, 0xee,
+	0x0d, 0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if this.Erc20Address != that1.Erc20Address {
+		return false
 	}
-	return n
-}
-
-func (m *ToggleTokenRelayProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Denom != that1.Denom {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	if !this.RateLimit.Equal(that1.RateLimit) {
+		return false
+	}
+	if !this.ConversionFee.Equal(that1.ConversionFee) {
+		return false
+	}
+	if len(this.AllowedBehaviours) != len(that1.AllowedBehaviours) {
+		return false
+	}
+	for i := range this.AllowedBehaviours {
+		if this.AllowedBehaviours[i] != that1.AllowedBehaviours[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConversionFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionFee)
+	if !ok {
+		that2, ok := that.(ConversionFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InflowFeeBps != that1.InflowFeeBps {
+		return false
+	}
+	if this.OutflowFeeBps != that1.OutflowFeeBps {
+		return false
+	}
+	return true
+}
+func (this *RateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimit)
+	if !ok {
+		that2, ok := that.(RateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.InflowQuota.Equal(that1.InflowQuota) {
+		return false
+	}
+	if !this.OutflowQuota.Equal(that1.OutflowQuota) {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
+func (this *ToggleTokenRelayProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ToggleTokenRelayProposal)
+	if !ok {
+		that2, ok := that.(ToggleTokenRelayProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *UpdateTokenPairERC20Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateTokenPairERC20Proposal)
+	if !ok {
+		that2, ok := that.(UpdateTokenPairERC20Proposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.NewErc20Address != that1.NewErc20Address {
+		return false
+	}
+	return true
+}
+func (this *ResolveRegistrationDepositProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResolveRegistrationDepositProposal)
+	if !ok {
+		that2, ok := that.(ResolveRegistrationDepositProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Slash != that1.Slash {
+		return false
+	}
+	return true
+}
+func (this *UpdateTokenPairRateLimitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateTokenPairRateLimitProposal)
+	if !ok {
+		that2, ok := that.(UpdateTokenPairRateLimitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !this.RateLimit.Equal(&that1.RateLimit) {
+		return false
+	}
+	return true
+}
+func (this *UpdateTokenPairConversionFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateTokenPairConversionFeeProposal)
+	if !ok {
+		that2, ok := that.(UpdateTokenPairConversionFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !this.ConversionFee.Equal(that1.ConversionFee) {
+		return false
+	}
+	return true
+}
+func (this *UpdateTokenPairAllowedBehavioursProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateTokenPairAllowedBehavioursProposal)
+	if !ok {
+		that2, ok := that.(UpdateTokenPairAllowedBehavioursProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if len(this.AllowedBehaviours) != len(that1.AllowedBehaviours) {
+		return false
+	}
+	for i := range this.AllowedBehaviours {
+		if this.AllowedBehaviours[i] != that1.AllowedBehaviours[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedBehaviours) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedBehaviours)*10)
+		var j1 int
+		for _, num := range m.AllowedBehaviours {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintErc20(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ConversionFee != nil {
+		{
+			size, err := m.ConversionFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintErc20(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintErc20(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutflowFeeBps != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.OutflowFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InflowFeeBps != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.InflowFeeBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.OutflowQuota.Size()
+		i -= size
+		if _, err := m.OutflowQuota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflowQuota.Size()
+		i -= size
+		if _, err := m.InflowQuota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.WindowStart != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinEscrowed.Size()
+		i -= size
+		if _, err := m.CoinEscrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CoinSupply.Size()
+		i -= size
+		if _, err := m.CoinSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Escrowed.Size()
+		i -= size
+		if _, err := m.Erc20Escrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Erc20Supply.Size()
+		i -= size
+		if _, err := m.Erc20Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ToggleTokenRelayProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToggleTokenRelayProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ToggleTokenRelayProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewErc20Address) > 0 {
+		i -= len(m.NewErc20Address)
+		copy(dAtA[i:], m.NewErc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.NewErc20Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolveRegistrationDepositProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveRegistrationDepositProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolveRegistrationDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slash {
+		i--
+		if m.Slash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairRateLimitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairRateLimitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairRateLimitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairConversionFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairConversionFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairConversionFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConversionFee != nil {
+		{
+			size, err := m.ConversionFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintErc20(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairAllowedBehavioursProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairAllowedBehavioursProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairAllowedBehavioursProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedBehaviours) > 0 {
+		dAtA9 := make([]byte, len(m.AllowedBehaviours)*10)
+		var j8 int
+		for _, num := range m.AllowedBehaviours {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintErc20(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.ConversionFee != nil {
+		l = m.ConversionFee.Size()
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.AllowedBehaviours) > 0 {
+		l = 0
+		for _, e := range m.AllowedBehaviours {
+			l += sovErc20(uint64(e))
+		}
+		n += 1 + sovErc20(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ConversionFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InflowFeeBps != 0 {
+		n += 1 + sovErc20(uint64(m.InflowFeeBps))
+	}
+	if m.OutflowFeeBps != 0 {
+		n += 1 + sovErc20(uint64(m.OutflowFeeBps))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflowQuota.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.OutflowQuota.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovErc20(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovErc20(uint64(m.WindowStart))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *PairSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Erc20Supply.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Erc20Escrowed.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.CoinSupply.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.CoinEscrowed.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ToggleTokenRelayProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTokenPairERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.NewErc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResolveRegistrationDepositProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Slash {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateTokenPairRateLimitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *UpdateTokenPairConversionFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.ConversionFee != nil {
+		l = m.ConversionFee.Size()
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTokenPairAllowedBehavioursProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.AllowedBehaviours) > 0 {
+		l = 0
+		for _, e := range m.AllowedBehaviours {
+			l += sovErc20(uint64(e))
+		}
+		n += 1 + sovErc20(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *UpdateTokenPairMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *FailedConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovErc20(uint64(m.Sequence))
+	}
+	l = len(m.Error)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConversionFee == nil {
+				m.ConversionFee = &ConversionFee{}
+			}
+			if err := m.ConversionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v ConversionBehaviour
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowErc20
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ConversionBehaviour(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedBehaviours = append(m.AllowedBehaviours, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowErc20
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthErc20
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthErc20
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedBehaviours) == 0 {
+					m.AllowedBehaviours = make([]ConversionBehaviour, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ConversionBehaviour
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowErc20
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ConversionBehaviour(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedBehaviours = append(m.AllowedBehaviours, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBehaviours", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowFeeBps", wireType)
+			}
+			m.InflowFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflowFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowFeeBps", wireType)
+			}
+			m.OutflowFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutflowFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowQuota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowQuota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Escrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinEscrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinEscrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToggleTokenRelayProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToggleTokenRelayProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return nil
+}
+func (m *RegistrationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveRegistrationDepositProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveRegistrationDepositProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveRegistrationDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Slash = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UpdateTokenPairERC20Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.NewErc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozErc20(x uint64) (n int) {
-	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *UpdateTokenPairRateLimitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,15 +4622,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTokenPairRateLimitProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTokenPairRateLimitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,11 +4658,11 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,13 +4690,13 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1014,17 +4706,29 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			m.ContractOwner = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1034,11 +4738,25 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1060,7 +4778,7 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateTokenPairConversionFeeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1083,10 +4801,10 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTokenPairConversionFeeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTokenPairConversionFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1155,7 +4873,39 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1182,7 +4932,10 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConversionFee == nil {
+				m.ConversionFee = &ConversionFee{}
+			}
+			if err := m.ConversionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1207,7 +4960,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateTokenPairAllowedBehavioursProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1230,10 +4983,10 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTokenPairAllowedBehavioursProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTokenPairAllowedBehavioursProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1302,7 +5055,7 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1330,8 +5083,77 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v ConversionBehaviour
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowErc20
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ConversionBehaviour(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedBehaviours = append(m.AllowedBehaviours, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowErc20
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthErc20
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthErc20
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedBehaviours) == 0 {
+					m.AllowedBehaviours = make([]ConversionBehaviour, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ConversionBehaviour
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowErc20
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ConversionBehaviour(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedBehaviours = append(m.AllowedBehaviours, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBehaviours", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1353,7 +5175,7 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateTokenPairMetadataProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,10 +5198,10 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleTokenRelayProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTokenPairMetadataProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleTokenRelayProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTokenPairMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1448,9 +5270,9 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1460,23 +5282,24 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthErc20
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthErc20
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1499,7 +5322,7 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *FailedConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1522,15 +5345,15 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1558,13 +5381,13 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1574,27 +5397,28 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthErc20
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthErc20
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1622,11 +5446,30 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1654,7 +5497,7 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6,14 +6,18 @@ import (
 
 // errors
 var (
-	ErrERC20Disabled          = sdkerrors.Register(ModuleName, 2, "erc20 module is disabled")
-	ErrInternalTokenPair      = sdkerrors.Register(ModuleName, 3, "internal ethereum token mapping error")
-	ErrTokenPairNotFound      = sdkerrors.Register(ModuleName, 4, "token pair not found")
-	ErrTokenPairAlreadyExists = sdkerrors.Register(ModuleName, 5, "token pair already exists")
-	ErrUndefinedOwner         = sdkerrors.Register(ModuleName, 6, "undefined owner of contract pair")
-	ErrBalanceInvariance      = sdkerrors.Register(ModuleName, 7, "post transfer balance invariant failed")
-	ErrUnexpectedEvent        = sdkerrors.Register(ModuleName, 8, "unexpected event")
-	ErrABIPack                = sdkerrors.Register(ModuleName, 9, "contract ABI pack failed")
-	ErrABIUnpack              = sdkerrors.Register(ModuleName, 10, "contract ABI unpack failed")
-	ErrEVMDenom               = sdkerrors.Register(ModuleName, 11, "EVM denomination registration")
+	ErrERC20Disabled            = sdkerrors.Register(ModuleName, 2, "erc20 module is disabled")
+	ErrInternalTokenPair        = sdkerrors.Register(ModuleName, 3, "internal ethereum token mapping error")
+	ErrTokenPairNotFound        = sdkerrors.Register(ModuleName, 4, "token pair not found")
+	ErrTokenPairAlreadyExists   = sdkerrors.Register(ModuleName, 5, "token pair already exists")
+	ErrUndefinedOwner           = sdkerrors.Register(ModuleName, 6, "undefined owner of contract pair")
+	ErrBalanceInvariance        = sdkerrors.Register(ModuleName, 7, "post transfer balance invariant failed")
+	ErrUnexpectedEvent          = sdkerrors.Register(ModuleName, 8, "unexpected event")
+	ErrABIPack                  = sdkerrors.Register(ModuleName, 9, "contract ABI pack failed")
+	ErrABIUnpack                = sdkerrors.Register(ModuleName, 10, "contract ABI unpack failed")
+	ErrEVMDenom                 = sdkerrors.Register(ModuleName, 11, "EVM denomination registration")
+	ErrERC20Verification        = sdkerrors.Register(ModuleName, 12, "ERC20 contract verification failed")
+	ErrDepositNotFound          = sdkerrors.Register(ModuleName, 13, "registration deposit not found")
+	ErrFailedConversionNotFound = sdkerrors.Register(ModuleName, 14, "failed conversion not found")
+	ErrInvalidPermit            = sdkerrors.Register(ModuleName, 15, "invalid ERC20 permit")
 )
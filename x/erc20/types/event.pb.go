@@ -185,40 +185,136 @@ func (m *EventRegisterTokens) GetErc20Token() string {
 	return ""
 }
 
+// EventERC20HookConversion is emitted when an ERC20 transfer to the module
+// address is converted to a Cosmos coin by the EVM hook
+type EventERC20HookConversion struct {
+	// hex address of the ERC20 sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// bech32 address receiving the Cosmos coin
+	Receiver   string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CosmosCoin string `protobuf:"bytes,4,opt,name=cosmos_coin,json=cosmosCoin,proto3" json:"cosmos_coin,omitempty"`
+	Erc20Token string `protobuf:"bytes,5,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token,omitempty"`
+	// hash of the ethereum tx that emitted the transfer
+	TxHash string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *EventERC20HookConversion) Reset()         { *m = EventERC20HookConversion{} }
+func (m *EventERC20HookConversion) String() string { return proto.CompactTextString(m) }
+func (*EventERC20HookConversion) ProtoMessage()    {}
+func (*EventERC20HookConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e0d4b1c9e255f20, []int{2}
+}
+func (m *EventERC20HookConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventERC20HookConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventERC20HookConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventERC20HookConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventERC20HookConversion.Merge(m, src)
+}
+func (m *EventERC20HookConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventERC20HookConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventERC20HookConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventERC20HookConversion proto.InternalMessageInfo
+
+func (m *EventERC20HookConversion) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventERC20HookConversion) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *EventERC20HookConversion) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventERC20HookConversion) GetCosmosCoin() string {
+	if m != nil {
+		return m.CosmosCoin
+	}
+	return ""
+}
+
+func (m *EventERC20HookConversion) GetErc20Token() string {
+	if m != nil {
+		return m.Erc20Token
+	}
+	return ""
+}
+
+func (m *EventERC20HookConversion) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("uptick.erc20.v1.Status", Status_name, Status_value)
 	proto.RegisterType((*EventIBCERC20)(nil), "uptick.erc20.v1.EventIBCERC20")
 	proto.RegisterType((*EventRegisterTokens)(nil), "uptick.erc20.v1.EventRegisterTokens")
+	proto.RegisterType((*EventERC20HookConversion)(nil), "uptick.erc20.v1.EventERC20HookConversion")
 }
 
 func init() { proto.RegisterFile("uptick/erc20/v1/event.proto", fileDescriptor_9e0d4b1c9e255f20) }
 
 var fileDescriptor_9e0d4b1c9e255f20 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xdd, 0xee, 0xd2, 0x30,
-	0x1c, 0x5d, 0xf9, 0x52, 0x6a, 0x40, 0x2c, 0x24, 0x2e, 0x98, 0xcc, 0x85, 0xc4, 0x64, 0x31, 0x71,
-	0x05, 0x7c, 0x02, 0x98, 0xd3, 0x10, 0xc9, 0x4c, 0x36, 0x16, 0x13, 0x6f, 0xc8, 0x18, 0xbf, 0x8c,
-	0x05, 0x69, 0x71, 0xed, 0x50, 0xdf, 0xc0, 0x4b, 0xdf, 0xc1, 0x97, 0xf1, 0xca, 0x70, 0xe9, 0xa5,
-	0x81, 0x17, 0x31, 0x74, 0xfb, 0x13, 0xf2, 0xbf, 0xeb, 0xf9, 0x6a, 0x7a, 0x7a, 0xf0, 0xb3, 0x7c,
-	0x2f, 0xd3, 0x78, 0x4b, 0x21, 0x8b, 0xc7, 0x43, 0x7a, 0x18, 0x51, 0x38, 0x00, 0x93, 0xf6, 0x3e,
-	0xe3, 0x92, 0x93, 0xc7, 0x85, 0x68, 0x2b, 0xd1, 0x3e, 0x8c, 0xfa, 0xbd, 0x84, 0x27, 0x5c, 0x69,
-	0xf4, 0x72, 0x2a, 0x6c, 0x83, 0x3f, 0x08, 0xb7, 0xdc, 0x4b, 0x6c, 0x36, 0x75, 0x5c, 0xdf, 0x19,
-	0x0f, 0x09, 0xc5, 0x0d, 0x21, 0x23, 0x99, 0x0b, 0x1d, 0x99, 0xc8, 0x6a, 0x8f, 0x9f, 0xda, 0xf7,
-	0x6e, 0xb2, 0x03, 0x25, 0xfb, 0xa5, 0x8d, 0xe8, 0xf8, 0xc1, 0x0e, 0x84, 0x88, 0x12, 0xd0, 0x2b,
-	0x26, 0xb2, 0x9a, 0xfe, 0x1d, 0x24, 0x7d, 0xfc, 0x50, 0xc0, 0x97, 0x1c, 0x58, 0x0c, 0x7a, 0xd5,
-	0x44, 0x56, 0xcd, 0xbf, 0x62, 0xf2, 0x02, 0xb7, 0x05, 0xcf, 0xb3, 0x18, 0x96, 0xf1, 0x26, 0x62,
-	0x0c, 0x3e, 0xeb, 0x35, 0x15, 0x6e, 0x15, 0xac, 0x53, 0x90, 0x84, 0xe2, 0xee, 0x1a, 0x84, 0x4c,
-	0x59, 0x24, 0x53, 0xce, 0xae, 0xde, 0xba, 0xf2, 0x92, 0x1b, 0xa9, 0x0c, 0x0c, 0xe6, 0xb8, 0xab,
-	0xfa, 0xf8, 0x90, 0xa4, 0x42, 0x42, 0xb6, 0xe0, 0x5b, 0x60, 0x82, 0xf4, 0x70, 0x7d, 0x0d, 0x8c,
-	0xef, 0x74, 0x64, 0x56, 0xad, 0xa6, 0x5f, 0x00, 0xf2, 0x1c, 0x3f, 0x52, 0xad, 0x96, 0xf2, 0xe2,
-	0x2a, 0x9f, 0x8f, 0x15, 0xa5, 0x72, 0x2f, 0x67, 0xb8, 0x51, 0xb4, 0x25, 0x04, 0xb7, 0x83, 0xc5,
-	0x64, 0x11, 0x06, 0xcb, 0xd0, 0x7b, 0xef, 0x7d, 0xf8, 0xe8, 0x75, 0xb4, 0x1b, 0x2e, 0x08, 0x1d,
-	0xc7, 0x0d, 0x82, 0x0e, 0x22, 0x4f, 0x70, 0xab, 0xe4, 0xde, 0x4e, 0x66, 0x73, 0xf7, 0x4d, 0xa7,
-	0xd2, 0xaf, 0xfd, 0xf8, 0x65, 0x68, 0xd3, 0x77, 0xbf, 0x4f, 0x06, 0x3a, 0x9e, 0x0c, 0xf4, 0xef,
-	0x64, 0xa0, 0x9f, 0x67, 0x43, 0x3b, 0x9e, 0x0d, 0xed, 0xef, 0xd9, 0xd0, 0x3e, 0xbd, 0x4a, 0x52,
-	0xb9, 0xc9, 0x57, 0x76, 0xcc, 0x77, 0x34, 0x54, 0x7f, 0xed, 0x81, 0xfc, 0xca, 0xb3, 0x2d, 0x2d,
-	0x07, 0xfe, 0x56, 0x4e, 0x2c, 0xbf, 0xef, 0x41, 0xac, 0x1a, 0x6a, 0xb9, 0xd7, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xb2, 0xae, 0xb3, 0x7c, 0xff, 0x01, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xb6, 0x8d, 0x4b, 0x16, 0x25, 0x84, 0x6d, 0x45, 0xad, 0x20, 0x99, 0x28, 0x12, 0x52,
+	0x84, 0x84, 0xdd, 0x86, 0x27, 0x68, 0x4d, 0xa0, 0x11, 0x55, 0x90, 0xec, 0x44, 0x48, 0x5c, 0x2c,
+	0xd7, 0x19, 0xd9, 0x56, 0xf0, 0x4e, 0xf0, 0xae, 0x4d, 0x78, 0x03, 0x8e, 0xbc, 0x03, 0x4f, 0xc2,
+	0x8d, 0x13, 0xea, 0x91, 0x23, 0x4a, 0x5e, 0x04, 0x79, 0xbd, 0x44, 0x55, 0x6e, 0xfb, 0xfd, 0x8d,
+	0x66, 0x66, 0x87, 0x3e, 0x2d, 0x56, 0x32, 0x8d, 0x96, 0x0e, 0xe4, 0xd1, 0xe8, 0xdc, 0x29, 0x2f,
+	0x1c, 0x28, 0x81, 0x4b, 0x7b, 0x95, 0xa3, 0x44, 0xf6, 0xa8, 0x16, 0x6d, 0x25, 0xda, 0xe5, 0x45,
+	0xef, 0x34, 0xc6, 0x18, 0x95, 0xe6, 0x54, 0xaf, 0xda, 0x36, 0xf8, 0x4d, 0x68, 0x7b, 0x5c, 0xc5,
+	0x26, 0x57, 0xee, 0xd8, 0x73, 0x47, 0xe7, 0xcc, 0xa1, 0x86, 0x90, 0xa1, 0x2c, 0x84, 0x49, 0xfa,
+	0x64, 0xd8, 0x19, 0x9d, 0xd9, 0x7b, 0x95, 0x6c, 0x5f, 0xc9, 0x9e, 0xb6, 0x31, 0x93, 0x1e, 0x67,
+	0x20, 0x44, 0x18, 0x83, 0x79, 0xd0, 0x27, 0xc3, 0x96, 0xf7, 0x1f, 0xb2, 0x1e, 0x7d, 0x20, 0xe0,
+	0x73, 0x01, 0x3c, 0x02, 0xf3, 0xb0, 0x4f, 0x86, 0x47, 0xde, 0x0e, 0xb3, 0xe7, 0xb4, 0x23, 0xb0,
+	0xc8, 0x23, 0x08, 0xa2, 0x24, 0xe4, 0x1c, 0x3e, 0x99, 0x47, 0x2a, 0xdc, 0xae, 0x59, 0xb7, 0x26,
+	0x99, 0x43, 0x4f, 0x16, 0x20, 0x64, 0xca, 0x43, 0x99, 0x22, 0xdf, 0x79, 0x9b, 0xca, 0xcb, 0xee,
+	0x49, 0x3a, 0x30, 0xb8, 0xa1, 0x27, 0x6a, 0x1e, 0x0f, 0xe2, 0x54, 0x48, 0xc8, 0x67, 0xb8, 0x04,
+	0x2e, 0xd8, 0x29, 0x6d, 0x2e, 0x80, 0x63, 0x66, 0x92, 0xfe, 0xe1, 0xb0, 0xe5, 0xd5, 0x80, 0x3d,
+	0xa3, 0x0f, 0xd5, 0x54, 0x81, 0xac, 0x5c, 0xba, 0x7d, 0xaa, 0x28, 0x95, 0x1b, 0xfc, 0x24, 0xd4,
+	0x54, 0xe5, 0xd4, 0x6e, 0xae, 0x11, 0x97, 0x2e, 0xf2, 0x12, 0x72, 0x91, 0x22, 0x67, 0x4f, 0xa8,
+	0x21, 0x80, 0x2f, 0x20, 0x57, 0x9b, 0x6a, 0x79, 0x1a, 0x55, 0x63, 0xe7, 0x10, 0x41, 0x5a, 0x42,
+	0xae, 0x4b, 0xee, 0x70, 0x95, 0x09, 0x33, 0x2c, 0xb8, 0x54, 0x0b, 0x69, 0x79, 0x1a, 0x55, 0x9d,
+	0x44, 0x28, 0x32, 0x14, 0x41, 0x84, 0x29, 0xd7, 0xbb, 0xa0, 0x35, 0xe5, 0x62, 0xca, 0xf7, 0x5b,
+	0x6d, 0xee, 0xb7, 0xca, 0xce, 0xe8, 0xb1, 0x5c, 0x07, 0x49, 0x28, 0x12, 0xd3, 0xa8, 0x4b, 0xcb,
+	0xf5, 0x75, 0x28, 0x92, 0x17, 0x13, 0x6a, 0xd4, 0x3f, 0xc6, 0x18, 0xed, 0xf8, 0xb3, 0xcb, 0xd9,
+	0xdc, 0x0f, 0xe6, 0xd3, 0x77, 0xd3, 0xf7, 0x1f, 0xa6, 0xdd, 0xc6, 0x3d, 0xce, 0x9f, 0xbb, 0xee,
+	0xd8, 0xf7, 0xbb, 0x84, 0x3d, 0xa6, 0x6d, 0xcd, 0xbd, 0xb9, 0x9c, 0xdc, 0x8c, 0x5f, 0x77, 0x0f,
+	0x7a, 0x47, 0xdf, 0x7e, 0x58, 0x8d, 0xab, 0xb7, 0xbf, 0x36, 0x16, 0xb9, 0xdb, 0x58, 0xe4, 0xef,
+	0xc6, 0x22, 0xdf, 0xb7, 0x56, 0xe3, 0x6e, 0x6b, 0x35, 0xfe, 0x6c, 0xad, 0xc6, 0xc7, 0x97, 0x71,
+	0x2a, 0x93, 0xe2, 0xd6, 0x8e, 0x30, 0x73, 0xe6, 0xea, 0x5e, 0xa6, 0x20, 0xbf, 0x60, 0xbe, 0x74,
+	0xf4, 0x91, 0xae, 0xf5, 0x99, 0xca, 0xaf, 0x2b, 0x10, 0xb7, 0x86, 0xba, 0xbe, 0x57, 0xff, 0x06,
+	0x00, 0x5e, 0x5f, 0x7f, 0x5e, 0xc3, 0x02, 0x00, 0x00,
 }
 
 func (m *EventIBCERC20) Marshal() (dAtA []byte, err error) {
@@ -314,6 +410,71 @@ func (m *EventRegisterTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventERC20HookConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventERC20HookConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventERC20HookConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Erc20Token) > 0 {
+		i -= len(m.Erc20Token)
+		copy(dAtA[i:], m.Erc20Token)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Erc20Token)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CosmosCoin) > 0 {
+		i -= len(m.CosmosCoin)
+		copy(dAtA[i:], m.CosmosCoin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CosmosCoin)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -371,6 +532,39 @@ func (m *EventRegisterTokens) Size() (n int) {
 	return n
 }
 
+func (m *EventERC20HookConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CosmosCoin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Erc20Token)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -675,6 +869,248 @@ func (m *EventRegisterTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventERC20HookConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventERC20HookConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventERC20HookConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosCoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,10 +18,39 @@ const (
 	EventTypeRegisterERC20        = "register_erc20"
 	EventTypeToggleTokenRelay     = "toggle_token_relay" // #nosec
 	EventTypeUpdateTokenPairERC20 = "update_token_pair_erc20"
+	EventTypeRefundDeposit        = "refund_registration_deposit"
+	EventTypeSlashDeposit         = "slash_registration_deposit"
+	EventTypeUpdateRateLimit      = "update_token_pair_rate_limit"
+	EventTypeRateLimitExceeded    = "rate_limit_exceeded"
+	EventTypeConversionFee        = "conversion_fee"
+	EventTypeUpdateConversionFee  = "update_token_pair_conversion_fee"
+	EventTypeTransferERC20        = "transfer_erc20"
+	EventTypeRefundERC20          = "refund_erc20"
+	EventTypeSetAutoConvert       = "set_auto_convert"
+	EventTypeIBCContractCall      = "ibc_contract_call"
+	EventTypeRetryIBCConversion   = "retry_ibc_conversion"
+	EventTypeConvertWithPermit    = "convert_erc20_with_permit"
+	EventTypeAutoRegisterCoin     = "auto_register_coin"
+	EventTypeUpdateMetadata       = "update_token_pair_metadata"
+	EventTypeUpdateBehaviours     = "update_token_pair_allowed_behaviours"
 
 	AttributeKeyCosmosCoin = "cosmos_coin"
 	AttributeKeyERC20Token = "erc20_token" // #nosec
 	AttributeKeyReceiver   = "receiver"
+	AttributeKeyDepositor  = "depositor"
+	AttributeKeyDirection  = "direction"
+	AttributeKeyQuota      = "quota"
+	AttributeKeyFee        = "fee"
+	AttributeKeyFeeBps     = "fee_bps"
+	AttributeKeyRecipient  = "fee_recipient"
+	AttributeKeyChannel    = "source_channel"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyEnabled    = "enabled"
+	AttributeKeyContract   = "contract"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyRelayer    = "relayer"
+	AttributeKeyRelayerFee = "relayer_fee"
+	AttributeKeyDenomTrace = "denom_trace"
 
 	ERC20EventTransfer = "Transfer"
 )
@@ -1,6 +1,13 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
@@ -40,5 +47,84 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenDeposit := make(map[string]bool)
+	for _, d := range gs.RegistrationDeposits {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if seenDeposit[d.Erc20Address] {
+			return fmt.Errorf("registration deposit duplicated on genesis '%s'", d.Erc20Address)
+		}
+		if !seenErc20[d.Erc20Address] {
+			return fmt.Errorf("registration deposit of unregistered token ERC20 contract on genesis '%s'", d.Erc20Address)
+		}
+
+		seenDeposit[d.Erc20Address] = true
+	}
+
+	seenOptOut := make(map[string]bool)
+	for _, addr := range gs.AutoConvertOptOuts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid auto-convert opt-out address '%s': %w", addr, err)
+		}
+		if seenOptOut[addr] {
+			return fmt.Errorf("auto-convert opt-out duplicated on genesis '%s'", addr)
+		}
+
+		seenOptOut[addr] = true
+	}
+
+	seenFailed := make(map[string]bool)
+	for _, fc := range gs.FailedConversions {
+		if err := fc.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%d", fc.Receiver, fc.DestinationChannel, fc.Sequence)
+		if seenFailed[key] {
+			return fmt.Errorf("failed conversion duplicated on genesis '%s'", key)
+		}
+
+		seenFailed[key] = true
+	}
+
 	return gs.Params.Validate()
 }
+
+// Validate performs a stateless validation of a RegistrationDeposit
+func (d RegistrationDeposit) Validate() error {
+	if err := ethermint.ValidateAddress(d.Erc20Address); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor address '%s': %w", d.Depositor, err)
+	}
+	if !d.Amount.IsValid() {
+		return fmt.Errorf("invalid registration deposit %s of '%s'", d.Amount, d.Erc20Address)
+	}
+	return nil
+}
+
+// NewFailedConversion returns an instance of FailedConversion
+func NewFailedConversion(receiver sdk.AccAddress, coin sdk.Coin, channelID string, sequence uint64, reason string) FailedConversion { // nolint: interfacer
+	return FailedConversion{
+		Receiver:           receiver.String(),
+		Coin:               coin,
+		DestinationChannel: channelID,
+		Sequence:           sequence,
+		Error:              reason,
+	}
+}
+
+// Validate performs a stateless validation of a FailedConversion
+func (fc FailedConversion) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(fc.Receiver); err != nil {
+		return fmt.Errorf("invalid failed conversion receiver '%s': %w", fc.Receiver, err)
+	}
+	if !fc.Coin.IsValid() || !fc.Coin.IsPositive() {
+		return fmt.Errorf("invalid failed conversion coin %s", fc.Coin)
+	}
+	if err := host.ChannelIdentifierValidator(fc.DestinationChannel); err != nil {
+		return fmt.Errorf("invalid failed conversion channel '%s': %w", fc.DestinationChannel, err)
+	}
+	return nil
+}
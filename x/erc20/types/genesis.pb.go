@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +31,13 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// deposits locked by the self-serve registrations of ERC20 token pairs
+	RegistrationDeposits []RegistrationDeposit `protobuf:"bytes,3,rep,name=registration_deposits,json=registrationDeposits,proto3" json:"registration_deposits"`
+	// bech32 addresses of the accounts that opted out of the conversion of the
+	// coins received over IBC
+	AutoConvertOptOuts []string `protobuf:"bytes,4,rep,name=auto_convert_opt_outs,json=autoConvertOptOuts,proto3" json:"auto_convert_opt_outs,omitempty"`
+	// coins received over IBC that couldn't be converted
+	FailedConversions []FailedConversion `protobuf:"bytes,5,rep,name=failed_conversions,json=failedConversions,proto3" json:"failed_conversions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +87,27 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRegistrationDeposits() []RegistrationDeposit {
+	if m != nil {
+		return m.RegistrationDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoConvertOptOuts() []string {
+	if m != nil {
+		return m.AutoConvertOptOuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedConversions() []FailedConversion {
+	if m != nil {
+		return m.FailedConversions
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// parameter to enable the intrarelaying of Cosmos coins <--> ERC20 tokens.
@@ -86,6 +116,21 @@ type Params struct {
 	// Coin by transferring the Tokens through a MsgEthereumTx to the
 	// ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// deposit locked to register an ERC20 token pair with MsgRegisterERC20. An
+	// empty deposit disables the self-serve registration.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// fee rate in basis points charged on the conversions of ERC20 tokens to
+	// Cosmos coins
+	InflowFeeBps uint32 `protobuf:"varint,4,opt,name=inflow_fee_bps,json=inflowFeeBps,proto3" json:"inflow_fee_bps,omitempty"`
+	// fee rate in basis points charged on the conversions of Cosmos coins to
+	// ERC20 tokens
+	OutflowFeeBps uint32 `protobuf:"varint,5,opt,name=outflow_fee_bps,json=outflowFeeBps,proto3" json:"outflow_fee_bps,omitempty"`
+	// name of the module account receiving the conversion fees. The fees sent to
+	// the distribution module fund the community pool.
+	FeeRecipient string `protobuf:"bytes,6,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// channels whose received IBC vouchers are registered as token pairs on
+	// their first receipt, without a RegisterCoinProposal
+	AutoRegisterChannels []string `protobuf:"bytes,7,rep,name=auto_register_channels,json=autoRegisterChannels,proto3" json:"auto_register_channels,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -135,6 +180,41 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetInflowFeeBps() uint32 {
+	if m != nil {
+		return m.InflowFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetOutflowFeeBps() uint32 {
+	if m != nil {
+		return m.OutflowFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetAutoRegisterChannels() []string {
+	if m != nil {
+		return m.AutoRegisterChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "uptick.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "uptick.erc20.v1.Params")
@@ -143,27 +223,44 @@ func init() {
 func init() { proto.RegisterFile("uptick/erc20/v1/genesis.proto", fileDescriptor_46287becf4ffd2e8) }
 
 var fileDescriptor_46287becf4ffd2e8 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2d, 0x28, 0xc9,
-	0x4c, 0xce, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x48, 0xeb, 0x81, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0xa4, 0xd1, 0xd5, 0x43, 0x64, 0xc0, 0xaa, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc1, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x35,
-	0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x94, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5c, 0x0f, 0xcd, 0x16, 0xbd, 0x00, 0xb0, 0xb4, 0x13, 0xcb,
-	0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xc5, 0x42, 0x8e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79,
-	0xf1, 0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x52, 0x18, 0x7a,
-	0x43, 0x40, 0x6a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xda, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69,
-	0x5c, 0x6c, 0x10, 0xa3, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1,
-	0x1a, 0xc1, 0x2e, 0xe1, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61,
-	0x4a, 0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd,
-	0x93, 0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c,
-	0xcb, 0x05, 0x71, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x14, 0xec, 0x72, 0xbf,
-	0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x7d, 0x68, 0xc0, 0x56, 0x40, 0x83, 0xb6, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x84, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xbd, 0xf8,
-	0x9a, 0xa7, 0x01, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x52, 0x13, 0x4f,
+	0x10, 0xc6, 0xb3, 0x04, 0xf2, 0xff, 0x33, 0x49, 0xa4, 0x18, 0x83, 0xae, 0x58, 0x2e, 0x01, 0x29,
+	0x2b, 0x17, 0x76, 0x09, 0xea, 0xc1, 0xa3, 0x89, 0x80, 0x17, 0x85, 0x5a, 0x95, 0x83, 0x97, 0xad,
+	0xc9, 0xd2, 0x09, 0x53, 0x49, 0xa6, 0xb7, 0x66, 0x26, 0x8b, 0x5e, 0x7c, 0x05, 0x7d, 0x0e, 0x9f,
+	0x84, 0x23, 0x47, 0x4f, 0x68, 0x85, 0x57, 0xf0, 0x01, 0xac, 0x9d, 0x19, 0x2c, 0x24, 0x9c, 0x32,
+	0xf9, 0xbe, 0x5f, 0xf7, 0x76, 0x7d, 0xd3, 0x43, 0x1e, 0x4d, 0x32, 0xcd, 0xd3, 0x61, 0x04, 0x32,
+	0xdd, 0xd9, 0x8e, 0xf2, 0x76, 0x34, 0x00, 0x01, 0x8a, 0xab, 0x30, 0x93, 0xa8, 0x91, 0x2e, 0x59,
+	0x3b, 0x34, 0x76, 0x98, 0xb7, 0x57, 0x1f, 0xde, 0xe4, 0xad, 0x63, 0xe8, 0xd5, 0xc6, 0x00, 0x07,
+	0x68, 0x8e, 0x51, 0x71, 0x72, 0x6a, 0x90, 0xa2, 0x1a, 0xa3, 0x8a, 0x7a, 0x4c, 0x41, 0x94, 0xb7,
+	0x7b, 0xa0, 0x59, 0x3b, 0x4a, 0x91, 0x0b, 0xeb, 0x6f, 0xfc, 0x9e, 0x23, 0xb5, 0x7d, 0xfb, 0xd5,
+	0x77, 0x9a, 0x69, 0xa0, 0xcf, 0x49, 0x25, 0x63, 0x92, 0x8d, 0x95, 0xef, 0x35, 0xbd, 0x56, 0x75,
+	0xe7, 0x7e, 0x78, 0x63, 0x8a, 0xf0, 0xd0, 0xd8, 0x9d, 0xf9, 0xb3, 0x8b, 0xb5, 0x52, 0xec, 0x60,
+	0xfa, 0x92, 0x54, 0x35, 0x0e, 0x41, 0x24, 0x19, 0xe3, 0x52, 0xf9, 0x73, 0xcd, 0x72, 0xab, 0xba,
+	0xb3, 0x3a, 0x53, 0xfb, 0xbe, 0x60, 0x0e, 0x19, 0x97, 0xae, 0x9c, 0xe8, 0x2b, 0x41, 0xd1, 0x84,
+	0xac, 0x48, 0x18, 0x70, 0xa5, 0x25, 0xd3, 0x1c, 0x45, 0x72, 0x0c, 0x19, 0x2a, 0xae, 0x95, 0x5f,
+	0x36, 0xcd, 0x36, 0x67, 0x9a, 0xc5, 0xd7, 0xe8, 0x57, 0x16, 0x76, 0x6d, 0x1b, 0x72, 0xd6, 0x52,
+	0xb4, 0x4d, 0x56, 0xd8, 0x44, 0x63, 0x92, 0xa2, 0xc8, 0x41, 0xea, 0x04, 0x33, 0x9d, 0xe0, 0x44,
+	0x2b, 0x7f, 0xbe, 0x59, 0x6e, 0x2d, 0xc6, 0xb4, 0x30, 0xbb, 0xd6, 0x3b, 0xc8, 0xf4, 0xc1, 0x44,
+	0x2b, 0x7a, 0x44, 0x68, 0x9f, 0xf1, 0x11, 0x1c, 0xbb, 0x22, 0xc5, 0x51, 0x28, 0x7f, 0xc1, 0x0c,
+	0xb4, 0x3e, 0x33, 0xd0, 0x9e, 0x41, 0xbb, 0x7f, 0x49, 0x37, 0xcd, 0x72, 0xff, 0x86, 0xae, 0x36,
+	0xbe, 0x96, 0x49, 0xc5, 0xe6, 0x48, 0xd7, 0x49, 0x0d, 0x04, 0xeb, 0x8d, 0x20, 0x31, 0x7d, 0x4c,
+	0xec, 0xff, 0xc7, 0x55, 0xab, 0xed, 0x16, 0x12, 0x7d, 0x41, 0x96, 0xae, 0x90, 0x7c, 0x9c, 0x9c,
+	0x20, 0x0e, 0xfd, 0xb9, 0x82, 0xea, 0x2c, 0x4f, 0x2f, 0xd6, 0xea, 0xbb, 0x96, 0x3c, 0x7a, 0xf3,
+	0x1a, 0x71, 0x18, 0xd7, 0x5d, 0x61, 0x3e, 0x2e, 0xfe, 0xd2, 0x2f, 0xa4, 0x71, 0x5b, 0xa8, 0x2e,
+	0xd3, 0x07, 0xa1, 0x5d, 0x8f, 0xb0, 0x58, 0x8f, 0xd0, 0xad, 0x47, 0xd8, 0x45, 0x2e, 0x3a, 0xdb,
+	0xc5, 0xe8, 0xdf, 0x7f, 0xae, 0xb5, 0x06, 0x5c, 0x9f, 0x4c, 0x7a, 0x61, 0x8a, 0xe3, 0xc8, 0xed,
+	0x92, 0xfd, 0xd9, 0x52, 0xc7, 0xc3, 0x48, 0x7f, 0xce, 0x40, 0x99, 0x02, 0x15, 0xdf, 0xbd, 0x25,
+	0x74, 0xba, 0x49, 0xee, 0x70, 0xd1, 0x1f, 0xe1, 0x69, 0xd2, 0x07, 0x48, 0x7a, 0x59, 0x11, 0xb6,
+	0xd7, 0xaa, 0xc7, 0x35, 0xab, 0xee, 0x01, 0x74, 0x32, 0x45, 0x9f, 0x90, 0x25, 0x9c, 0xe8, 0x7f,
+	0xb0, 0x05, 0x83, 0xd5, 0x9d, 0xec, 0xb8, 0xc7, 0xa4, 0x5e, 0xf8, 0x12, 0x52, 0x9e, 0x71, 0x10,
+	0xda, 0xaf, 0x34, 0xbd, 0xd6, 0x62, 0x5c, 0xeb, 0x03, 0xc4, 0x57, 0x1a, 0x7d, 0x46, 0xee, 0x99,
+	0x6b, 0xb6, 0xe3, 0x80, 0x4c, 0xd2, 0x13, 0x26, 0x04, 0x8c, 0x94, 0xff, 0x9f, 0xb9, 0xe7, 0x46,
+	0xe1, 0xc6, 0xce, 0xec, 0x3a, 0xaf, 0xb3, 0x7f, 0x36, 0x0d, 0xbc, 0xf3, 0x69, 0xe0, 0xfd, 0x9a,
+	0x06, 0xde, 0xb7, 0xcb, 0xa0, 0x74, 0x7e, 0x19, 0x94, 0x7e, 0x5c, 0x06, 0xa5, 0x8f, 0x5b, 0xd7,
+	0x12, 0xf8, 0x60, 0x6e, 0xfc, 0x2d, 0xe8, 0x53, 0x94, 0xc3, 0xc8, 0x3d, 0xc7, 0x4f, 0xee, 0x41,
+	0x9a, 0x30, 0x7a, 0x15, 0xf3, 0xb0, 0x9e, 0xfe, 0x19, 0x00, 0xf2, 0xd8, 0xc5, 0x9a, 0xdd, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +283,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedConversions) > 0 {
+		for iNdEx := len(m.FailedConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AutoConvertOptOuts) > 0 {
+		for iNdEx := len(m.AutoConvertOptOuts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoConvertOptOuts[iNdEx])
+			copy(dAtA[i:], m.AutoConvertOptOuts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoConvertOptOuts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RegistrationDeposits) > 0 {
+		for iNdEx := len(m.RegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -233,6 +367,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoRegisterChannels) > 0 {
+		for iNdEx := len(m.AutoRegisterChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoRegisterChannels[iNdEx])
+			copy(dAtA[i:], m.AutoRegisterChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoRegisterChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OutflowFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutflowFeeBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InflowFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InflowFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -281,6 +455,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegistrationDeposits) > 0 {
+		for _, e := range m.RegistrationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoConvertOptOuts) > 0 {
+		for _, s := range m.AutoConvertOptOuts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedConversions) > 0 {
+		for _, e := range m.FailedConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -296,6 +488,28 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.InflowFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.InflowFeeBps))
+	}
+	if m.OutflowFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.OutflowFeeBps))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AutoRegisterChannels) > 0 {
+		for _, s := range m.AutoRegisterChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -401,6 +615,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposits = append(m.RegistrationDeposits, RegistrationDeposit{})
+			if err := m.RegistrationDeposits[len(m.RegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoConvertOptOuts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoConvertOptOuts = append(m.AutoConvertOptOuts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedConversions = append(m.FailedConversions, FailedConversion{})
+			if err := m.FailedConversions[len(m.FailedConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -491,6 +805,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowFeeBps", wireType)
+			}
+			m.InflowFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflowFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowFeeBps", wireType)
+			}
+			m.OutflowFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutflowFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRegisterChannels = append(m.AutoRegisterChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
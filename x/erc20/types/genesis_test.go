@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -128,6 +129,90 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with auto-convert opt-outs",
+			genState: &GenesisState{
+				Params:             DefaultParams(),
+				AutoConvertOptOuts: []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated auto-convert opt-out",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AutoConvertOptOuts: []string{
+					"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+					"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid auto-convert opt-out",
+			genState: &GenesisState{
+				Params:             DefaultParams(),
+				AutoConvertOptOuts: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with failed conversions",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FailedConversions: []FailedConversion{
+					{
+						Receiver:           "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+						Coin:               sdk.NewInt64Coin("ibc/uatom", 100),
+						DestinationChannel: "channel-0",
+						Sequence:           1,
+					},
+					{
+						Receiver:           "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+						Coin:               sdk.NewInt64Coin("ibc/uatom", 100),
+						DestinationChannel: "channel-0",
+						Sequence:           2,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated failed conversion",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FailedConversions: []FailedConversion{
+					{
+						Receiver:           "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+						Coin:               sdk.NewInt64Coin("ibc/uatom", 100),
+						DestinationChannel: "channel-0",
+						Sequence:           1,
+					},
+					{
+						Receiver:           "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+						Coin:               sdk.NewInt64Coin("ibc/uatom", 50),
+						DestinationChannel: "channel-0",
+						Sequence:           1,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - failed conversion without coins",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FailedConversions: []FailedConversion{
+					{
+						Receiver:           "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+						Coin:               sdk.NewInt64Coin("ibc/uatom", 0),
+						DestinationChannel: "channel-0",
+						Sequence:           1,
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
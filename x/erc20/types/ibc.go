@@ -1,17 +1,50 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 )
 
 func IBCDenom(port, channel, denom string) (string, error) {
+	voucherDenom := IBCDenomTrace(port, channel, denom).IBCDenom()
+	return voucherDenom, nil
+}
+
+// IBCDenomTrace returns the denomination trace of the vouchers minted for a
+// denomination received on the given port and channel
+func IBCDenomTrace(port, channel, denom string) transfertypes.DenomTrace {
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
 	sourcePrefix := transfertypes.GetDenomPrefix(port, channel)
 	// NOTE: sourcePrefix contains the trailing "/"
 	prefixedDenom := sourcePrefix + denom
 
 	// construct the denomination trace from the full raw denomination
-	denomTrace := transfertypes.ParseDenomTrace(prefixedDenom)
-	voucherDenom := denomTrace.IBCDenom()
-	return voucherDenom, nil
+	return transfertypes.ParseDenomTrace(prefixedDenom)
+}
+
+// IBCDenomMetadata returns the metadata of the vouchers of a denomination
+// trace registered without a RegisterCoinProposal, e.g.
+//  - base: ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+//  - name: transfer/channel-0/uatom
+//  - symbol: ibcUATOM
+func IBCDenomMetadata(denomTrace transfertypes.DenomTrace) banktypes.Metadata {
+	denom := denomTrace.IBCDenom()
+	path := denomTrace.GetFullDenomPath()
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC voucher of %s", path),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    path,
+		Symbol:  "ibc" + strings.ToUpper(denomTrace.BaseDenom),
+	}
 }
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ConversionAction defines how the registered coins received over IBC are
+// handled by the module
+type ConversionAction int
+
+const (
+	// ActionDefault converts the coins unless the receiver opted out
+	ActionDefault ConversionAction = iota
+	// ActionKeepNative keeps the received coins
+	ActionKeepNative
+	// ActionConvert converts the coins unless the receiver opted out
+	ActionConvert
+	// ActionConvertAndCall converts the coins to the IBC caller address of the
+	// sender, which approves and calls a contract
+	ActionConvertAndCall
+)
+
+const (
+	// ReceiverSuffixSeparator separates the receiver address from the
+	// conversion action on the receiver of an ICS-20 packet, e.g.
+	// "uptick1...|keep" or "uptick1...|call:0x..."
+	ReceiverSuffixSeparator = "|"
+
+	actionKeep    = "keep"
+	actionConvert = "convert"
+	actionCall    = "call"
+)
+
+// IBCRoute defines the conversion of the coins received by an ICS-20 packet
+type IBCRoute struct {
+	Action ConversionAction
+	// contract called by ActionConvertAndCall
+	Contract common.Address
+	// calldata of the contract call
+	Data []byte
+}
+
+// packetData is a lenient representation of the ICS-20 packet data, which
+// accepts the memo field of newer ICS-20 versions
+type packetData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo,omitempty"`
+}
+
+// memo is the JSON format of the erc20 instructions on an ICS-20 memo, e.g.
+// {"erc20":{"action":"call","contract":"0x...","data":"0x..."}}
+type memo struct {
+	ERC20 *struct {
+		Action   string `json:"action"`
+		Contract string `json:"contract,omitempty"`
+		Data     string `json:"data,omitempty"`
+	} `json:"erc20"`
+}
+
+// ParseIBCRoute returns the conversion route of a received ICS-20 packet set
+// by its memo or, without erc20 instructions on the memo, by the suffix of its
+// receiver. The returned packet carries the data expected by the transfer
+// module, without the memo and the receiver suffix. The receiver of
+// ActionConvertAndCall is replaced by the IBC caller address of the sender.
+//
+// Packets that can't be parsed are returned unchanged with ActionDefault.
+func ParseIBCRoute(packet channeltypes.Packet) (channeltypes.Packet, IBCRoute) {
+	var data packetData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return packet, IBCRoute{}
+	}
+
+	receiver := data.Receiver
+	route, ok := parseMemo(data.Memo)
+	if idx := strings.LastIndex(receiver, ReceiverSuffixSeparator); idx >= 0 {
+		suffixRoute, valid := parseAction(receiver[idx+1:])
+		if valid {
+			receiver = receiver[:idx]
+			if !ok {
+				route = suffixRoute
+			}
+		}
+	}
+
+	if data.Memo == "" && receiver == data.Receiver {
+		return packet, route
+	}
+
+	if route.Action == ActionConvertAndCall {
+		caller := IBCCallerAddress(packet.GetDestChannel(), data.Sender)
+		receiver = sdk.AccAddress(caller.Bytes()).String()
+	}
+
+	packet.Data = transfertypes.NewFungibleTokenPacketData(
+		data.Denom, data.Amount, data.Sender, receiver,
+	).GetBytes()
+	return packet, route
+}
+
+// IBCCallerAddress returns the address calling the contracts of the
+// ActionConvertAndCall routes of a sender on the given channel. It is only
+// controlled by the packets of the sender.
+func IBCCallerAddress(channel, sender string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(ModuleName + "/" + channel + "/" + sender)))
+}
+
+func parseMemo(str string) (IBCRoute, bool) {
+	var m memo
+	if err := json.Unmarshal([]byte(str), &m); err != nil || m.ERC20 == nil {
+		return IBCRoute{}, false
+	}
+
+	switch m.ERC20.Action {
+	case actionKeep:
+		return IBCRoute{Action: ActionKeepNative}, true
+	case actionConvert:
+		return IBCRoute{Action: ActionConvert}, true
+	case actionCall:
+		if !common.IsHexAddress(m.ERC20.Contract) {
+			return IBCRoute{}, false
+		}
+		var data []byte
+		if m.ERC20.Data != "" {
+			bz, err := hexutil.Decode(m.ERC20.Data)
+			if err != nil {
+				return IBCRoute{}, false
+			}
+			data = bz
+		}
+		return IBCRoute{
+			Action:   ActionConvertAndCall,
+			Contract: common.HexToAddress(m.ERC20.Contract),
+			Data:     data,
+		}, true
+	default:
+		return IBCRoute{}, false
+	}
+}
+
+func parseAction(str string) (IBCRoute, bool) {
+	switch {
+	case str == actionKeep:
+		return IBCRoute{Action: ActionKeepNative}, true
+	case str == actionConvert:
+		return IBCRoute{Action: ActionConvert}, true
+	case strings.HasPrefix(str, actionCall+":"):
+		contract := strings.TrimPrefix(str, actionCall+":")
+		if !common.IsHexAddress(contract) {
+			return IBCRoute{}, false
+		}
+		return IBCRoute{Action: ActionConvertAndCall, Contract: common.HexToAddress(contract)}, true
+	default:
+		return IBCRoute{}, false
+	}
+}
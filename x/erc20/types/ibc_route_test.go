@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type IBCRouteTestSuite struct {
+	suite.Suite
+}
+
+func TestIBCRouteSuite(t *testing.T) {
+	suite.Run(t, new(IBCRouteTestSuite))
+}
+
+func (suite *IBCRouteTestSuite) TestParseIBCRoute() {
+	contract := common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7")
+	receiver := "uptick1ps7w8jmn9uzjwy5q4mqsxvw5g4e6t9qrzqpyxr"
+	sender := "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+
+	newPacket := func(receiver, memo string) channeltypes.Packet {
+		bz, err := json.Marshal(packetData{
+			Denom:    "uatom",
+			Amount:   "100",
+			Sender:   sender,
+			Receiver: receiver,
+			Memo:     memo,
+		})
+		suite.Require().NoError(err)
+		return channeltypes.Packet{Data: bz, DestinationChannel: "channel-0"}
+	}
+
+	testCases := []struct {
+		msg         string
+		packet      channeltypes.Packet
+		expRoute    IBCRoute
+		expReceiver string
+	}{
+		{
+			"no memo nor suffix",
+			newPacket(receiver, ""),
+			IBCRoute{},
+			receiver,
+		},
+		{
+			"unparseable memo",
+			newPacket(receiver, "not a json"),
+			IBCRoute{},
+			receiver,
+		},
+		{
+			"memo without erc20 instructions",
+			newPacket(receiver, `{"forward":{"receiver":"cosmos1"}}`),
+			IBCRoute{},
+			receiver,
+		},
+		{
+			"memo with unknown action",
+			newPacket(receiver, `{"erc20":{"action":"burn"}}`),
+			IBCRoute{},
+			receiver,
+		},
+		{
+			"memo keep",
+			newPacket(receiver, `{"erc20":{"action":"keep"}}`),
+			IBCRoute{Action: ActionKeepNative},
+			receiver,
+		},
+		{
+			"memo convert",
+			newPacket(receiver, `{"erc20":{"action":"convert"}}`),
+			IBCRoute{Action: ActionConvert},
+			receiver,
+		},
+		{
+			"memo call with invalid data",
+			newPacket(receiver, `{"erc20":{"action":"call","contract":"`+contract.Hex()+`","data":"zz"}}`),
+			IBCRoute{},
+			receiver,
+		},
+		{
+			"memo call",
+			newPacket(receiver, `{"erc20":{"action":"call","contract":"`+contract.Hex()+`","data":"0x01"}}`),
+			IBCRoute{Action: ActionConvertAndCall, Contract: contract, Data: []byte{1}},
+			"",
+		},
+		{
+			"suffix keep",
+			newPacket(receiver+"|keep", ""),
+			IBCRoute{Action: ActionKeepNative},
+			receiver,
+		},
+		{
+			"suffix call",
+			newPacket(receiver+"|call:"+contract.Hex(), ""),
+			IBCRoute{Action: ActionConvertAndCall, Contract: contract},
+			"",
+		},
+		{
+			"invalid suffix is kept",
+			newPacket(receiver+"|burn", ""),
+			IBCRoute{},
+			receiver + "|burn",
+		},
+		{
+			"memo takes precedence over the suffix",
+			newPacket(receiver+"|keep", `{"erc20":{"action":"convert"}}`),
+			IBCRoute{Action: ActionConvert},
+			receiver,
+		},
+	}
+
+	for i, tc := range testCases {
+		packet, route := ParseIBCRoute(tc.packet)
+		suite.Require().Equal(tc.expRoute, route, "test %d failed: %s", i, tc.msg)
+
+		var data transfertypes.FungibleTokenPacketData
+		err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data)
+		suite.Require().NoError(err, "test %d failed: %s", i, tc.msg)
+
+		expReceiver := tc.expReceiver
+		if route.Action == ActionConvertAndCall {
+			caller := IBCCallerAddress("channel-0", sender)
+			expReceiver = sdk.AccAddress(caller.Bytes()).String()
+		}
+		suite.Require().Equal(expReceiver, data.Receiver, "test %d failed: %s", i, tc.msg)
+		suite.Require().Equal("100", data.Amount)
+	}
+}
+
+func (suite *IBCRouteTestSuite) TestIBCCallerAddress() {
+	caller := IBCCallerAddress("channel-0", "cosmos1sender")
+	suite.Require().Equal(caller, IBCCallerAddress("channel-0", "cosmos1sender"))
+	suite.Require().NotEqual(caller, IBCCallerAddress("channel-1", "cosmos1sender"))
+	suite.Require().NotEqual(caller, IBCCallerAddress("channel-0", "cosmos1other"))
+}
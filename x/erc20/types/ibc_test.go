@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBCDenomMetadata(t *testing.T) {
+	testCases := []struct {
+		name      string
+		denom     string
+		expName   string
+		expSymbol string
+	}{
+		{"native denom", "uatom", "transfer/channel-0/uatom", "ibcUATOM"},
+		{"multi hop denom", "transfer/channel-5/uosmo", "transfer/channel-0/transfer/channel-5/uosmo", "ibcUOSMO"},
+	}
+
+	for _, tc := range testCases {
+		denomTrace := IBCDenomTrace("transfer", "channel-0", tc.denom)
+		metadata := IBCDenomMetadata(denomTrace)
+
+		denom, err := IBCDenom("transfer", "channel-0", tc.denom)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, denom, metadata.Base, tc.name)
+		require.Equal(t, tc.expName, metadata.Name, tc.name)
+		require.Equal(t, tc.expSymbol, metadata.Symbol, tc.name)
+
+		// the metadata is accepted by a RegisterCoinProposal
+		require.NoError(t, metadata.Validate(), tc.name)
+		require.NoError(t, validateIBC(metadata), tc.name)
+	}
+}
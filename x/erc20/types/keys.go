@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/ethereum/go-ethereum/common"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -16,6 +18,11 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// DepositModuleName is the name of the module account holding the
+	// registration deposits, kept apart from the escrowed coins of the
+	// token pairs
+	DepositModuleName = ModuleName + "_deposit"
 )
 
 // ModuleAddress is the native module address for EVM
@@ -30,6 +37,11 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixRegistrationDeposit
+	prefixRateLimitFlow
+	prefixIBCTransfer
+	prefixAutoConvertOptOut
+	prefixFailedConversion
 )
 
 // KVStore key prefixes
@@ -37,4 +49,29 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+
+	KeyPrefixRegistrationDeposit = []byte{prefixRegistrationDeposit}
+	KeyPrefixRateLimitFlow       = []byte{prefixRateLimitFlow}
+	KeyPrefixIBCTransfer         = []byte{prefixIBCTransfer}
+	KeyPrefixAutoConvertOptOut   = []byte{prefixAutoConvertOptOut}
+	KeyPrefixFailedConversion    = []byte{prefixFailedConversion}
 )
+
+// IBCTransferKey returns the store key of the packet sent by MsgTransferERC20
+// on the given port and channel
+func IBCTransferKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(portID+"/"+channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// FailedConversionsKey returns the store key prefix of the failed conversions
+// of a receiver
+func FailedConversionsKey(receiver sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(receiver)
+}
+
+// FailedConversionKey returns the store key of the failed conversion of a
+// packet received on the given channel
+func FailedConversionKey(receiver sdk.AccAddress, channelID string, sequence uint64) []byte {
+	key := append(FailedConversionsKey(receiver), []byte(channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -1,22 +1,53 @@
 package types
 
 import (
+	"math/big"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgTransferERC20{}
+	_ sdk.Msg = &MsgSetAutoConvert{}
+	_ sdk.Msg = &MsgRetryIBCConversion{}
+	_ sdk.Msg = &MsgConvertERC20WithPermit{}
 )
 
+// conversionTermsArgs are the ABI encoded arguments of the conversion terms of
+// MsgConvertERC20WithPermit:
+// (chainId, contract, owner, receiver, amount, relayer, relayerFee, nonce, deadline)
+var conversionTermsArgs abi.Arguments
+
+func init() {
+	uint256, _ := abi.NewType("uint256", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	conversionTermsArgs = abi.Arguments{
+		{Type: uint256}, {Type: address}, {Type: address}, {Type: address}, {Type: uint256},
+		{Type: address}, {Type: uint256}, {Type: uint256}, {Type: uint256},
+	}
+}
+
 const (
-	TypeMsgConvertCoin  = "convert_coin"
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertCoin            = "convert_coin"
+	TypeMsgConvertERC20           = "convert_ERC20"
+	TypeMsgRegisterERC20          = "register_ERC20"
+	TypeMsgTransferERC20          = "transfer_ERC20"
+	TypeMsgSetAutoConvert         = "set_auto_convert"
+	TypeMsgRetryIBCConversion     = "retry_ibc_conversion"
+	TypeMsgConvertERC20WithPermit = "convert_ERC20_with_permit"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -114,3 +145,289 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	addr := common.HexToAddress(msg.Sender)
 	return []sdk.AccAddress{addr.Bytes()}
 }
+
+// NewMsgRegisterERC20 creates a new instance of MsgRegisterERC20
+func NewMsgRegisterERC20(contract common.Address, deployNonce uint64, sender sdk.AccAddress) *MsgRegisterERC20 { // nolint: interfacer
+	return &MsgRegisterERC20{
+		ContractAddress: contract.String(),
+		DeployNonce:     deployNonce,
+		Sender:          sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterERC20) Type() string { return TypeMsgRegisterERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgTransferERC20 creates a new instance of MsgTransferERC20
+func NewMsgTransferERC20( // nolint: interfacer
+	amount sdk.Int, contract, sender common.Address,
+	sourcePort, sourceChannel, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgTransferERC20 {
+	return &MsgTransferERC20{
+		ContractAddress:  contract.String(),
+		Amount:           amount,
+		Sender:           sender.Hex(),
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferERC20) Type() string { return TypeMsgTransferERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot transfer a non-positive amount")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout height and timestamp cannot both be 0")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgTransferERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferERC20) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// NewMsgSetAutoConvert creates a new instance of MsgSetAutoConvert
+func NewMsgSetAutoConvert(sender sdk.AccAddress, enabled bool) *MsgSetAutoConvert { // nolint: interfacer
+	return &MsgSetAutoConvert{
+		Sender:  sender.String(),
+		Enabled: enabled,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetAutoConvert) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetAutoConvert) Type() string { return TypeMsgSetAutoConvert }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetAutoConvert) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetAutoConvert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetAutoConvert) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRetryIBCConversion creates a new instance of MsgRetryIBCConversion
+func NewMsgRetryIBCConversion(sender sdk.AccAddress, channelID string, sequence uint64) *MsgRetryIBCConversion { // nolint: interfacer
+	return &MsgRetryIBCConversion{
+		Sender:             sender.String(),
+		DestinationChannel: channelID,
+		Sequence:           sequence,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRetryIBCConversion) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRetryIBCConversion) Type() string { return TypeMsgRetryIBCConversion }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRetryIBCConversion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if err := host.ChannelIdentifierValidator(msg.DestinationChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid destination channel ID")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRetryIBCConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRetryIBCConversion) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC20WithPermit creates a new instance of MsgConvertERC20WithPermit
+func NewMsgConvertERC20WithPermit( // nolint: interfacer
+	amount sdk.Int, receiver sdk.AccAddress, contract, owner common.Address,
+	relayer sdk.AccAddress, relayerFee, deadline sdk.Int,
+	permitSignature, conversionSignature []byte,
+) *MsgConvertERC20WithPermit {
+	return &MsgConvertERC20WithPermit{
+		ContractAddress:     contract.String(),
+		Amount:              amount,
+		Receiver:            receiver.String(),
+		Owner:               owner.Hex(),
+		Relayer:             relayer.String(),
+		RelayerFee:          relayerFee,
+		Deadline:            deadline,
+		PermitSignature:     permitSignature,
+		ConversionSignature: conversionSignature,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20WithPermit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20WithPermit) Type() string { return TypeMsgConvertERC20WithPermit }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20WithPermit) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner hex address %s", msg.Owner)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "invalid relayer address")
+	}
+	if msg.RelayerFee.IsNegative() || msg.RelayerFee.GTE(msg.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "relayer fee %s must be lower than the amount", msg.RelayerFee)
+	}
+	if !msg.Deadline.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidPermit, "invalid deadline %s", msg.Deadline)
+	}
+	if len(msg.PermitSignature) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidPermit, "invalid permit signature length %d", len(msg.PermitSignature))
+	}
+	if len(msg.ConversionSignature) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidPermit, "invalid conversion signature length %d", len(msg.ConversionSignature))
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC20WithPermit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20WithPermit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// ConversionHash returns the hash of the conversion terms signed by the owner,
+// which binds the receiver and the relayer fee to the permit nonce of the owner
+// on the given chain
+func (msg MsgConvertERC20WithPermit) ConversionHash(chainID, nonce *big.Int) (common.Hash, error) {
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	relayer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	bz, err := conversionTermsArgs.Pack(
+		chainID,
+		common.HexToAddress(msg.ContractAddress),
+		common.HexToAddress(msg.Owner),
+		common.BytesToAddress(receiver.Bytes()),
+		msg.Amount.BigInt(),
+		common.BytesToAddress(relayer.Bytes()),
+		msg.RelayerFee.BigInt(),
+		nonce,
+		msg.Deadline.BigInt(),
+	)
+	if err != nil {
+		return common.Hash{}, sdkerrors.Wrap(ErrABIPack, err.Error())
+	}
+
+	return crypto.Keccak256Hash(bz), nil
+}
@@ -1,11 +1,13 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/tests"
@@ -246,3 +248,459 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20Getters() {
+	msgInvalid := MsgRegisterERC20{}
+	msg := NewMsgRegisterERC20(
+		tests.GenerateAddress(),
+		1,
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRegisterERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20() {
+	testCases := []struct {
+		msg        string
+		contract   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.AccAddress{}.String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg register erc20 - pass",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRegisterERC20{tc.contract, 0, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgTransferERC20Getters() {
+	msg := NewMsgTransferERC20(
+		sdk.NewInt(100),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+		"transfer",
+		"channel-0",
+		"cosmos1receiver",
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgTransferERC20, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgTransferERC20() {
+	testCases := []struct {
+		msg              string
+		contract         string
+		amount           sdk.Int
+		sender           string
+		channel          string
+		receiver         string
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+		expectPass       bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.AccAddress{}.String(),
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"channel-0",
+			"cosmos1receiver",
+			clienttypes.NewHeight(0, 100),
+			0,
+			false,
+		},
+		{
+			"non-positive amount",
+			tests.GenerateAddress().String(),
+			sdk.NewInt(0),
+			tests.GenerateAddress().String(),
+			"channel-0",
+			"cosmos1receiver",
+			clienttypes.NewHeight(0, 100),
+			0,
+			false,
+		},
+		{
+			"invalid sender hex address",
+			tests.GenerateAddress().String(),
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"channel-0",
+			"cosmos1receiver",
+			clienttypes.NewHeight(0, 100),
+			0,
+			false,
+		},
+		{
+			"invalid source channel",
+			tests.GenerateAddress().String(),
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"",
+			"cosmos1receiver",
+			clienttypes.NewHeight(0, 100),
+			0,
+			false,
+		},
+		{
+			"empty receiver",
+			tests.GenerateAddress().String(),
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"channel-0",
+			" ",
+			clienttypes.NewHeight(0, 100),
+			0,
+			false,
+		},
+		{
+			"no timeout",
+			tests.GenerateAddress().String(),
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"channel-0",
+			"cosmos1receiver",
+			clienttypes.ZeroHeight(),
+			0,
+			false,
+		},
+		{
+			"msg transfer erc20 - pass",
+			tests.GenerateAddress().String(),
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"channel-0",
+			"cosmos1receiver",
+			clienttypes.ZeroHeight(),
+			1000,
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgTransferERC20{tc.contract, tc.amount, tc.sender, "transfer", tc.channel, tc.receiver, tc.timeoutHeight, tc.timeoutTimestamp}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetAutoConvertGetters() {
+	msgInvalid := MsgSetAutoConvert{}
+	msg := NewMsgSetAutoConvert(sdk.AccAddress(tests.GenerateAddress().Bytes()), false)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgSetAutoConvert, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgSetAutoConvert() {
+	suite.Require().Error(MsgSetAutoConvert{Sender: tests.GenerateAddress().String()}.ValidateBasic())
+	suite.Require().NoError(MsgSetAutoConvert{Sender: sdk.AccAddress(tests.GenerateAddress().Bytes()).String()}.ValidateBasic())
+}
+
+func (suite *MsgsTestSuite) TestMsgRetryIBCConversionGetters() {
+	msgInvalid := MsgRetryIBCConversion{}
+	msg := NewMsgRetryIBCConversion(sdk.AccAddress(tests.GenerateAddress().Bytes()), "channel-0", 1)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRetryIBCConversion, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRetryIBCConversion() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		channel    string
+		expectPass bool
+	}{
+		{
+			"invalid sender address",
+			tests.GenerateAddress().String(),
+			"channel-0",
+			false,
+		},
+		{
+			"invalid channel",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"",
+			false,
+		},
+		{
+			"msg retry ibc conversion - pass",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"channel-0",
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRetryIBCConversion{tc.sender, tc.channel, 1}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20WithPermitGetters() {
+	msgInvalid := MsgConvertERC20WithPermit{}
+	msg := NewMsgConvertERC20WithPermit(
+		sdk.NewInt(100),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.NewInt(1),
+		sdk.NewInt(1000),
+		make([]byte, 65),
+		make([]byte, 65),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertERC20WithPermit, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20WithPermit() {
+	sig := make([]byte, 65)
+
+	testCases := []struct {
+		msg           string
+		amount        sdk.Int
+		receiver      string
+		contract      string
+		owner         string
+		relayer       string
+		relayerFee    sdk.Int
+		deadline      sdk.Int
+		permitSig     []byte
+		conversionSig []byte
+		expectPass    bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress{}.String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"negative amount",
+			sdk.NewInt(-100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"invalid receiver address",
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"invalid owner address",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"invalid relayer address",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"relayer fee not lower than the amount",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(100),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"non-positive deadline",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.ZeroInt(),
+			sig,
+			sig,
+			false,
+		},
+		{
+			"invalid permit signature",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig[:64],
+			sig,
+			false,
+		},
+		{
+			"invalid conversion signature",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.NewInt(1),
+			sdk.NewInt(1000),
+			sig,
+			nil,
+			false,
+		},
+		{
+			"msg convert erc20 with permit - pass",
+			sdk.NewInt(100),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.ZeroInt(),
+			sdk.NewInt(1000),
+			sig,
+			sig,
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertERC20WithPermit{
+			tc.contract, tc.amount, tc.receiver, tc.owner, tc.relayer,
+			tc.relayerFee, tc.deadline, tc.permitSig, tc.conversionSig,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestConversionHash() {
+	msg := NewMsgConvertERC20WithPermit(
+		sdk.NewInt(100),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.NewInt(1),
+		sdk.NewInt(1000),
+		nil,
+		nil,
+	)
+
+	hash, err := msg.ConversionHash(big.NewInt(7701), big.NewInt(0))
+	suite.Require().NoError(err)
+
+	again, err := msg.ConversionHash(big.NewInt(7701), big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().Equal(hash, again)
+
+	// the hash is bound to the permit nonce and the chain
+	other, err := msg.ConversionHash(big.NewInt(7701), big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(hash, other)
+
+	other, err = msg.ConversionHash(big.NewInt(7702), big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(hash, other)
+
+	// and to the relayer fee
+	msg.RelayerFee = sdk.NewInt(2)
+	other, err = msg.ConversionHash(big.NewInt(7701), big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(hash, other)
+}
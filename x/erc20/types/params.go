@@ -2,14 +2,24 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 )
 
 // Parameter store key
 var (
 	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
 	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+
+	ParamStoreKeyRegistrationDeposit  = []byte("RegistrationDeposit")
+	ParamStoreKeyInflowFeeBps         = []byte("InflowFeeBps")
+	ParamStoreKeyOutflowFeeBps        = []byte("OutflowFeeBps")
+	ParamStoreKeyFeeRecipient         = []byte("FeeRecipient")
+	ParamStoreKeyAutoRegisterChannels = []byte("AutoRegisterChannels")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -23,17 +33,32 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	registrationDeposit sdk.Coins,
+	inflowFeeBps uint32,
+	outflowFeeBps uint32,
+	feeRecipient string,
+	autoRegisterChannels []string,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:          enableErc20,
+		EnableEVMHook:        enableEVMHook,
+		RegistrationDeposit:  registrationDeposit,
+		InflowFeeBps:         inflowFeeBps,
+		OutflowFeeBps:        outflowFeeBps,
+		FeeRecipient:         feeRecipient,
+		AutoRegisterChannels: autoRegisterChannels,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:   true,
-		EnableEVMHook: true,
+		EnableErc20:          true,
+		EnableEVMHook:        true,
+		RegistrationDeposit:  nil,
+		InflowFeeBps:         0,
+		OutflowFeeBps:        0,
+		FeeRecipient:         authtypes.FeeCollectorName,
+		AutoRegisterChannels: nil,
 	}
 }
 
@@ -46,12 +71,104 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateCoins(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
+func validateFeeBps(i interface{}) error {
+	bps, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if bps > MaxFeeBps {
+		return fmt.Errorf("fee rate cannot be greater than %d basis points: %d", MaxFeeBps, bps)
+	}
+
+	return nil
+}
+
+func validateFeeRecipient(i interface{}) error {
+	recipient, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if recipient != strings.TrimSpace(recipient) {
+		return fmt.Errorf("invalid fee recipient module name '%s'", recipient)
+	}
+
+	return nil
+}
+
+func validateChannels(i interface{}) error {
+	channels, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid auto register channel %s: %w", channel, err)
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicated auto register channel %s", channel)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
+
+// IsAutoRegisterChannel returns true if the vouchers received on the channel
+// are registered on their first receipt
+func (p Params) IsAutoRegisterChannel(channel string) bool {
+	for _, c := range p.AutoRegisterChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableErc20, &p.EnableErc20, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableEVMHook, &p.EnableEVMHook, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationDeposit, &p.RegistrationDeposit, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyInflowFeeBps, &p.InflowFeeBps, validateFeeBps),
+		paramtypes.NewParamSetPair(ParamStoreKeyOutflowFeeBps, &p.OutflowFeeBps, validateFeeBps),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeRecipient, &p.FeeRecipient, validateFeeRecipient),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoRegisterChannels, &p.AutoRegisterChannels, validateChannels),
 	}
 }
 
-func (p Params) Validate() error { return nil }
+func (p Params) Validate() error {
+	if err := validateCoins(p.RegistrationDeposit); err != nil {
+		return err
+	}
+	if err := validateFeeBps(p.InflowFeeBps); err != nil {
+		return err
+	}
+	if err := validateFeeBps(p.OutflowFeeBps); err != nil {
+		return err
+	}
+	if err := validateFeeRecipient(p.FeeRecipient); err != nil {
+		return err
+	}
+	if err := validateChannels(p.AutoRegisterChannels); err != nil {
+		return err
+	}
+	// the per pair fees are also sent to the recipient
+	if p.FeeRecipient == "" && (p.InflowFeeBps > 0 || p.OutflowFeeBps > 0) {
+		return fmt.Errorf("fee recipient cannot be empty with a positive fee rate")
+	}
+	return nil
+}
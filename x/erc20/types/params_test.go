@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -29,9 +30,49 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, true),
+			NewParams(true, true, sdk.NewCoins(sdk.NewInt64Coin("auptick", 100)), 30, 10, "fee_collector", nil),
 			false,
 		},
+		{
+			"invalid registration deposit",
+			NewParams(true, true, sdk.Coins{{Denom: "auptick", Amount: sdk.NewInt(-1)}}, 0, 0, "fee_collector", nil),
+			true,
+		},
+		{
+			"invalid inflow fee",
+			NewParams(true, true, sdk.Coins{}, MaxFeeBps+1, 0, "fee_collector", nil),
+			true,
+		},
+		{
+			"invalid outflow fee",
+			NewParams(true, true, sdk.Coins{}, 0, MaxFeeBps+1, "fee_collector", nil),
+			true,
+		},
+		{
+			"fee without recipient",
+			NewParams(true, true, sdk.Coins{}, 10, 0, "", nil),
+			true,
+		},
+		{
+			"invalid fee recipient",
+			NewParams(true, true, sdk.Coins{}, 0, 0, " fee_collector", nil),
+			true,
+		},
+		{
+			"valid auto register channels",
+			NewParams(true, true, sdk.Coins{}, 0, 0, "fee_collector", []string{"channel-0", "channel-1"}),
+			false,
+		},
+		{
+			"invalid auto register channel",
+			NewParams(true, true, sdk.Coins{}, 0, 0, "fee_collector", []string{"ch"}),
+			true,
+		},
+		{
+			"duplicated auto register channel",
+			NewParams(true, true, sdk.Coins{}, 0, 0, "fee_collector", []string{"channel-0", "channel-0"}),
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -53,4 +94,19 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(1))
 	suite.Require().NoError(validateBool(true))
+	suite.Require().Error(validateCoins(true))
+	suite.Require().NoError(validateCoins(sdk.Coins{}))
+	suite.Require().Error(validateFeeBps(10))
+	suite.Require().NoError(validateFeeBps(uint32(MaxFeeBps)))
+	suite.Require().Error(validateFeeRecipient(1))
+	suite.Require().NoError(validateFeeRecipient("distribution"))
+	suite.Require().Error(validateChannels("channel-0"))
+	suite.Require().NoError(validateChannels([]string{"channel-0"}))
+}
+
+func (suite *ParamsTestSuite) TestIsAutoRegisterChannel() {
+	params := NewParams(true, true, sdk.Coins{}, 0, 0, "fee_collector", []string{"channel-0"})
+	suite.Require().True(params.IsAutoRegisterChannel("channel-0"))
+	suite.Require().False(params.IsAutoRegisterChannel("channel-1"))
+	suite.Require().False(DefaultParams().IsAutoRegisterChannel("channel-0"))
 }
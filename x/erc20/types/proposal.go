@@ -22,6 +22,11 @@ const (
 	ProposalTypeRegisterERC20        string = "RegisterERC20"
 	ProposalTypeToggleTokenRelay     string = "ToggleTokenRelay" // #nosec
 	ProposalTypeUpdateTokenPairERC20 string = "UpdateTokenPairERC20"
+	ProposalTypeResolveDeposit       string = "ResolveRegistrationDeposit"
+	ProposalTypeUpdateRateLimit      string = "UpdateTokenPairRateLimit"
+	ProposalTypeUpdateConversionFee  string = "UpdateTokenPairConversionFee"
+	ProposalTypeUpdateMetadata       string = "UpdateTokenPairMetadata"
+	ProposalTypeUpdateBehaviours     string = "UpdateTokenPairAllowedBehaviours"
 )
 
 // Implements Proposal Interface
@@ -30,6 +35,11 @@ var (
 	_ v1beta1.Content = &RegisterERC20Proposal{}
 	_ v1beta1.Content = &ToggleTokenRelayProposal{}
 	_ v1beta1.Content = &UpdateTokenPairERC20Proposal{}
+	_ v1beta1.Content = &ResolveRegistrationDepositProposal{}
+	_ v1beta1.Content = &UpdateTokenPairRateLimitProposal{}
+	_ v1beta1.Content = &UpdateTokenPairConversionFeeProposal{}
+	_ v1beta1.Content = &UpdateTokenPairMetadataProposal{}
+	_ v1beta1.Content = &UpdateTokenPairAllowedBehavioursProposal{}
 )
 
 func init() {
@@ -37,10 +47,20 @@ func init() {
 	v1beta1.RegisterProposalType(ProposalTypeRegisterERC20)
 	v1beta1.RegisterProposalType(ProposalTypeToggleTokenRelay)
 	v1beta1.RegisterProposalType(ProposalTypeUpdateTokenPairERC20)
+	v1beta1.RegisterProposalType(ProposalTypeResolveDeposit)
+	v1beta1.RegisterProposalType(ProposalTypeUpdateRateLimit)
+	v1beta1.RegisterProposalType(ProposalTypeUpdateConversionFee)
+	v1beta1.RegisterProposalType(ProposalTypeUpdateMetadata)
+	v1beta1.RegisterProposalType(ProposalTypeUpdateBehaviours)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterCoinProposal{}, "erc20/RegisterCoinProposal",nil)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterERC20Proposal{}, "erc20/RegisterERC20Proposal",nil)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&ToggleTokenRelayProposal{}, "erc20/ToggleTokenRelayProposal",nil)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateTokenPairERC20Proposal{}, "erc20/UpdateTokenPairERC20Proposal",nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&ResolveRegistrationDepositProposal{}, "erc20/ResolveRegistrationDepositProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateTokenPairRateLimitProposal{}, "erc20/UpdateTokenPairRateLimitProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateTokenPairConversionFeeProposal{}, "erc20/UpdateTokenPairConversionFeeProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateTokenPairMetadataProposal{}, "erc20/UpdateTokenPairMetadataProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateTokenPairAllowedBehavioursProposal{}, "erc20/UpdateTokenPairAllowedBehavioursProposal", nil)
 }
 
 // CreateDenomDescription generates a string with the coin description
@@ -219,3 +239,178 @@ func (p UpdateTokenPairERC20Proposal) GetERC20Address() common.Address {
 func (p UpdateTokenPairERC20Proposal) GetNewERC20Address() common.Address {
 	return common.HexToAddress(p.NewErc20Address)
 }
+
+// NewResolveRegistrationDepositProposal returns new instance of ResolveRegistrationDepositProposal
+func NewResolveRegistrationDepositProposal(title, description, erc20Addr string, slash bool) v1beta1.Content {
+	return &ResolveRegistrationDepositProposal{
+		Title:        title,
+		Description:  description,
+		Erc20Address: erc20Addr,
+		Slash:        slash,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*ResolveRegistrationDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*ResolveRegistrationDepositProposal) ProposalType() string {
+	return ProposalTypeResolveDeposit
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *ResolveRegistrationDepositProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(p.Erc20Address); err != nil {
+		return sdkerrors.Wrap(err, "ERC20 address")
+	}
+
+	return v1beta1.ValidateAbstract(p)
+}
+
+// GetERC20Address returns the common.Address representation of the ERC20 hex address
+func (p ResolveRegistrationDepositProposal) GetERC20Address() common.Address {
+	return common.HexToAddress(p.Erc20Address)
+}
+
+// NewUpdateTokenPairRateLimitProposal returns new instance of UpdateTokenPairRateLimitProposal
+func NewUpdateTokenPairRateLimitProposal(title, description, token string, rateLimit RateLimit) v1beta1.Content {
+	return &UpdateTokenPairRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+		RateLimit:   rateLimit,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateTokenPairRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateTokenPairRateLimitProposal) ProposalType() string {
+	return ProposalTypeUpdateRateLimit
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateTokenPairRateLimitProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if err := p.RateLimit.Validate(); err != nil {
+		return err
+	}
+
+	return v1beta1.ValidateAbstract(p)
+}
+
+// NewUpdateTokenPairConversionFeeProposal returns new instance of UpdateTokenPairConversionFeeProposal
+func NewUpdateTokenPairConversionFeeProposal(title, description, token string, conversionFee *ConversionFee) v1beta1.Content {
+	return &UpdateTokenPairConversionFeeProposal{
+		Title:         title,
+		Description:   description,
+		Token:         token,
+		ConversionFee: conversionFee,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateTokenPairConversionFeeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateTokenPairConversionFeeProposal) ProposalType() string {
+	return ProposalTypeUpdateConversionFee
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateTokenPairConversionFeeProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if p.ConversionFee != nil {
+		if err := p.ConversionFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return v1beta1.ValidateAbstract(p)
+}
+
+// NewUpdateTokenPairMetadataProposal returns new instance of UpdateTokenPairMetadataProposal
+func NewUpdateTokenPairMetadataProposal(title, description string, coinMetadata banktypes.Metadata) v1beta1.Content {
+	return &UpdateTokenPairMetadataProposal{
+		Title:       title,
+		Description: description,
+		Metadata:    coinMetadata,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateTokenPairMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateTokenPairMetadataProposal) ProposalType() string {
+	return ProposalTypeUpdateMetadata
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateTokenPairMetadataProposal) ValidateBasic() error {
+	if err := p.Metadata.Validate(); err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(p.Metadata.Base, ibctransfertypes.DenomPrefix+"/") {
+		if err := validateIBC(p.Metadata); err != nil {
+			return err
+		}
+	}
+
+	if _, err := ERC20Decimals(p.Metadata); err != nil {
+		return err
+	}
+
+	return v1beta1.ValidateAbstract(p)
+}
+
+// NewUpdateTokenPairAllowedBehavioursProposal returns new instance of UpdateTokenPairAllowedBehavioursProposal
+func NewUpdateTokenPairAllowedBehavioursProposal(title, description, token string, behaviours []ConversionBehaviour) v1beta1.Content {
+	return &UpdateTokenPairAllowedBehavioursProposal{
+		Title:             title,
+		Description:       description,
+		Token:             token,
+		AllowedBehaviours: behaviours,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateTokenPairAllowedBehavioursProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateTokenPairAllowedBehavioursProposal) ProposalType() string {
+	return ProposalTypeUpdateBehaviours
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateTokenPairAllowedBehavioursProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateBehaviours(p.AllowedBehaviours); err != nil {
+		return err
+	}
+
+	return v1beta1.ValidateAbstract(p)
+}
@@ -6,8 +6,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	length "github.com/cosmos/cosmos-sdk/x/gov/types"
+	length "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/tests"
@@ -84,16 +85,16 @@ func (suite *ProposalTestSuite) TestRegisterERC20Proposal() {
 		expectPass  bool
 	}{
 		// Valid tests
-		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
-		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: true},
+		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, nil, nil, nil}, expectPass: true},
 		// Missing params valid
-		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, nil, nil, nil}, expectPass: false},
 		// Invalid address
-		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
 	}
 
 	for i, tc := range testCases {
@@ -301,3 +302,178 @@ func (suite *ProposalTestSuite) TestUpdateTokenPairERC20ProposalGetERC20Addresse
 	suite.Require().Equal(addr, proposal.GetERC20Address())
 	suite.Require().Equal(addrNew, proposal.GetNewERC20Address())
 }
+
+func (suite *ProposalTestSuite) TestResolveRegistrationDepositProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		erc20Addr   string
+		slash       bool
+		expectPass  bool
+	}{
+		{msg: "resolve registration deposit - refund", title: "test", description: "test desc", erc20Addr: tests.GenerateAddress().String(), slash: false, expectPass: true},
+		{msg: "resolve registration deposit - slash", title: "test", description: "test desc", erc20Addr: tests.GenerateAddress().String(), slash: true, expectPass: true},
+		{msg: "resolve registration deposit - invalid address", title: "test", description: "test desc", erc20Addr: "1x5dCA2483280D9727c80b5518faC4556617fb19F", slash: false, expectPass: false},
+		{msg: "resolve registration deposit - missing title", title: "", description: "test desc", erc20Addr: tests.GenerateAddress().String(), slash: false, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewResolveRegistrationDepositProposal(tc.title, tc.description, tc.erc20Addr, tc.slash)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateTokenPairRateLimitProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		rateLimit   RateLimit
+		expectPass  bool
+	}{
+		{msg: "update token pair rate limit - pass", title: "test", description: "test desc", token: "test", rateLimit: NewRateLimit(sdk.NewInt(100), sdk.NewInt(100), 10), expectPass: true},
+		{msg: "update token pair rate limit - remove", title: "test", description: "test desc", token: tests.GenerateAddress().String(), rateLimit: NewRateLimit(sdk.ZeroInt(), sdk.ZeroInt(), 0), expectPass: true},
+		{msg: "update token pair rate limit - invalid token", title: "test", description: "test desc", token: "(test", rateLimit: NewRateLimit(sdk.NewInt(100), sdk.NewInt(100), 10), expectPass: false},
+		{msg: "update token pair rate limit - invalid rate limit", title: "test", description: "test desc", token: "test", rateLimit: NewRateLimit(sdk.NewInt(100), sdk.NewInt(100), 0), expectPass: false},
+		{msg: "update token pair rate limit - missing title", title: "", description: "test desc", token: "test", rateLimit: NewRateLimit(sdk.NewInt(100), sdk.NewInt(100), 10), expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateTokenPairRateLimitProposal(tc.title, tc.description, tc.token, tc.rateLimit)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateTokenPairConversionFeeProposal() {
+	conversionFee := NewConversionFee(30, 10)
+	invalidConversionFee := NewConversionFee(MaxFeeBps+1, 10)
+
+	testCases := []struct {
+		msg           string
+		title         string
+		description   string
+		token         string
+		conversionFee *ConversionFee
+		expectPass    bool
+	}{
+		{msg: "update token pair conversion fee - pass", title: "test", description: "test desc", token: "test", conversionFee: &conversionFee, expectPass: true},
+		{msg: "update token pair conversion fee - remove", title: "test", description: "test desc", token: tests.GenerateAddress().String(), conversionFee: nil, expectPass: true},
+		{msg: "update token pair conversion fee - invalid token", title: "test", description: "test desc", token: "(test", conversionFee: &conversionFee, expectPass: false},
+		{msg: "update token pair conversion fee - invalid fee", title: "test", description: "test desc", token: "test", conversionFee: &invalidConversionFee, expectPass: false},
+		{msg: "update token pair conversion fee - missing title", title: "", description: "test desc", token: "test", conversionFee: &conversionFee, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateTokenPairConversionFeeProposal(tc.title, tc.description, tc.token, tc.conversionFee)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateTokenPairMetadataProposal() {
+	validMetadata := banktypes.Metadata{
+		Description: "desc",
+		Base:        "acoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    "acoin",
+				Exponent: 0,
+			},
+			{
+				Denom:    "coin",
+				Exponent: uint32(18),
+			},
+		},
+		Name:    "Coin",
+		Symbol:  "COIN",
+		Display: "coin",
+	}
+
+	erc20Metadata := validMetadata
+	erc20Metadata.Base = CreateDenom(tests.GenerateAddress().String())
+	erc20Metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: erc20Metadata.Base, Exponent: 0}}
+	erc20Metadata.Display = erc20Metadata.Base
+
+	invalidDecimalsMetadata := validMetadata
+	invalidDecimalsMetadata.DenomUnits = []*banktypes.DenomUnit{
+		{Denom: "acoin", Exponent: 0},
+		{Denom: "coin", Exponent: 256},
+	}
+
+	validIBCDenom := "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		metadata    banktypes.Metadata
+		expectPass  bool
+	}{
+		{msg: "update token pair metadata - pass", title: "test", description: "test desc", metadata: validMetadata, expectPass: true},
+		{msg: "update token pair metadata - erc20 denom", title: "test", description: "test desc", metadata: erc20Metadata, expectPass: true},
+		{msg: "update token pair metadata - ibc", title: "test", description: "test desc", metadata: createFullMetadata(validIBCDenom, "ibcATOM-14", "ATOM channel-14"), expectPass: true},
+		{msg: "update token pair metadata - ibc invalid symbol", title: "test", description: "test desc", metadata: createFullMetadata(validIBCDenom, "badSymbol", "ATOM channel-14"), expectPass: false},
+		{msg: "update token pair metadata - invalid metadata", title: "test", description: "test desc", metadata: createMetadata("(test", "test"), expectPass: false},
+		{msg: "update token pair metadata - invalid decimals", title: "test", description: "test desc", metadata: invalidDecimalsMetadata, expectPass: false},
+		{msg: "update token pair metadata - missing title", title: "", description: "test desc", metadata: validMetadata, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateTokenPairMetadataProposal(tc.title, tc.description, tc.metadata)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateTokenPairAllowedBehavioursProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		behaviours  []ConversionBehaviour
+		expectPass  bool
+	}{
+		{msg: "update token pair allowed behaviours - pass", title: "test", description: "test desc", token: tests.GenerateAddress().String(), behaviours: []ConversionBehaviour{BEHAVIOUR_ADDITIONAL_TRANSFERS}, expectPass: true},
+		{msg: "update token pair allowed behaviours - remove", title: "test", description: "test desc", token: "test", behaviours: nil, expectPass: true},
+		{msg: "update token pair allowed behaviours - invalid token", title: "test", description: "test desc", token: "(test", behaviours: nil, expectPass: false},
+		{msg: "update token pair allowed behaviours - invalid behaviour", title: "test", description: "test desc", token: "test", behaviours: []ConversionBehaviour{BEHAVIOUR_UNSPECIFIED}, expectPass: false},
+		{msg: "update token pair allowed behaviours - duplicated behaviour", title: "test", description: "test desc", token: "test", behaviours: []ConversionBehaviour{BEHAVIOUR_SUPPLY_CHANGES, BEHAVIOUR_SUPPLY_CHANGES}, expectPass: false},
+		{msg: "update token pair allowed behaviours - missing title", title: "", description: "test desc", token: "test", behaviours: nil, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateTokenPairAllowedBehavioursProposal(tc.title, tc.description, tc.token, tc.behaviours)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
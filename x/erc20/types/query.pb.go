@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -225,6 +226,431 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryPairSupplyRequest is the request type for the Query/PairSupply RPC
+// method.
+type QueryPairSupplyRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryPairSupplyRequest) Reset()         { *m = QueryPairSupplyRequest{} }
+func (m *QueryPairSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPairSupplyRequest) ProtoMessage()    {}
+func (*QueryPairSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{4}
+}
+func (m *QueryPairSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairSupplyRequest.Merge(m, src)
+}
+func (m *QueryPairSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryPairSupplyRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QueryPairSupplyResponse is the response type for the Query/PairSupply RPC
+// method.
+type QueryPairSupplyResponse struct {
+	TokenPair TokenPair  `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+	Supply    PairSupply `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+}
+
+func (m *QueryPairSupplyResponse) Reset()         { *m = QueryPairSupplyResponse{} }
+func (m *QueryPairSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPairSupplyResponse) ProtoMessage()    {}
+func (*QueryPairSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{5}
+}
+func (m *QueryPairSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairSupplyResponse.Merge(m, src)
+}
+func (m *QueryPairSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryPairSupplyResponse) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return TokenPair{}
+}
+
+func (m *QueryPairSupplyResponse) GetSupply() PairSupply {
+	if m != nil {
+		return m.Supply
+	}
+	return PairSupply{}
+}
+
+// QueryEstimateConversionRequest is the request type for the
+// Query/EstimateConversion RPC method.
+type QueryEstimateConversionRequest struct {
+	// token to convert, either the hex contract address of the ERC20 to convert
+	// it to Cosmos coins or the Cosmos base denomination to convert it to ERC20
+	// tokens
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// amount to convert
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryEstimateConversionRequest) Reset()         { *m = QueryEstimateConversionRequest{} }
+func (m *QueryEstimateConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateConversionRequest) ProtoMessage()    {}
+func (*QueryEstimateConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{6}
+}
+func (m *QueryEstimateConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateConversionRequest.Merge(m, src)
+}
+func (m *QueryEstimateConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateConversionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateConversionRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateConversionRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryEstimateConversionRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// QueryEstimateConversionResponse is the response type for the
+// Query/EstimateConversion RPC method.
+type QueryEstimateConversionResponse struct {
+	// fee deducted from the converted amount
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+	// amount received after the fee deduction
+	AmountOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount_out,json=amountOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_out"`
+	// applied fee rate in basis points
+	FeeBps uint32 `protobuf:"varint,3,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	// module account receiving the fee
+	FeeRecipient string `protobuf:"bytes,4,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+func (m *QueryEstimateConversionResponse) Reset()         { *m = QueryEstimateConversionResponse{} }
+func (m *QueryEstimateConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateConversionResponse) ProtoMessage()    {}
+func (*QueryEstimateConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{7}
+}
+func (m *QueryEstimateConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateConversionResponse.Merge(m, src)
+}
+func (m *QueryEstimateConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateConversionResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateConversionResponse) GetFeeBps() uint32 {
+	if m != nil {
+		return m.FeeBps
+	}
+	return 0
+}
+
+func (m *QueryEstimateConversionResponse) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+// QueryAutoConvertRequest is the request type for the Query/AutoConvert RPC
+// method.
+type QueryAutoConvertRequest struct {
+	// bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAutoConvertRequest) Reset()         { *m = QueryAutoConvertRequest{} }
+func (m *QueryAutoConvertRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoConvertRequest) ProtoMessage()    {}
+func (*QueryAutoConvertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{8}
+}
+func (m *QueryAutoConvertRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoConvertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoConvertRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoConvertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoConvertRequest.Merge(m, src)
+}
+func (m *QueryAutoConvertRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoConvertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoConvertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoConvertRequest proto.InternalMessageInfo
+
+func (m *QueryAutoConvertRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAutoConvertResponse is the response type for the Query/AutoConvert RPC
+// method.
+type QueryAutoConvertResponse struct {
+	// false if the account opted out of the auto-conversion
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryAutoConvertResponse) Reset()         { *m = QueryAutoConvertResponse{} }
+func (m *QueryAutoConvertResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoConvertResponse) ProtoMessage()    {}
+func (*QueryAutoConvertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{9}
+}
+func (m *QueryAutoConvertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoConvertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoConvertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoConvertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoConvertResponse.Merge(m, src)
+}
+func (m *QueryAutoConvertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoConvertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoConvertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoConvertResponse proto.InternalMessageInfo
+
+func (m *QueryAutoConvertResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// QueryFailedConversionsRequest is the request type for the
+// Query/FailedConversions RPC method.
+type QueryFailedConversionsRequest struct {
+	// bech32 address of the receiver
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedConversionsRequest) Reset()         { *m = QueryFailedConversionsRequest{} }
+func (m *QueryFailedConversionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedConversionsRequest) ProtoMessage()    {}
+func (*QueryFailedConversionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{10}
+}
+func (m *QueryFailedConversionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedConversionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedConversionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedConversionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedConversionsRequest.Merge(m, src)
+}
+func (m *QueryFailedConversionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedConversionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedConversionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedConversionsRequest proto.InternalMessageInfo
+
+func (m *QueryFailedConversionsRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryFailedConversionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFailedConversionsResponse is the response type for the
+// Query/FailedConversions RPC method.
+type QueryFailedConversionsResponse struct {
+	FailedConversions []FailedConversion `protobuf:"bytes,1,rep,name=failed_conversions,json=failedConversions,proto3" json:"failed_conversions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedConversionsResponse) Reset()         { *m = QueryFailedConversionsResponse{} }
+func (m *QueryFailedConversionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedConversionsResponse) ProtoMessage()    {}
+func (*QueryFailedConversionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8253d6c2765777, []int{11}
+}
+func (m *QueryFailedConversionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedConversionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedConversionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedConversionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedConversionsResponse.Merge(m, src)
+}
+func (m *QueryFailedConversionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedConversionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedConversionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedConversionsResponse proto.InternalMessageInfo
+
+func (m *QueryFailedConversionsResponse) GetFailedConversions() []FailedConversion {
+	if m != nil {
+		return m.FailedConversions
+	}
+	return nil
+}
+
+func (m *QueryFailedConversionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -233,7 +659,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f8253d6c2765777, []int{4}
+	return fileDescriptor_5f8253d6c2765777, []int{12}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +698,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f8253d6c2765777, []int{5}
+	return fileDescriptor_5f8253d6c2765777, []int{13}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,6 +739,14 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "uptick.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "uptick.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "uptick.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryPairSupplyRequest)(nil), "uptick.erc20.v1.QueryPairSupplyRequest")
+	proto.RegisterType((*QueryPairSupplyResponse)(nil), "uptick.erc20.v1.QueryPairSupplyResponse")
+	proto.RegisterType((*QueryEstimateConversionRequest)(nil), "uptick.erc20.v1.QueryEstimateConversionRequest")
+	proto.RegisterType((*QueryEstimateConversionResponse)(nil), "uptick.erc20.v1.QueryEstimateConversionResponse")
+	proto.RegisterType((*QueryAutoConvertRequest)(nil), "uptick.erc20.v1.QueryAutoConvertRequest")
+	proto.RegisterType((*QueryAutoConvertResponse)(nil), "uptick.erc20.v1.QueryAutoConvertResponse")
+	proto.RegisterType((*QueryFailedConversionsRequest)(nil), "uptick.erc20.v1.QueryFailedConversionsRequest")
+	proto.RegisterType((*QueryFailedConversionsResponse)(nil), "uptick.erc20.v1.QueryFailedConversionsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "uptick.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "uptick.erc20.v1.QueryParamsResponse")
 }
@@ -320,39 +754,66 @@ func init() {
 func init() { proto.RegisterFile("uptick/erc20/v1/query.proto", fileDescriptor_5f8253d6c2765777) }
 
 var fileDescriptor_5f8253d6c2765777 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0xb5, 0x34, 0x52, 0x5e, 0x06, 0xa4, 0x23, 0x90, 0x62, 0x8a, 0x5b, 0x99, 0x28, 0x89,
-	0x10, 0xbd, 0x23, 0x41, 0xcc, 0x88, 0x0e, 0x74, 0x41, 0x28, 0x44, 0x30, 0xc0, 0x02, 0x97, 0xe8,
-	0x64, 0xac, 0x10, 0x9f, 0xeb, 0xbb, 0x04, 0x2a, 0xc4, 0x82, 0xc4, 0xc2, 0x84, 0xe0, 0x33, 0xf0,
-	0x5d, 0x3a, 0x56, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x83, 0x54, 0xbe, 0x3b, 0x3b, 0x75, 0x5c, 0xd5,
-	0x9b, 0xfd, 0xde, 0xfb, 0xfd, 0x7b, 0xcf, 0x86, 0x5b, 0xb3, 0x48, 0x05, 0xe3, 0x09, 0xe5, 0xf1,
-	0xb8, 0x7f, 0x9f, 0xce, 0x7b, 0xf4, 0x68, 0xc6, 0xe3, 0x63, 0x12, 0xc5, 0x42, 0x09, 0x7c, 0xd5,
-	0x34, 0x89, 0x6e, 0x92, 0x79, 0xcf, 0xb9, 0x3b, 0x16, 0x72, 0x2a, 0x24, 0x1d, 0x31, 0xc9, 0xcd,
-	0x24, 0x9d, 0xf7, 0x46, 0x5c, 0xb1, 0x1e, 0x8d, 0x98, 0x1f, 0x84, 0x4c, 0x05, 0x22, 0x34, 0x60,
-	0xe7, 0xf6, 0x3a, 0xb3, 0xcf, 0x43, 0x2e, 0x03, 0x69, 0xdb, 0x05, 0x61, 0x23, 0x62, 0x9a, 0x3b,
-	0xbe, 0x10, 0xfe, 0x7b, 0x4e, 0x59, 0x14, 0x50, 0x16, 0x86, 0x42, 0x69, 0xe2, 0x14, 0xda, 0xf0,
-	0x85, 0x2f, 0xf4, 0x23, 0x4d, 0x9e, 0x4c, 0xd5, 0x7b, 0x0b, 0x37, 0x9e, 0x27, 0x8e, 0x5e, 0x88,
-	0x09, 0x0f, 0x07, 0x2c, 0x88, 0xe5, 0x90, 0x1f, 0xcd, 0xb8, 0x54, 0xf8, 0x09, 0xc0, 0xca, 0xdd,
-	0x36, 0xda, 0x43, 0xdd, 0x7a, 0xbf, 0x4d, 0x4c, 0x14, 0x92, 0x44, 0x21, 0x26, 0xb4, 0x8d, 0x42,
-	0x06, 0xcc, 0xe7, 0x16, 0x3b, 0x3c, 0x87, 0xf4, 0x7e, 0x21, 0x68, 0x16, 0x24, 0x64, 0x24, 0x42,
-	0xc9, 0xf1, 0x63, 0xa8, 0xab, 0xa4, 0xfa, 0x26, 0x4a, 0xca, 0xdb, 0x68, 0x6f, 0xb3, 0x5b, 0xef,
-	0x3b, 0x64, 0x6d, 0x81, 0x24, 0x43, 0x1e, 0x5c, 0x39, 0xf9, 0xbb, 0x5b, 0x19, 0x82, 0xca, 0xa8,
-	0xf0, 0x61, 0xce, 0xe6, 0x86, 0xb6, 0xd9, 0x29, 0xb5, 0x69, 0xf4, 0x73, 0x3e, 0xf7, 0xe1, 0x7a,
-	0xde, 0x66, 0xba, 0x88, 0x06, 0x6c, 0x69, 0x3d, 0xbd, 0x83, 0xda, 0xd0, 0xbc, 0x78, 0xaf, 0xd6,
-	0x17, 0x97, 0x85, 0x7a, 0x04, 0xb0, 0x0a, 0x65, 0x17, 0x57, 0x9e, 0xa9, 0x96, 0x65, 0xf2, 0x1a,
-	0x80, 0x35, 0xf5, 0x80, 0xc5, 0x6c, 0x9a, 0xde, 0xc3, 0x7b, 0x0a, 0xd7, 0x72, 0x55, 0xab, 0xf6,
-	0x10, 0xaa, 0x91, 0xae, 0x58, 0xa5, 0x66, 0x41, 0xc9, 0x00, 0xac, 0x8c, 0x1d, 0xee, 0xff, 0xd8,
-	0x84, 0x2d, 0x4d, 0x87, 0xbf, 0x22, 0x80, 0xd5, 0x69, 0x70, 0xa7, 0x80, 0xbf, 0xf8, 0xfb, 0x70,
-	0xba, 0xe5, 0x83, 0xc6, 0xa2, 0xd7, 0xfa, 0xf2, 0xfb, 0xff, 0xcf, 0x0d, 0x17, 0xef, 0xd0, 0xf5,
-	0xaf, 0xf7, 0xdc, 0xf1, 0xf1, 0x37, 0x04, 0xb5, 0x0c, 0x8c, 0xdb, 0x25, 0xec, 0xa9, 0x8b, 0x4e,
-	0xe9, 0x9c, 0x35, 0x71, 0x4f, 0x9b, 0x68, 0xe3, 0xd6, 0x65, 0x26, 0xe8, 0x27, 0xfd, 0xf2, 0x19,
-	0x2b, 0xa8, 0x9a, 0xb5, 0xe1, 0x3b, 0x17, 0x0b, 0xe4, 0x6e, 0xe3, 0xb4, 0x2e, 0x1f, 0xb2, 0x16,
-	0x76, 0xb5, 0x85, 0x9b, 0xb8, 0x59, 0xb0, 0x60, 0x8e, 0x72, 0x70, 0x78, 0xb2, 0x70, 0xd1, 0xe9,
-	0xc2, 0x45, 0xff, 0x16, 0x2e, 0xfa, 0xbe, 0x74, 0x2b, 0xa7, 0x4b, 0xb7, 0xf2, 0x67, 0xe9, 0x56,
-	0x5e, 0xef, 0xfb, 0x81, 0x7a, 0x37, 0x1b, 0x91, 0xb1, 0x98, 0xd2, 0x97, 0x1a, 0xfc, 0x8c, 0xab,
-	0x0f, 0x22, 0x9e, 0xa4, 0x54, 0x1f, 0x2d, 0x99, 0x3a, 0x8e, 0xb8, 0x1c, 0x55, 0xf5, 0xcf, 0xfd,
-	0xe0, 0x2c, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbe, 0x8c, 0xfc, 0xa8, 0x04, 0x00, 0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x49, 0xbb, 0xc9, 0xbe, 0x50, 0xa1, 0x0e, 0xa1, 0x59, 0xdc, 0xd6, 0x09, 0x6e,
+	0x94, 0x6c, 0x51, 0xe3, 0x49, 0xd2, 0x82, 0xc4, 0x09, 0x1a, 0x44, 0x2b, 0x24, 0x28, 0xc5, 0xfc,
+	0x90, 0xe0, 0xb2, 0x78, 0x77, 0xdf, 0x1a, 0x2b, 0x59, 0x8f, 0xeb, 0x19, 0x2f, 0x44, 0x51, 0x2e,
+	0x20, 0x2e, 0x48, 0x48, 0x48, 0x15, 0xe2, 0x2f, 0xe0, 0x06, 0x7f, 0x04, 0xb7, 0x1e, 0x2b, 0x71,
+	0x41, 0x1c, 0x2a, 0x94, 0xf0, 0x07, 0xf0, 0x27, 0x20, 0xcf, 0x8c, 0xbd, 0x9b, 0xf5, 0xfe, 0x08,
+	0x28, 0xa7, 0x78, 0x66, 0xde, 0xf7, 0xbd, 0xcf, 0x7b, 0xf1, 0x7c, 0xbd, 0x70, 0x35, 0x8d, 0x65,
+	0xd8, 0xda, 0x63, 0x98, 0xb4, 0x76, 0xb6, 0x58, 0x6f, 0x9b, 0x3d, 0x4a, 0x31, 0x39, 0x70, 0xe3,
+	0x84, 0x4b, 0x4e, 0x9f, 0xd7, 0x87, 0xae, 0x3a, 0x74, 0x7b, 0xdb, 0xd6, 0x2b, 0x2d, 0x2e, 0xba,
+	0x5c, 0xb0, 0xa6, 0x2f, 0x50, 0x47, 0xb2, 0xde, 0x76, 0x13, 0xa5, 0xbf, 0xcd, 0x62, 0x3f, 0x08,
+	0x23, 0x5f, 0x86, 0x3c, 0xd2, 0x62, 0xeb, 0xfa, 0x70, 0xe6, 0x00, 0x23, 0x14, 0xa1, 0x30, 0xc7,
+	0xa5, 0xc2, 0xba, 0x88, 0x3e, 0xbc, 0x16, 0x70, 0x1e, 0xec, 0x23, 0xf3, 0xe3, 0x90, 0xf9, 0x51,
+	0xc4, 0xa5, 0x4a, 0x9c, 0x4b, 0x97, 0x02, 0x1e, 0x70, 0xf5, 0xc8, 0xb2, 0x27, 0xbd, 0xeb, 0x7c,
+	0x0e, 0x57, 0x3e, 0xc8, 0x88, 0x3e, 0xe2, 0x7b, 0x18, 0x3d, 0xf4, 0xc3, 0x44, 0x78, 0xf8, 0x28,
+	0x45, 0x21, 0xe9, 0x3d, 0x80, 0x3e, 0x5d, 0x8d, 0xac, 0x92, 0xfa, 0xe2, 0xce, 0xba, 0xab, 0x5b,
+	0x71, 0xb3, 0x56, 0x5c, 0xdd, 0xb4, 0x69, 0xc5, 0x7d, 0xe8, 0x07, 0x68, 0xb4, 0xde, 0x80, 0xd2,
+	0xf9, 0x99, 0xc0, 0x72, 0xa9, 0x84, 0x88, 0x79, 0x24, 0x90, 0xde, 0x85, 0x45, 0x99, 0xed, 0x36,
+	0xe2, 0x6c, 0xbb, 0x46, 0x56, 0xe7, 0xea, 0x8b, 0x3b, 0x96, 0x3b, 0x34, 0x40, 0xb7, 0x50, 0xee,
+	0x5e, 0x78, 0xf2, 0x6c, 0x65, 0xc6, 0x03, 0x59, 0xa4, 0xa2, 0xf7, 0x4f, 0x61, 0xce, 0x2a, 0xcc,
+	0x8d, 0xa9, 0x98, 0xba, 0xfe, 0x29, 0xce, 0x4d, 0x78, 0xf1, 0x34, 0x66, 0x3e, 0x88, 0x25, 0xb8,
+	0xa8, 0xea, 0xa9, 0x19, 0x54, 0x3d, 0xbd, 0x70, 0x3e, 0x1d, 0x1e, 0x5c, 0xd1, 0xd4, 0x1b, 0x00,
+	0xfd, 0xa6, 0xcc, 0xe0, 0xa6, 0xf7, 0x54, 0x2d, 0x7a, 0x72, 0x5c, 0x93, 0x3a, 0x5b, 0x7c, 0x98,
+	0xc6, 0xf1, 0xfe, 0xc1, 0x64, 0x94, 0x1f, 0xf3, 0x09, 0x0f, 0x0a, 0xce, 0x09, 0x86, 0xbe, 0x0e,
+	0x15, 0xa1, 0x52, 0x9a, 0xd9, 0x5e, 0x2d, 0x89, 0xfb, 0x55, 0x8d, 0xda, 0x08, 0x9c, 0x07, 0x60,
+	0x2b, 0xac, 0xb7, 0x85, 0x0c, 0xbb, 0xbe, 0xc4, 0xb7, 0x78, 0xd4, 0xc3, 0x44, 0x84, 0x3c, 0x9a,
+	0xd8, 0x0f, 0xbd, 0x02, 0x15, 0xbf, 0xcb, 0xd3, 0x48, 0xaa, 0x92, 0x55, 0xcf, 0xac, 0x9c, 0x7f,
+	0x08, 0xac, 0x8c, 0x4d, 0x68, 0xfa, 0x7d, 0x13, 0xe6, 0x3a, 0x88, 0x3a, 0xdf, 0xae, 0x9b, 0xe1,
+	0xfc, 0xf9, 0x6c, 0x65, 0x3d, 0x08, 0xe5, 0x17, 0x69, 0xd3, 0x6d, 0xf1, 0x2e, 0x33, 0x77, 0x51,
+	0xff, 0xd9, 0x14, 0xed, 0x3d, 0x26, 0x0f, 0x62, 0x14, 0xee, 0x3b, 0x91, 0xf4, 0x32, 0x29, 0x7d,
+	0x0f, 0x40, 0xd7, 0x6b, 0xf0, 0xd4, 0x10, 0xfc, 0xe7, 0x44, 0x55, 0x9d, 0xe1, 0xfd, 0x54, 0xd2,
+	0x65, 0x98, 0xef, 0x20, 0x36, 0x9a, 0xb1, 0xa8, 0xcd, 0xad, 0x92, 0xfa, 0x25, 0xaf, 0xd2, 0x41,
+	0xdc, 0x8d, 0x05, 0xbd, 0x01, 0x97, 0xb2, 0x83, 0x04, 0x5b, 0x61, 0x1c, 0x62, 0x24, 0x6b, 0x17,
+	0x54, 0xb3, 0xcf, 0x75, 0x10, 0xbd, 0x7c, 0xcf, 0xb9, 0x6d, 0xfe, 0xb3, 0x77, 0x53, 0xc9, 0x75,
+	0xb7, 0x32, 0x9f, 0x5d, 0x0d, 0xe6, 0xfd, 0x76, 0x3b, 0x41, 0x21, 0xcc, 0xf4, 0xf2, 0xa5, 0x73,
+	0x07, 0x6a, 0x65, 0x91, 0x99, 0x4f, 0x0d, 0xe6, 0x31, 0xf2, 0x9b, 0xfb, 0xd8, 0x56, 0xaa, 0x05,
+	0x2f, 0x5f, 0x3a, 0xdf, 0x10, 0xb8, 0xae, 0x64, 0xf7, 0xfc, 0x70, 0x1f, 0xdb, 0xfd, 0xd9, 0x16,
+	0x8e, 0x60, 0xc1, 0x42, 0x82, 0x2d, 0x0c, 0x7b, 0x98, 0x98, 0x92, 0xc5, 0x7a, 0xc8, 0x2d, 0x66,
+	0xff, 0xb7, 0x5b, 0xfc, 0x46, 0xc0, 0x1e, 0x47, 0x61, 0x5a, 0xf8, 0x04, 0x68, 0x47, 0x1d, 0x36,
+	0x5a, 0xfd, 0x53, 0xe3, 0x1d, 0x2f, 0x97, 0xde, 0xce, 0xe1, 0x3c, 0xe6, 0x1d, 0xbd, 0xdc, 0x19,
+	0xce, 0x7f, 0x7e, 0x4e, 0xb2, 0x04, 0xd4, 0x5c, 0xc7, 0xc4, 0xef, 0xe6, 0xd3, 0x73, 0xde, 0x85,
+	0x17, 0x4e, 0xed, 0x9a, 0x6e, 0x5e, 0x85, 0x4a, 0xac, 0x76, 0xcc, 0xe5, 0x5c, 0x1e, 0x71, 0xbf,
+	0xb2, 0xe3, 0xfc, 0x6e, 0xe9, 0xe0, 0x9d, 0x9f, 0x16, 0xe0, 0xa2, 0x4a, 0x47, 0xbf, 0x25, 0x00,
+	0x7d, 0x6b, 0xa5, 0x1b, 0x25, 0xfd, 0x68, 0x7f, 0xb7, 0xea, 0xd3, 0x03, 0x35, 0xa2, 0xb3, 0xf6,
+	0xf5, 0xef, 0x7f, 0x3f, 0x9e, 0xb5, 0xe9, 0x35, 0x36, 0xfc, 0xf5, 0x19, 0x30, 0x6f, 0xfa, 0x1d,
+	0x81, 0x6a, 0x21, 0xa6, 0xeb, 0x53, 0xb2, 0xe7, 0x14, 0x1b, 0x53, 0xe3, 0x0c, 0xc4, 0x2d, 0x05,
+	0xb1, 0x4e, 0xd7, 0x26, 0x41, 0xb0, 0x43, 0xb5, 0x38, 0xa2, 0xdf, 0x13, 0x80, 0xbe, 0x2f, 0x8d,
+	0x1b, 0x4a, 0xc9, 0x60, 0xad, 0xfa, 0xf4, 0xc0, 0xa9, 0x3c, 0x19, 0x49, 0x43, 0x5b, 0x60, 0xc1,
+	0xf3, 0x2b, 0x01, 0x5a, 0x76, 0x2d, 0xca, 0x46, 0x97, 0x1b, 0x6b, 0x98, 0xd6, 0xd6, 0xd9, 0x05,
+	0x86, 0xf3, 0x8e, 0xe2, 0x74, 0xe9, 0xad, 0x12, 0x27, 0x1a, 0xd1, 0xc0, 0x35, 0x2a, 0x78, 0x1f,
+	0x13, 0x58, 0x1c, 0xb0, 0x0f, 0x3a, 0x66, 0x2e, 0x65, 0x5b, 0xb2, 0x6e, 0x9e, 0x21, 0xd2, 0xa0,
+	0x31, 0x85, 0x76, 0x93, 0x6e, 0x94, 0xd0, 0xfc, 0x54, 0x72, 0x83, 0x25, 0xd9, 0xa1, 0xf1, 0xb5,
+	0x23, 0xfa, 0x0b, 0x81, 0xcb, 0x25, 0x5f, 0xa0, 0xee, 0xe8, 0x8a, 0xe3, 0x6c, 0xcc, 0x62, 0x67,
+	0x8e, 0x37, 0x9c, 0xaf, 0x29, 0xce, 0x2d, 0xea, 0x96, 0x38, 0xcb, 0x3e, 0xc4, 0x0e, 0x73, 0x4b,
+	0x3c, 0xa2, 0x12, 0x2a, 0xfa, 0xee, 0xd2, 0x1b, 0xe3, 0x5e, 0xab, 0x01, 0x83, 0xb0, 0xd6, 0x26,
+	0x07, 0x19, 0x98, 0x15, 0x05, 0xf3, 0x12, 0x5d, 0x1e, 0xf1, 0xde, 0x29, 0x9f, 0xb8, 0xff, 0xe4,
+	0xd8, 0x26, 0x4f, 0x8f, 0x6d, 0xf2, 0xd7, 0xb1, 0x4d, 0x7e, 0x38, 0xb1, 0x67, 0x9e, 0x9e, 0xd8,
+	0x33, 0x7f, 0x9c, 0xd8, 0x33, 0x9f, 0x6d, 0x0e, 0x7c, 0xbd, 0x3e, 0x56, 0xe2, 0x07, 0x28, 0xbf,
+	0xe4, 0xc9, 0x5e, 0x9e, 0xea, 0x2b, 0x93, 0x4c, 0x7d, 0xc8, 0x9a, 0x15, 0xf5, 0x0b, 0xf1, 0xf6,
+	0xbf, 0x03, 0x00, 0xf5, 0x19, 0x49, 0xa9, 0xed, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -371,6 +832,18 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// Retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// PairSupply retrieves the supplies of both sides of a token pair and the
+	// amounts escrowed by the module
+	PairSupply(ctx context.Context, in *QueryPairSupplyRequest, opts ...grpc.CallOption) (*QueryPairSupplyResponse, error)
+	// EstimateConversion retrieves the fee charged on the conversion of an amount
+	// of a token pair
+	EstimateConversion(ctx context.Context, in *QueryEstimateConversionRequest, opts ...grpc.CallOption) (*QueryEstimateConversionResponse, error)
+	// AutoConvert retrieves whether the coins received over IBC by an account
+	// are converted to ERC20 tokens
+	AutoConvert(ctx context.Context, in *QueryAutoConvertRequest, opts ...grpc.CallOption) (*QueryAutoConvertResponse, error)
+	// FailedConversions retrieves the coins received over IBC by an account
+	// that couldn't be converted
+	FailedConversions(ctx context.Context, in *QueryFailedConversionsRequest, opts ...grpc.CallOption) (*QueryFailedConversionsResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -401,21 +874,69 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/uptick.erc20.v1.Query/Params", in, out, opts...)
+func (c *queryClient) PairSupply(ctx context.Context, in *QueryPairSupplyRequest, opts ...grpc.CallOption) (*QueryPairSupplyResponse, error) {
+	out := new(QueryPairSupplyResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc20.v1.Query/PairSupply", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Retrieves registered token pairs
+func (c *queryClient) EstimateConversion(ctx context.Context, in *QueryEstimateConversionRequest, opts ...grpc.CallOption) (*QueryEstimateConversionResponse, error) {
+	out := new(QueryEstimateConversionResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc20.v1.Query/EstimateConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoConvert(ctx context.Context, in *QueryAutoConvertRequest, opts ...grpc.CallOption) (*QueryAutoConvertResponse, error) {
+	out := new(QueryAutoConvertResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc20.v1.Query/AutoConvert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedConversions(ctx context.Context, in *QueryFailedConversionsRequest, opts ...grpc.CallOption) (*QueryFailedConversionsResponse, error) {
+	out := new(QueryFailedConversionsResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc20.v1.Query/FailedConversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc20.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Retrieves registered token pairs
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// Retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// PairSupply retrieves the supplies of both sides of a token pair and the
+	// amounts escrowed by the module
+	PairSupply(context.Context, *QueryPairSupplyRequest) (*QueryPairSupplyResponse, error)
+	// EstimateConversion retrieves the fee charged on the conversion of an amount
+	// of a token pair
+	EstimateConversion(context.Context, *QueryEstimateConversionRequest) (*QueryEstimateConversionResponse, error)
+	// AutoConvert retrieves whether the coins received over IBC by an account
+	// are converted to ERC20 tokens
+	AutoConvert(context.Context, *QueryAutoConvertRequest) (*QueryAutoConvertResponse, error)
+	// FailedConversions retrieves the coins received over IBC by an account
+	// that couldn't be converted
+	FailedConversions(context.Context, *QueryFailedConversionsRequest) (*QueryFailedConversionsResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -430,6 +951,18 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) PairSupply(ctx context.Context, req *QueryPairSupplyRequest) (*QueryPairSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairSupply not implemented")
+}
+func (*UnimplementedQueryServer) EstimateConversion(ctx context.Context, req *QueryEstimateConversionRequest) (*QueryEstimateConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateConversion not implemented")
+}
+func (*UnimplementedQueryServer) AutoConvert(ctx context.Context, req *QueryAutoConvertRequest) (*QueryAutoConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoConvert not implemented")
+}
+func (*UnimplementedQueryServer) FailedConversions(ctx context.Context, req *QueryFailedConversionsRequest) (*QueryFailedConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedConversions not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -474,6 +1007,78 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PairSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc20.v1.Query/PairSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairSupply(ctx, req.(*QueryPairSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc20.v1.Query/EstimateConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateConversion(ctx, req.(*QueryEstimateConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoConvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc20.v1.Query/AutoConvert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoConvert(ctx, req.(*QueryAutoConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc20.v1.Query/FailedConversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedConversions(ctx, req.(*QueryFailedConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +1109,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "PairSupply",
+			Handler:    _Query_PairSupply_Handler,
+		},
+		{
+			MethodName: "EstimateConversion",
+			Handler:    _Query_EstimateConversion_Handler,
+		},
+		{
+			MethodName: "AutoConvert",
+			Handler:    _Query_AutoConvert_Handler,
+		},
+		{
+			MethodName: "FailedConversions",
+			Handler:    _Query_FailedConversions_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -660,7 +1281,7 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairSupplyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -670,20 +1291,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairSupplyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -693,18 +1321,28 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -716,100 +1354,1378 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEstimateConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryEstimateConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateConversionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.FeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoConvertRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoConvertRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoConvertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokenPairs) > 0 {
-		for _, e := range m.TokenPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoConvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoConvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoConvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedConversionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedConversionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedConversionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedConversionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedConversionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedConversionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedConversions) > 0 {
+		for iNdEx := len(m.FailedConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPairSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPairSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateConversionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.FeeBps))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoConvertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoConvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFailedConversionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedConversionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedConversions) > 0 {
+		for _, e := range m.FailedConversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryTokenPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryTokenPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoConvertRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,17 +2748,17 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoConvertRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoConvertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -852,27 +2768,23 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -895,7 +2807,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoConvertResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -918,51 +2830,17 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoConvertResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -972,28 +2850,12 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1015,7 +2877,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedConversionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1038,15 +2900,15 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedConversionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedConversionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1074,7 +2936,43 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1097,7 +2995,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedConversionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,15 +3018,15 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedConversionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedConversionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedConversions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1155,7 +3053,44 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedConversions = append(m.FailedConversions, FailedConversion{})
+			if err := m.FailedConversions[len(m.FailedConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConversionDirection defines whether a conversion mints/unescrows Cosmos
+// coins or ERC20 tokens
+type ConversionDirection int
+
+const (
+	// Inflow converts ERC20 tokens to Cosmos coins
+	Inflow ConversionDirection = iota
+	// Outflow converts Cosmos coins to ERC20 tokens
+	Outflow
+)
+
+// String implements the Stringer interface
+func (d ConversionDirection) String() string {
+	if d == Inflow {
+		return "inflow"
+	}
+	return "outflow"
+}
+
+// NewRateLimit returns an instance of RateLimit
+func NewRateLimit(inflowQuota, outflowQuota sdk.Int, window uint64) RateLimit {
+	return RateLimit{
+		InflowQuota:  inflowQuota,
+		OutflowQuota: outflowQuota,
+		Window:       window,
+	}
+}
+
+// Validate performs a stateless validation of a RateLimit
+func (rl RateLimit) Validate() error {
+	if rl.InflowQuota.IsNil() || rl.InflowQuota.IsNegative() {
+		return fmt.Errorf("inflow quota cannot be nil or negative: %s", rl.InflowQuota)
+	}
+	if rl.OutflowQuota.IsNil() || rl.OutflowQuota.IsNegative() {
+		return fmt.Errorf("outflow quota cannot be nil or negative: %s", rl.OutflowQuota)
+	}
+	if rl.Window == 0 && !(rl.InflowQuota.IsZero() && rl.OutflowQuota.IsZero()) {
+		return fmt.Errorf("window cannot be zero with a positive quota")
+	}
+	return nil
+}
+
+// IsEnabled returns true if the rate limit restricts any direction
+func (rl RateLimit) IsEnabled() bool {
+	return rl.Window > 0 && (rl.InflowQuota.IsPositive() || rl.OutflowQuota.IsPositive())
+}
+
+// Quota returns the quota of the given direction
+func (rl RateLimit) Quota(direction ConversionDirection) sdk.Int {
+	if direction == Inflow {
+		return rl.InflowQuota
+	}
+	return rl.OutflowQuota
+}
+
+// NewRateLimitFlow returns an empty flow for a window starting at the given
+// height
+func NewRateLimitFlow(windowStart int64) RateLimitFlow {
+	return RateLimitFlow{
+		WindowStart: windowStart,
+		Inflow:      sdk.ZeroInt(),
+		Outflow:     sdk.ZeroInt(),
+	}
+}
+
+// Add returns the flow including the amount converted in the given direction
+func (f RateLimitFlow) Add(direction ConversionDirection, amount sdk.Int) RateLimitFlow {
+	if direction == Inflow {
+		f.Inflow = f.Inflow.Add(amount)
+	} else {
+		f.Outflow = f.Outflow.Add(amount)
+	}
+	return f
+}
+
+// Amount returns the amount converted in the given direction
+func (f RateLimitFlow) Amount(direction ConversionDirection) sdk.Int {
+	if direction == Inflow {
+		return f.Inflow
+	}
+	return f.Outflow
+}
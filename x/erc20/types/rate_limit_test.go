@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitValidate() {
+	testCases := []struct {
+		msg        string
+		rateLimit  RateLimit
+		expectPass bool
+	}{
+		{msg: "rate limit - nil quota", rateLimit: RateLimit{Window: 10}, expectPass: false},
+		{msg: "rate limit - negative inflow quota", rateLimit: NewRateLimit(sdk.NewInt(-1), sdk.ZeroInt(), 10), expectPass: false},
+		{msg: "rate limit - negative outflow quota", rateLimit: NewRateLimit(sdk.ZeroInt(), sdk.NewInt(-1), 10), expectPass: false},
+		{msg: "rate limit - zero window with quota", rateLimit: NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 0), expectPass: false},
+		{msg: "rate limit - disabled", rateLimit: NewRateLimit(sdk.ZeroInt(), sdk.ZeroInt(), 0), expectPass: true},
+		{msg: "rate limit - pass", rateLimit: NewRateLimit(sdk.NewInt(100), sdk.NewInt(50), 10), expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.rateLimit.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitIsEnabled() {
+	suite.Require().False(NewRateLimit(sdk.ZeroInt(), sdk.ZeroInt(), 10).IsEnabled())
+	suite.Require().False(NewRateLimit(sdk.NewInt(100), sdk.ZeroInt(), 0).IsEnabled())
+	suite.Require().True(NewRateLimit(sdk.ZeroInt(), sdk.NewInt(100), 10).IsEnabled())
+
+	rateLimit := NewRateLimit(sdk.NewInt(100), sdk.NewInt(50), 10)
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Quota(Inflow))
+	suite.Require().Equal(sdk.NewInt(50), rateLimit.Quota(Outflow))
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitFlowAdd() {
+	flow := NewRateLimitFlow(5)
+	flow = flow.Add(Inflow, sdk.NewInt(10))
+	flow = flow.Add(Outflow, sdk.NewInt(3))
+	flow = flow.Add(Inflow, sdk.NewInt(5))
+
+	suite.Require().Equal(int64(5), flow.WindowStart)
+	suite.Require().Equal(sdk.NewInt(15), flow.Amount(Inflow))
+	suite.Require().Equal(sdk.NewInt(3), flow.Amount(Outflow))
+}
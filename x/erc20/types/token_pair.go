@@ -40,7 +40,19 @@ func (tp TokenPair) Validate() error {
 		return err
 	}
 
-	return nil
+	if tp.RateLimit != nil {
+		if err := tp.RateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if tp.ConversionFee != nil {
+		if err := tp.ConversionFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateBehaviours(tp.AllowedBehaviours)
 }
 
 // IsNativeCoin returns true if the owner of the ERC20 contract is the
@@ -54,3 +66,8 @@ func (tp TokenPair) IsNativeCoin() bool {
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// HasRateLimit returns true if the conversions of the token pair are limited
+func (tp TokenPair) HasRateLimit() bool {
+	return tp.RateLimit != nil && tp.RateLimit.IsEnabled()
+}
@@ -58,10 +58,14 @@ func (suite *TokenPairTestSuite) TestTokenPair() {
 		pair       TokenPair
 		expectPass bool
 	}{
-		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: false},
+		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, nil, nil, nil}, expectPass: true},
+		{msg: "pass with allowed behaviours", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, nil, nil, []ConversionBehaviour{BEHAVIOUR_ADDITIONAL_TRANSFERS, BEHAVIOUR_SUPPLY_CHANGES}}, expectPass: true},
+		{msg: "invalid behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, nil, nil, []ConversionBehaviour{BEHAVIOUR_UNSPECIFIED}}, expectPass: false},
+		{msg: "unknown behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, nil, nil, []ConversionBehaviour{ConversionBehaviour(10)}}, expectPass: false},
+		{msg: "duplicated behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, nil, nil, []ConversionBehaviour{BEHAVIOUR_ADDITIONAL_TRANSFERS, BEHAVIOUR_ADDITIONAL_TRANSFERS}}, expectPass: false},
 	}
 
 	for i, tc := range testCases {
@@ -100,17 +104,17 @@ func (suite *TokenPairTestSuite) TestIsNativeCoin() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, nil, nil, nil},
 			false,
 		},
 		{
 			"external ERC20 owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, nil, nil, nil},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, nil, nil, nil},
 			true,
 		},
 	}
@@ -133,17 +137,17 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, nil, nil, nil},
 			false,
 		},
 		{
 			"module owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, nil, nil, nil},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, nil, nil, nil},
 			true,
 		},
 	}
@@ -157,3 +161,12 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 		}
 	}
 }
+
+func (suite *TokenPairTestSuite) TestIsBehaviourAllowed() {
+	pair := NewTokenPair(tests.GenerateAddress(), "test", true, OWNER_EXTERNAL)
+	suite.Require().False(pair.IsBehaviourAllowed(BEHAVIOUR_ADDITIONAL_TRANSFERS))
+
+	pair.AllowedBehaviours = []ConversionBehaviour{BEHAVIOUR_ADDITIONAL_TRANSFERS}
+	suite.Require().True(pair.IsBehaviourAllowed(BEHAVIOUR_ADDITIONAL_TRANSFERS))
+	suite.Require().False(pair.IsBehaviourAllowed(BEHAVIOUR_SUPPLY_CHANGES))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -35,3 +36,20 @@ func EqualMetadata(a, b banktypes.Metadata) error {
 	}
 	return fmt.Errorf("metadata provided is different from stored")
 }
+
+// ERC20Decimals returns the decimals of the ERC20 representation of a coin,
+// which is the exponent of its display denomination unit.
+func ERC20Decimals(metadata banktypes.Metadata) (uint8, error) {
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom != metadata.Display {
+			continue
+		}
+
+		if denomUnit.Exponent > math.MaxUint8 {
+			return 0, fmt.Errorf("display denom unit exponent %d cannot be greater than %d", denomUnit.Exponent, math.MaxUint8)
+		}
+		return uint8(denomUnit.Exponent), nil
+	}
+
+	return 0, fmt.Errorf("metadata of %s has no display denom unit %s", metadata.Base, metadata.Display)
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestSanitizeERC20Name(t *testing.T) {
@@ -25,3 +27,69 @@ func TestSanitizeERC20Name(t *testing.T) {
 		require.Equal(t, tc.expErc20Name, name, tc.name)
 	}
 }
+
+func TestERC20Decimals(t *testing.T) {
+	testCases := []struct {
+		name        string
+		metadata    banktypes.Metadata
+		expDecimals uint8
+		expPass     bool
+	}{
+		{
+			"base display",
+			banktypes.Metadata{
+				Base:       "acoin",
+				Display:    "acoin",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: "acoin", Exponent: 0}},
+			},
+			0,
+			true,
+		},
+		{
+			"display exponent",
+			banktypes.Metadata{
+				Base:    "acoin",
+				Display: "coin",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "acoin", Exponent: 0},
+					{Denom: "coin", Exponent: 18},
+				},
+			},
+			18,
+			true,
+		},
+		{
+			"display exponent too large",
+			banktypes.Metadata{
+				Base:    "acoin",
+				Display: "coin",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "acoin", Exponent: 0},
+					{Denom: "coin", Exponent: 256},
+				},
+			},
+			0,
+			false,
+		},
+		{
+			"no display denom unit",
+			banktypes.Metadata{
+				Base:       "acoin",
+				Display:    "coin",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: "acoin", Exponent: 0}},
+			},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		decimals, err := ERC20Decimals(tc.metadata)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expDecimals, decimals, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
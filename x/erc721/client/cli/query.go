@@ -24,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetNFTPairByNFTIDCmd(),
+		GetNFTPairByTokenIDCmd(),
+		GetConvertedNFTsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -98,6 +101,111 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetNFTPairByNFTIDCmd queries the ERC721 token paired with a converted nft
+func GetNFTPairByNFTIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-pair-by-nft [class-id] [nft-id]",
+		Short: "Get the ERC721 token paired with a converted nft",
+		Long:  "Get the ERC721 token paired with a converted nft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNFTPairByNFTIDRequest{
+				ClassId: args[0],
+				NftId:   args[1],
+			}
+
+			res, err := queryClient.NFTPairByNFTID(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetNFTPairByTokenIDCmd queries the nft paired with a converted ERC721 token
+func GetNFTPairByTokenIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-pair-by-token [token] [token-id]",
+		Short: "Get the nft paired with a converted ERC721 token",
+		Long:  "Get the nft paired with a converted ERC721 token, the token can be either the ERC721 contract address or the nft class ID of the token pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNFTPairByTokenIDRequest{
+				Token:   args[0],
+				TokenId: args[1],
+			}
+
+			res, err := queryClient.NFTPairByTokenID(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConvertedNFTsCmd queries the converted nfts of a token pair
+func GetConvertedNFTsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "converted-nfts [token]",
+		Short: "Gets the converted nfts of a token pair",
+		Long:  "Gets the converted nfts of a token pair, the token can be either the ERC721 contract address or the nft class ID of the token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConvertedNFTsRequest{
+				Token:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ConvertedNFTs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "converted nfts")
+	return cmd
+}
+
 // GetParamsCmd queries erc721 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
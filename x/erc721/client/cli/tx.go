@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +20,10 @@ import (
 	"github.com/UptickNetwork/uptick/x/erc721/types"
 )
 
+// FlagUnsafeTransfer sends the converted ERC721 tokens with transferFrom
+// instead of safeTransferFrom
+const FlagUnsafeTransfer = "unsafe-transfer"
+
 // NewTxCmd returns a root CLI command handler for erc721 transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -32,6 +37,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewConvertNFTCmd(),
 		NewConvertERC721Cmd(),
+		NewConvertNFTBatchCmd(),
+		NewConvertERC721BatchCmd(),
 	)
 	return txCmd
 }
@@ -62,10 +69,16 @@ func NewConvertNFTCmd() *cobra.Command {
 				receiver = common.BytesToAddress(sender).Hex()
 			}
 
+			unsafeTransfer, err := cmd.Flags().GetBool(FlagUnsafeTransfer)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgConvertNFT{
-				NftId:    nftID,
-				Receiver: receiver,
-				Sender:   sender.String(),
+				NftId:          nftID,
+				Receiver:       receiver,
+				Sender:         sender.String(),
+				UnsafeTransfer: unsafeTransfer,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -76,6 +89,7 @@ func NewConvertNFTCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagUnsafeTransfer, false, "send the erc721 tokens with transferFrom, skipping the onERC721Received check of contract receivers")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -128,6 +142,109 @@ func NewConvertERC721Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewConvertNFTBatchCmd returns a CLI command handler for converting multiple
+// Cosmos nfts of the same class
+func NewConvertNFTBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-nft-batch [class_id] [nft_ids] [receiver_hex]",
+		Short: "Convert comma-separated Cosmos nfts of a class to erc721. When the receiver [optional] is omitted, the erc721 tokens are transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			classID := args[0]
+			nftIDs := strings.Split(args[1], ",")
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 3 {
+				receiver = args[2]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			unsafeTransfer, err := cmd.Flags().GetBool(FlagUnsafeTransfer)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgConvertNFTBatch{
+				ClassId:        classID,
+				NftIds:         nftIDs,
+				Receiver:       receiver,
+				Sender:         sender.String(),
+				UnsafeTransfer: unsafeTransfer,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagUnsafeTransfer, false, "send the erc721 tokens with transferFrom, skipping the onERC721Received check of contract receivers")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC721BatchCmd returns a CLI command handler for converting
+// multiple erc721 tokens of the same contract
+func NewConvertERC721BatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc721-batch [contract-address] [token_ids] [receiver]",
+		Short: "Convert comma-separated erc721 tokens of a contract to Cosmos nfts. When the receiver [optional] is omitted, the Cosmos nfts are transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid erc721 contract address %w", err)
+			}
+
+			tokenIDs := strings.Split(args[1], ",")
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC721Batch{
+				ContractAddress: contract,
+				TokenIds:        tokenIDs,
+				Receiver:        receiver.String(),
+				Sender:          from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
 func NewRegisterNFTProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -333,3 +450,133 @@ func NewToggleTokenConversionProposalCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewUpdateTokenPairERC721ProposalCmd implements the command to submit an update-token-pair-erc721 proposal
+func NewUpdateTokenPairERC721ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-token-pair-erc721 [erc721_address] [new_erc721_address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit an update token pair ERC721 proposal",
+		Long:    "Submit a proposal to update the ERC721 address of a token pair, returning its escrowed nfts and erc721 tokens to their owners, along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-erc721 <contract-address> <new-contract-address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateTokenPairERC721Proposal(title, description, args[0], args[1])
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewDeregisterTokenPairProposalCmd implements the command to submit a deregister-token-pair proposal
+func NewDeregisterTokenPairProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deregister-nft-token-pair [token]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a deregister token pair proposal",
+		Long:    "Submit a proposal to deregister an erc721 token pair, returning its escrowed nfts and erc721 tokens to their owners, along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal deregister-nft-token-pair <class_or_contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewDeregisterTokenPairProposal(title, description, args[0])
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1auptick", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -10,4 +10,6 @@ var (
 	RegisterNFTProposalHandler           = govclient.NewProposalHandler(cli.NewRegisterNFTProposalCmd)
 	RegisterERC721ProposalHandler        = govclient.NewProposalHandler(cli.NewRegisterERC721ProposalCmd)
 	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd)
+	UpdateTokenPairERC721ProposalHandler = govclient.NewProposalHandler(cli.NewUpdateTokenPairERC721ProposalCmd)
+	DeregisterTokenPairProposalHandler   = govclient.NewProposalHandler(cli.NewDeregisterTokenPairProposalCmd)
 )
@@ -1,6 +1,8 @@
 package erc721
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
@@ -28,6 +30,23 @@ func InitGenesis(
 		k.SetClassMap(ctx, pair.ClassId, id)
 		k.SetERC721Map(ctx, pair.GetERC721Contract(), id)
 	}
+
+	for _, nftPair := range data.NftPairs {
+		pair, found := k.GetTokenPair(ctx, k.GetClassMap(ctx, nftPair.ClassId))
+		if !found {
+			panic(fmt.Errorf("token pair not found for nft class %s", nftPair.ClassId))
+		}
+		k.SetNFTPairByNFTID(ctx, pair, nftPair.NftId, nftPair.TokenId)
+		k.SetNFTPairByTokenID(ctx, pair, nftPair.TokenId, nftPair.NftId)
+	}
+
+	for _, data := range data.NftData {
+		pair, found := k.GetTokenPair(ctx, k.GetClassMap(ctx, data.ClassId))
+		if !found {
+			panic(fmt.Errorf("token pair not found for nft class %s", data.ClassId))
+		}
+		k.SetNFTData(ctx, pair, data)
+	}
 }
 
 // ExportGenesis export module status
@@ -35,5 +54,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		TokenPairs: k.GetTokenPairs(ctx),
+		NftPairs:   k.GetNFTPairs(ctx),
+		NftData:    k.GetAllNFTData(ctx),
 	}
 }
@@ -22,8 +22,8 @@ import (
 	"github.com/UptickNetwork/uptick/x/erc721/types"
 )
 
-// DeployERC20Contract creates and deploys an ERC20 contract on the EVM with the
-// erc20 module account as owner.
+// DeployERC721Contract creates and deploys an ERC721 contract on the EVM with the
+// erc721 module account as owner.
 func (k Keeper) DeployERC721Contract(
 	ctx sdk.Context,
 	class nft.Class,
@@ -38,9 +38,9 @@ func (k Keeper) DeployERC721Contract(
 		return common.Address{}, sdkerrors.Wrapf(types.ErrABIPack, "nft class is invalid %s: %s", class.Id, err.Error())
 	}
 
-	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
-	copy(data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)], contracts.ERC20MinterBurnerDecimalsContract.Bin)
-	copy(data[len(contracts.ERC20MinterBurnerDecimalsContract.Bin):], ctorArgs)
+	data := make([]byte, len(contracts.ERC721PresetMinterPauserAutoIdsContract.Bin)+len(ctorArgs))
+	copy(data[:len(contracts.ERC721PresetMinterPauserAutoIdsContract.Bin)], contracts.ERC721PresetMinterPauserAutoIdsContract.Bin)
+	copy(data[len(contracts.ERC721PresetMinterPauserAutoIdsContract.Bin):], ctorArgs)
 
 	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
 	if err != nil {
@@ -140,7 +140,7 @@ func (k Keeper) QueryERC721Token(
 		return types.ERC721TokenData{}, err
 	}
 
-	if err := erc721.UnpackIntoInterface(&symbolRes, "symbol", res.Ret); err != nil {
+	if err := erc721.UnpackIntoInterface(&uriRes, "tokenURI", res.Ret); err != nil {
 		return types.ERC721TokenData{}, sdkerrors.Wrapf(
 			types.ErrABIUnpack, "failed to unpack uri: %s", err.Error(),
 		)
@@ -173,6 +173,56 @@ func (k Keeper) QueryERC721NextTokenID(
 	return idRes.Value, nil
 }
 
+// QueryERC721Paused returns true if the transfers of the given ERC721 contract
+// are paused
+func (k Keeper) QueryERC721Paused(
+	ctx sdk.Context,
+	contract common.Address,
+) (bool, error) {
+	var pausedRes types.ERC721BoolResponse
+
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+
+	res, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "paused")
+	if err != nil {
+		return false, err
+	}
+
+	if err := erc721.UnpackIntoInterface(&pausedRes, "paused", res.Ret); err != nil {
+		return false, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack paused: %s", err.Error(),
+		)
+	}
+
+	return pausedRes.Value, nil
+}
+
+// QueryERC721HasRole returns true if the account has been granted the role on
+// the given AccessControl ERC721 contract
+func (k Keeper) QueryERC721HasRole(
+	ctx sdk.Context,
+	contract common.Address,
+	role [32]byte,
+	account common.Address,
+) (bool, error) {
+	var roleRes types.ERC721BoolResponse
+
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+
+	res, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "hasRole", role, account)
+	if err != nil {
+		return false, err
+	}
+
+	if err := erc721.UnpackIntoInterface(&roleRes, "hasRole", res.Ret); err != nil {
+		return false, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack hasRole: %s", err.Error(),
+		)
+	}
+
+	return roleRes.Value, nil
+}
+
 // QueryERC721TokenOwner returns the owner of given tokenID
 func (k Keeper) QueryERC721TokenOwner(
 	ctx sdk.Context,
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+// Hooks wrapper struct for erc721 keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. It converts the
+// ERC721 tokens of a registered token pair that are transferred to the module
+// address into their native Cosmos nft, which is sent to the bech32 address of
+// the sender:
+//  - native nft pairs: burn the token and unlock the escrowed nft
+//  - native ERC721 pairs: keep the token escrowed and mint the nft
+func (h Hooks) PostTxProcessing(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	params := h.k.GetParams(ctx)
+	if !params.EnableErc721 || !params.EnableEVMHook {
+		// no error is returned to allow for other post processing txs
+		// to pass
+		return nil
+	}
+
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+
+	for _, log := range receipt.Logs {
+		// ERC721 Transfer events have 4 topics: the event ID, from, to and
+		// tokenId. ERC20 Transfer events share the event ID without the tokenId.
+		if len(log.Topics) != 4 {
+			continue
+		}
+
+		event, err := erc721.EventByID(log.Topics[0])
+		if err != nil {
+			// invalid event for ERC721
+			continue
+		}
+
+		if event.Name != types.ERC721EventTransfer {
+			continue
+		}
+
+		// only transfers to the module address are converted
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		if to != types.ModuleAddress {
+			continue
+		}
+
+		// check that the contract is a registered token pair
+		contract := log.Address
+		if len(h.k.GetERC721Map(ctx, contract)) == 0 {
+			continue
+		}
+
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		receiver := sdk.AccAddress(from.Bytes())
+		tokenID := log.Topics[3].Big().String()
+
+		// reject the transfer if the token can't be converted, as it would
+		// otherwise remain locked on the module address
+		pair, err := h.k.MintingEnabled(ctx, receiver, receiver, contract.String())
+		if err != nil {
+			return err
+		}
+
+		var nftID string
+		switch {
+		case pair.IsNativeNFT():
+			if _, err = h.k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "burn", log.Topics[3].Big()); err != nil {
+				break
+			}
+			nftID, err = h.k.unlockNFT(ctx, pair, tokenID, receiver)
+		case pair.IsNativeERC721():
+			nftID, err = h.k.mintNFT(ctx, pair, tokenID, receiver)
+		default:
+			err = types.ErrUndefinedOwner
+		}
+
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to convert erc721 token %s of %s", tokenID, pair.Erc721Address)
+		}
+
+		ctx.EventManager().EmitEvents(
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeConvertERC721,
+					sdk.NewAttribute(sdk.AttributeKeySender, from.Hex()),
+					sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+					sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+					sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+					sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+					sdk.NewAttribute(types.AttributeKeyERC721TokenID, tokenID),
+				),
+			},
+		)
+	}
+
+	return nil
+}
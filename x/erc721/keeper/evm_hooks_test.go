@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestEvmHooksPostTxProcessing() {
+	var (
+		pair    types.TokenPair
+		tokenID *big.Int
+	)
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func() *ethtypes.Receipt
+		expPass  bool
+		expNFT   string
+	}{
+		{
+			"ok - native nft pair: token burned and nft unlocked",
+			func() *ethtypes.Receipt {
+				pair = suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1")
+				suite.convertNFT(pair, "nft1", suite.address)
+				tokenID = types.CreateTokenID("nft1")
+				return suite.transferERC721(pair.GetERC721Contract(), types.ModuleAddress, tokenID)
+			},
+			true,
+			"nft1",
+		},
+		{
+			"ok - native ERC721 pair: token escrowed and nft minted",
+			func() *ethtypes.Receipt {
+				pair = suite.setupNativeERC721Pair(suite.address, 1)
+				tokenID = big.NewInt(1)
+				return suite.transferERC721(pair.GetERC721Contract(), types.ModuleAddress, tokenID)
+			},
+			true,
+			"", // derived from the token ID
+		},
+		{
+			"ok - unregistered contract is ignored",
+			func() *ethtypes.Receipt {
+				contract := suite.deployERC721()
+				suite.mintERC721(contract, suite.address, 1)
+				pair = types.TokenPair{Erc721Address: contract.String()}
+				tokenID = big.NewInt(1)
+				return suite.transferERC721(contract, types.ModuleAddress, tokenID)
+			},
+			true,
+			"",
+		},
+		{
+			"ok - transfer not sent to the module address is ignored",
+			func() *ethtypes.Receipt {
+				pair = suite.setupNativeERC721Pair(suite.address, 1)
+				tokenID = big.NewInt(1)
+				return suite.transferERC721(pair.GetERC721Contract(), tests.GenerateAddress(), tokenID)
+			},
+			true,
+			"",
+		},
+		{
+			"fail - pair disabled",
+			func() *ethtypes.Receipt {
+				pair = suite.setupNativeERC721Pair(suite.address, 1)
+				pair.Enabled = false
+				suite.app.Erc721Keeper.SetTokenPair(suite.ctx, pair)
+				tokenID = big.NewInt(1)
+				return suite.transferERC721(pair.GetERC721Contract(), types.ModuleAddress, tokenID)
+			},
+			false,
+			"",
+		},
+		{
+			"fail - native nft pair without escrowed nft",
+			func() *ethtypes.Receipt {
+				pair = suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()))
+				tokenID = big.NewInt(1)
+				suite.callERC721(types.ModuleAddress, pair.GetERC721Contract(), "mintWithTokenId", suite.address, tokenID, nftURI)
+				return suite.transferERC721(pair.GetERC721Contract(), types.ModuleAddress, tokenID)
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			receipt := tc.malleate()
+			err := suite.app.Erc721Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			receiver := sdk.AccAddress(suite.address.Bytes())
+			nftID := string(suite.app.Erc721Keeper.GetNFTPairByTokenID(suite.ctx, pair, tokenID.String()))
+
+			switch {
+			case pair.IsNativeNFT():
+				// the token is burned and the nft pair deleted
+				_, err := suite.ownerOf(pair.GetERC721Contract(), tokenID)
+				suite.Require().Error(err)
+				suite.Require().Empty(nftID)
+				suite.Require().Equal(receiver, suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassId, tc.expNFT))
+			case pair.IsNativeERC721() && pair.ClassId != "":
+				owner, err := suite.ownerOf(pair.GetERC721Contract(), tokenID)
+				suite.Require().NoError(err)
+				if owner != types.ModuleAddress {
+					// not converted
+					suite.Require().Empty(nftID)
+					return
+				}
+				expNFTID, err := pair.CreateNFTID(tokenID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expNFTID, nftID)
+				suite.Require().Equal(receiver, suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassId, nftID))
+			default:
+				// unregistered contract
+				suite.Require().Empty(suite.app.Erc721Keeper.GetERC721Map(suite.ctx, pair.GetERC721Contract()))
+				suite.Require().Empty(suite.app.Erc721Keeper.GetNFTPairs(suite.ctx))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"math/big"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -65,6 +66,122 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// NFTPairByNFTID returns the ERC721 token paired with a converted nft
+func (k Keeper) NFTPairByNFTID(c context.Context, req *types.QueryNFTPairByNFTIDRequest) (*types.QueryNFTPairByNFTIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetTokenPair(ctx, k.GetClassMap(ctx, req.ClassId))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with class '%s'", req.ClassId)
+	}
+
+	tokenID := k.GetNFTPairByNFTID(ctx, pair, req.NftId)
+	if len(tokenID) == 0 {
+		return nil, status.Errorf(codes.NotFound, "nft pair with nft '%s'", req.NftId)
+	}
+
+	return &types.QueryNFTPairByNFTIDResponse{
+		ConvertedNft: k.getConvertedNFT(ctx, pair, req.NftId, string(tokenID)),
+	}, nil
+}
+
+// NFTPairByTokenID returns the nft paired with a converted ERC721 token
+func (k Keeper) NFTPairByTokenID(c context.Context, req *types.QueryNFTPairByTokenIDRequest) (*types.QueryNFTPairByTokenIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, req.Token))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	nftID := k.GetNFTPairByTokenID(ctx, pair, req.TokenId)
+	if len(nftID) == 0 {
+		return nil, status.Errorf(codes.NotFound, "nft pair with token id '%s'", req.TokenId)
+	}
+
+	return &types.QueryNFTPairByTokenIDResponse{
+		ConvertedNft: k.getConvertedNFT(ctx, pair, string(nftID), req.TokenId),
+	}, nil
+}
+
+// ConvertedNFTs returns the converted nfts of a token pair
+func (k Keeper) ConvertedNFTs(c context.Context, req *types.QueryConvertedNFTsRequest) (*types.QueryConvertedNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, req.Token))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	var nfts []types.ConvertedNFT
+	store := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByNFTID),
+		pair.GetID(),
+	)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		nfts = append(nfts, k.getConvertedNFT(ctx, pair, string(key), string(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryConvertedNFTsResponse{
+		ConvertedNfts: nfts,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// getConvertedNFT returns a converted nft along with the current holders of
+// its representations. The holders that can't be queried are left empty.
+func (k Keeper) getConvertedNFT(ctx sdk.Context, pair types.TokenPair, nftID, tokenID string) types.ConvertedNFT {
+	converted := types.ConvertedNFT{
+		NftPair: types.NFTPair{
+			ClassId:       pair.ClassId,
+			NftId:         nftID,
+			Erc721Address: pair.Erc721Address,
+			TokenId:       tokenID,
+		},
+	}
+
+	var nftOwner, erc721Owner string
+	if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, nftID); !owner.Empty() {
+		nftOwner = owner.String()
+	}
+	if id, ok := new(big.Int).SetString(tokenID, 10); ok {
+		if owner, err := k.QueryERC721TokenOwner(ctx, pair.GetERC721Contract(), id); err == nil {
+			erc721Owner = owner.Hex()
+		}
+	}
+
+	// the native representation is escrowed by the module while the nft is
+	// converted
+	switch {
+	case pair.IsNativeNFT():
+		converted.Custodian = nftOwner
+		converted.Owner = erc721Owner
+		converted.Escrowed = nftOwner == sdk.AccAddress(types.ModuleAddress.Bytes()).String()
+	case pair.IsNativeERC721():
+		converted.Custodian = erc721Owner
+		converted.Owner = nftOwner
+		converted.Escrowed = erc721Owner == types.ModuleAddress.Hex()
+	}
+
+	return converted
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
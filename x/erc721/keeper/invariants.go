@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+// RegisterInvariants registers all erc721 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrowed-nfts", EscrowedNFTsInvariant(k))
+}
+
+// EscrowedNFTsInvariant checks that the converted nfts are escrowed as
+// expected by the module:
+//  - native nft pairs: the nft is owned by the module account
+//  - native ERC721 pairs: the nft exists and the ERC721 token is owned by the
+//    module address
+func EscrowedNFTsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, nftPair := range k.GetNFTPairs(ctx) {
+			if err := k.checkEscrowedNFT(ctx, nftPair); err != nil {
+				count++
+				msg += fmt.Sprintf("\tnft %s (erc721 token %s): %s\n", nftPair.NftId, nftPair.TokenId, err.Error())
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrowed-nfts",
+			fmt.Sprintf("%d escrowed nft invariants found\n%s", count, msg),
+		), broken
+	}
+}
+
+func (k Keeper) checkEscrowedNFT(ctx sdk.Context, nftPair types.NFTPair) error {
+	id := k.GetClassMap(ctx, nftPair.ClassId)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return fmt.Errorf("no token pair holds the nft")
+	}
+
+	switch {
+	case pair.IsNativeNFT():
+		if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, nftPair.NftId); !owner.Equals(sdk.AccAddress(types.ModuleAddress.Bytes())) {
+			return fmt.Errorf("nft is owned by %s instead of the module account", owner)
+		}
+	case pair.IsNativeERC721():
+		if !k.nftKeeper.HasNFT(ctx, pair.ClassId, nftPair.NftId) {
+			return fmt.Errorf("nft doesn't exist")
+		}
+		tokenID, ok := new(big.Int).SetString(nftPair.TokenId, 10)
+		if !ok {
+			return fmt.Errorf("invalid erc721 token id")
+		}
+		owner, err := k.QueryERC721TokenOwner(ctx, pair.GetERC721Contract(), tokenID)
+		if err != nil {
+			return err
+		}
+		if owner != types.ModuleAddress {
+			return fmt.Errorf("erc721 token is owned by %s instead of the module address", owner)
+		}
+	default:
+		return types.ErrUndefinedOwner
+	}
+
+	return nil
+}
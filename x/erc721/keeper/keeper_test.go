@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/UptickNetwork/uptick/app"
+	"github.com/UptickNetwork/uptick/contracts"
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+const (
+	classID   = "classid"
+	className = "Class Name"
+	classSym  = "CLS"
+	nftURI    = "ipfs://nft"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.Uptick
+	address common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+
+	// consensus key of the block proposer
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(priv.PubKey().Address())
+
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         "uptick_7777-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+
+	suite.address = tests.GenerateAddress()
+	suite.setAccount(suite.address)
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(suite.address.Bytes()), priv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+}
+
+func (suite *KeeperTestSuite) setAccount(address common.Address) {
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(address.Bytes()), nil, 0, 0),
+		CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+}
+
+// deployERC721 deploys an ERC721 contract owned by the suite address
+func (suite *KeeperTestSuite) deployERC721() common.Address {
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract
+
+	ctorArgs, err := erc721.ABI.Pack("", "Token", "TKN", "")
+	suite.Require().NoError(err)
+	data := append(append([]byte{}, erc721.Bin...), ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	_, err = suite.app.Erc721Keeper.CallEVMWithData(suite.ctx, suite.address, nil, data, true)
+	suite.Require().NoError(err)
+
+	return crypto.CreateAddress(suite.address, nonce)
+}
+
+// deployContract deploys a contract that doesn't implement onERC721Received
+func (suite *KeeperTestSuite) deployContract() common.Address {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+
+	ctorArgs, err := erc20.ABI.Pack("", "Coin", "COIN", uint8(18))
+	suite.Require().NoError(err)
+	data := append(append([]byte{}, erc20.Bin...), ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	_, err = suite.app.Erc721Keeper.CallEVMWithData(suite.ctx, suite.address, nil, data, true)
+	suite.Require().NoError(err)
+
+	return crypto.CreateAddress(suite.address, nonce)
+}
+
+// callERC721 calls the ERC721 contract from the given address
+func (suite *KeeperTestSuite) callERC721(from, contract common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+
+	res, err := suite.app.Erc721Keeper.CallEVM(suite.ctx, erc721, from, contract, true, method, args...)
+	suite.Require().NoError(err)
+	return res
+}
+
+// mintERC721 mints an ERC721 token of a contract owned by the suite address
+func (suite *KeeperTestSuite) mintERC721(contract, to common.Address, tokenID int64) {
+	suite.callERC721(suite.address, contract, "mintWithTokenId", to, big.NewInt(tokenID), nftURI)
+}
+
+// transferERC721 transfers an ERC721 token of the suite address and returns
+// the receipt of the transfer
+func (suite *KeeperTestSuite) transferERC721(contract, to common.Address, tokenID *big.Int) *ethtypes.Receipt {
+	res := suite.callERC721(suite.address, contract, "transferFrom", suite.address, to, tokenID)
+	return &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)}
+}
+
+func (suite *KeeperTestSuite) ownerOf(contract common.Address, tokenID *big.Int) (common.Address, error) {
+	return suite.app.Erc721Keeper.QueryERC721TokenOwner(suite.ctx, contract, tokenID)
+}
+
+// setupNativeNFTPair saves an nft class with an nft owned by the given account
+// and registers it
+func (suite *KeeperTestSuite) setupNativeNFTPair(owner sdk.AccAddress, nftIDs ...string) types.TokenPair {
+	class := nft.Class{Id: classID, Name: className, Symbol: classSym}
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(suite.ctx, class))
+	for _, nftID := range nftIDs {
+		err := suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: classID, Id: nftID, Uri: nftURI}, owner)
+		suite.Require().NoError(err)
+	}
+
+	pair, err := suite.app.Erc721Keeper.RegisterNFT(suite.ctx, class)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// setupNativeERC721Pair deploys an ERC721 contract with tokens owned by the
+// given address and registers it
+func (suite *KeeperTestSuite) setupNativeERC721Pair(owner common.Address, tokenIDs ...int64) types.TokenPair {
+	contract := suite.deployERC721()
+	for _, tokenID := range tokenIDs {
+		suite.mintERC721(contract, owner, tokenID)
+	}
+
+	pair, err := suite.app.Erc721Keeper.RegisterERC721(suite.ctx, contract)
+	suite.Require().NoError(err)
+	return *pair
+}
+
+// convertNFT converts an nft of the suite address to its ERC721 token
+func (suite *KeeperTestSuite) convertNFT(pair types.TokenPair, nftID string, receiver common.Address) {
+	msg := types.NewMsgConvertNFT(pair.ClassId, nftID, receiver, sdk.AccAddress(suite.address.Bytes()))
+	_, err := suite.app.Erc721Keeper.ConvertNFT(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+}
+
+// convertERC721 converts an ERC721 token of the suite address to its nft
+func (suite *KeeperTestSuite) convertERC721(pair types.TokenPair, tokenID int64, receiver sdk.AccAddress) {
+	msg := types.NewMsgConvertERC721(big.NewInt(tokenID).String(), receiver, pair.GetERC721Contract(), suite.address)
+	_, err := suite.app.Erc721Keeper.ConvertERC721(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestERC721TokenIDRanges() {
+	suite.SetupTest()
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+	autoOffset := new(big.Int).Lsh(big.NewInt(1), 255)
+
+	contract := suite.deployERC721()
+	suite.mintERC721(contract, suite.address, 0)
+	suite.mintERC721(contract, suite.address, 1)
+
+	// the automatic token ids don't collide with the explicit ones
+	suite.callERC721(suite.address, contract, "mint", suite.address)
+	owner, err := suite.ownerOf(contract, autoOffset)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.address, owner)
+
+	nextTokenID, err := suite.app.Erc721Keeper.QueryERC721NextTokenID(suite.ctx, contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(new(big.Int).Add(autoOffset, big.NewInt(1)).String(), nextTokenID.String())
+
+	// the explicit token ids can't be in the automatic range
+	_, err = suite.app.Erc721Keeper.CallEVM(suite.ctx, erc721, suite.address, contract, true, "mintWithTokenId", suite.address, nextTokenID, nftURI)
+	suite.Require().Error(err)
+
+	// the token ids of native nfts are always below the automatic range
+	for _, nftID := range []string{"1", "nft1", autoOffset.String(), "01"} {
+		suite.Require().Equal(-1, types.CreateTokenID(nftID).Cmp(autoOffset), nftID)
+	}
+	suite.Require().Equal(big.NewInt(1), types.CreateTokenID("1"))
+}
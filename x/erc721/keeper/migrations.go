@@ -1,5 +1,12 @@
 package keeper
 
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -11,3 +18,104 @@ func NewMigrator(keeper Keeper) Migrator {
 		keeper: keeper,
 	}
 }
+
+// Migrate1to2 migrates the store from consensus version 1 to 2:
+//  - set the legacy nft ID scheme of the native ERC721 token pairs, whose nfts
+//    have been minted with the "Cat-<token ID>" IDs
+//  - rewrite the nft pairs, previously keyed by the raw nft ID and token ID, to
+//    be scoped by token pair ID and ERC721 contract respectively
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	tokenPairs := k.GetTokenPairs(ctx)
+
+	for i, pair := range tokenPairs {
+		if pair.IsNativeERC721() && pair.NftIdScheme == types.NFT_ID_SCHEME_UNSPECIFIED {
+			pair.NftIdScheme = types.NFT_ID_SCHEME_LEGACY
+			k.SetTokenPair(ctx, pair)
+			tokenPairs[i] = pair
+		}
+	}
+
+	// collect the legacy mappings from both stores, as a mapping of one
+	// store may have been overwritten by another class holding the same ID
+	type legacyNFTPair struct{ nftID, tokenID string }
+	var legacy []legacyNFTPair
+	seen := make(map[legacyNFTPair]bool)
+
+	nftStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByNFTID)
+	tokenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByTokenID)
+
+	for i, store := range []prefix.Store{nftStore, tokenStore} {
+		var keys [][]byte
+
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			p := legacyNFTPair{nftID: string(iterator.Key()), tokenID: string(iterator.Value())}
+			if i == 1 {
+				// the token store maps the token ID to the nft ID
+				p = legacyNFTPair{nftID: string(iterator.Value()), tokenID: string(iterator.Key())}
+			}
+			if !seen[p] {
+				seen[p] = true
+				legacy = append(legacy, p)
+			}
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	for _, p := range legacy {
+		pair, found := m.resolveTokenPair(ctx, tokenPairs, p.nftID, p.tokenID)
+		if !found {
+			k.Logger(ctx).Error(
+				"dropping nft pair not held by any token pair",
+				"nft-id", p.nftID, "token-id", p.tokenID,
+			)
+			continue
+		}
+
+		k.SetNFTPairByNFTID(ctx, pair, p.nftID, p.tokenID)
+		k.SetNFTPairByTokenID(ctx, pair, p.tokenID, p.nftID)
+	}
+
+	return nil
+}
+
+// resolveTokenPair returns the token pair that holds the given legacy nft pair,
+// preferring the one that escrows it as expected
+func (m Migrator) resolveTokenPair(
+	ctx sdk.Context,
+	tokenPairs []types.TokenPair,
+	nftID, tokenID string,
+) (types.TokenPair, bool) {
+	var (
+		candidate types.TokenPair
+		found     bool
+	)
+
+	for _, pair := range tokenPairs {
+		if !m.keeper.nftKeeper.HasNFT(ctx, pair.ClassId, nftID) {
+			continue
+		}
+
+		nftPair := types.NFTPair{
+			ClassId:       pair.ClassId,
+			NftId:         nftID,
+			Erc721Address: pair.Erc721Address,
+			TokenId:       tokenID,
+		}
+		if err := m.keeper.checkEscrowedNFT(ctx, nftPair); err == nil {
+			return pair, true
+		}
+
+		if !found {
+			candidate, found = pair, true
+		}
+	}
+
+	return candidate, found
+}
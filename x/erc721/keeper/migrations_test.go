@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/UptickNetwork/uptick/x/erc721/keeper"
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	moduleAcc := sdk.AccAddress(types.ModuleAddress.Bytes())
+	owner := sdk.AccAddress(suite.address.Bytes())
+
+	// native nft pair with an escrowed nft
+	nativeNFT := suite.setupNativeNFTPair(moduleAcc, "nft1")
+	nativeNFTTokenID := types.CreateTokenID("nft1").String()
+
+	// another class holding an nft with the same ID, which isn't escrowed
+	other := nft.Class{Id: "otherclass", Name: className, Symbol: classSym}
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(suite.ctx, other))
+	suite.Require().NoError(suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: other.Id, Id: "nft1"}, owner))
+	_, err := suite.app.Erc721Keeper.RegisterNFT(suite.ctx, other)
+	suite.Require().NoError(err)
+
+	// native ERC721 pair registered before the nft ID schemes, with an
+	// escrowed token
+	nativeERC721 := suite.setupNativeERC721Pair(types.ModuleAddress, 1)
+	nativeERC721.NftIdScheme = types.NFT_ID_SCHEME_UNSPECIFIED
+	suite.app.Erc721Keeper.SetTokenPair(suite.ctx, nativeERC721)
+	suite.Require().NoError(suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: nativeERC721.ClassId, Id: "Cat-1"}, owner))
+
+	// legacy mappings, keyed by the raw nft ID and token ID
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	nftStore := prefix.NewStore(store, types.KeyPrefixNFTPairByNFTID)
+	tokenStore := prefix.NewStore(store, types.KeyPrefixNFTPairByTokenID)
+	legacy := map[string]string{
+		"nft1":   nativeNFTTokenID,
+		"Cat-1":  "1",
+		"orphan": "99", // not held by any token pair
+	}
+	for nftID, tokenID := range legacy {
+		nftStore.Set([]byte(nftID), []byte(tokenID))
+		tokenStore.Set([]byte(tokenID), []byte(nftID))
+	}
+
+	err = keeper.NewMigrator(suite.app.Erc721Keeper).Migrate1to2(suite.ctx)
+	suite.Require().NoError(err)
+
+	pair, found := suite.app.Erc721Keeper.GetTokenPair(suite.ctx, nativeERC721.GetID())
+	suite.Require().True(found)
+	suite.Require().Equal(types.NFT_ID_SCHEME_LEGACY, pair.NftIdScheme)
+
+	// the nft IDs of the tokens are still derived as before the migration
+	nftID, err := pair.CreateNFTID(big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal("Cat-1", nftID)
+
+	// the mappings are scoped by the token pair escrowing the nft
+	expNFTPairs := []types.NFTPair{
+		{ClassId: nativeNFT.ClassId, NftId: "nft1", Erc721Address: nativeNFT.Erc721Address, TokenId: nativeNFTTokenID},
+		{ClassId: nativeERC721.ClassId, NftId: "Cat-1", Erc721Address: nativeERC721.Erc721Address, TokenId: "1"},
+	}
+	suite.Require().ElementsMatch(expNFTPairs, suite.app.Erc721Keeper.GetNFTPairs(suite.ctx))
+	suite.Require().Equal("nft1", string(suite.app.Erc721Keeper.GetNFTPairByTokenID(suite.ctx, nativeNFT, nativeNFTTokenID)))
+	suite.Require().Equal("Cat-1", string(suite.app.Erc721Keeper.GetNFTPairByTokenID(suite.ctx, nativeERC721, "1")))
+
+	// the legacy keys are removed
+	for nftID, tokenID := range legacy {
+		suite.Require().False(nftStore.Has([]byte(nftID)))
+		suite.Require().False(tokenStore.Has([]byte(tokenID)))
+	}
+
+	_, broken := keeper.EscrowedNFTsInvariant(suite.app.Erc721Keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
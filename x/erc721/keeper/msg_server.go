@@ -2,14 +2,15 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"github.com/UptickNetwork/uptick/contracts"
 	"github.com/UptickNetwork/uptick/x/erc721/types"
@@ -36,37 +37,31 @@ func (k Keeper) ConvertNFT(
 		return nil, err
 	}
 
-	// Remove token pair if contract is suicided
-	erc721 := common.HexToAddress(pair.Erc721Address)
-	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc721)
-
-	if acc == nil || !acc.IsContract() {
-		k.DeleteTokenPair(ctx, pair)
-		k.Logger(ctx).Debug(
-			"deleting selfdestructed token pair from state",
-			"contract", pair.Erc721Address,
-		)
+	if k.deleteSelfDestructedPair(ctx, pair) {
 		// NOTE: return nil error to persist the changes from the deletion
 		return nil, nil
 	}
 
-	if !k.nftKeeper.HasNFT(ctx, msg.ClassId, msg.NftId) {
-		return nil, sdkerrors.Wrapf(types.ErrNFTNotExist, "nft not exist: %s", msg.NftId)
+	tokenID, err := k.convertNFT(ctx, pair, msg.NftId, receiver, sender, msg.UnsafeTransfer)
+	if err != nil {
+		return nil, err
 	}
 
-	if owner := k.nftKeeper.GetOwner(ctx, msg.ClassId, msg.NftId); !owner.Equals(sender) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.NftId)
-	}
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertNFT,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, msg.ClassId),
+				sdk.NewAttribute(types.AttributeKeyNFTID, msg.NftId),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyERC721TokenID, tokenID),
+			),
+		},
+	)
 
-	// Check ownership and execute conversion
-	switch {
-	case pair.IsNativeNFT():
-		return k.convertNFTNativeNFT(ctx, pair, msg, receiver, sender) // case 1.1
-	case pair.IsNativeERC721():
-		return k.convertNFTNativeERC721(ctx, pair, msg, receiver, sender) // case 2.2
-	default:
-		return nil, types.ErrUndefinedOwner
-	}
+	return &types.MsgConvertNFTResponse{}, nil
 }
 
 // ConvertERC721 converts ERC721 tokens into native Cosmos nft for both
@@ -88,287 +83,477 @@ func (k Keeper) ConvertERC721(
 		return nil, err
 	}
 
-	// Remove token pair if contract is suicided
-	erc721 := common.HexToAddress(pair.Erc721Address)
-	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc721)
+	if k.deleteSelfDestructedPair(ctx, pair) {
+		// NOTE: return nil error to persist the changes from the deletion
+		return nil, nil
+	}
 
-	bigTokenId := new(big.Int)
-	_, err = fmt.Sscan(msg.TokenId, bigTokenId)
+	nftID, err := k.convertERC721(ctx, pair, msg.TokenId, receiver, sender)
 	if err != nil {
-		sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s error scanning value", err)
 		return nil, err
 	}
 
-	fmt.Printf("################### erc721 : %v+,bigTokenId :%v+,ContractAddress %v+ \n", erc721, bigTokenId,msg.ContractAddress)
-	owner, err := k.QueryERC721TokenOwner(ctx, erc721, bigTokenId)
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertERC721,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+				sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyERC721TokenID, msg.TokenId),
+			),
+		},
+	)
+
+	return &types.MsgConvertERC721Response{}, nil
+}
+
+// ConvertNFTBatch converts multiple native Cosmos nfts of the same class into
+// ERC721 tokens. The conversion is atomic: if any nft fails to be converted,
+// none of them is.
+func (k Keeper) ConvertNFTBatch(
+	goCtx context.Context,
+	msg *types.MsgConvertNFTBatch,
+) (
+	*types.MsgConvertNFTBatchResponse, error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	pair, err := k.MintingEnabled(ctx, sender, receiver.Bytes(), msg.ClassId)
 	if err != nil {
 		return nil, err
 	}
-	if owner != sender {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of erc721 token %s", sender, msg.TokenId)
+
+	if k.deleteSelfDestructedPair(ctx, pair) {
+		// NOTE: return nil error to persist the changes from the deletion
+		return nil, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(types.BatchConversionGasPerItem*uint64(len(msg.NftIds)), "erc721 batch conversion")
+
+	tokenIDs := make([]string, len(msg.NftIds))
+	for i, nftID := range msg.NftIds {
+		tokenIDs[i], err = k.convertNFT(ctx, pair, nftID, receiver, sender, msg.UnsafeTransfer)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert nft %s", nftID)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertNFTBatch,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, msg.ClassId),
+				sdk.NewAttribute(types.AttributeKeyNFTIDs, strings.Join(msg.NftIds, ",")),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyERC721TokenIDs, strings.Join(tokenIDs, ",")),
+			),
+		},
+	)
+
+	return &types.MsgConvertNFTBatchResponse{}, nil
+}
+
+// ConvertERC721Batch converts multiple ERC721 tokens of the same contract into
+// native Cosmos nfts. The conversion is atomic: if any token fails to be
+// converted, none of them is.
+func (k Keeper) ConvertERC721Batch(
+	goCtx context.Context,
+	msg *types.MsgConvertERC721Batch,
+) (
+	*types.MsgConvertERC721BatchResponse, error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	pair, err := k.MintingEnabled(ctx, sender.Bytes(), receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	if acc == nil || !acc.IsContract() {
-		k.DeleteTokenPair(ctx, pair)
-		k.Logger(ctx).Debug(
-			"deleting selfdestructed token pair from state",
-			"contract", pair.Erc721Address,
-		)
+	if k.deleteSelfDestructedPair(ctx, pair) {
 		// NOTE: return nil error to persist the changes from the deletion
 		return nil, nil
 	}
 
+	ctx.GasMeter().ConsumeGas(types.BatchConversionGasPerItem*uint64(len(msg.TokenIds)), "erc721 batch conversion")
+
+	nftIDs := make([]string, len(msg.TokenIds))
+	for i, tokenID := range msg.TokenIds {
+		nftIDs[i], err = k.convertERC721(ctx, pair, tokenID, receiver, sender)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert erc721 token %s", tokenID)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertERC721Batch,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+				sdk.NewAttribute(types.AttributeKeyNFTIDs, strings.Join(nftIDs, ",")),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyERC721TokenIDs, strings.Join(msg.TokenIds, ",")),
+			),
+		},
+	)
+
+	return &types.MsgConvertERC721BatchResponse{}, nil
+}
+
+// deleteSelfDestructedPair removes the token pair if its contract is suicided
+// and returns true if the pair was deleted
+func (k Keeper) deleteSelfDestructedPair(ctx sdk.Context, pair types.TokenPair) bool {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, pair.GetERC721Contract())
+	if acc != nil && acc.IsContract() {
+		return false
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	k.Logger(ctx).Debug(
+		"deleting selfdestructed token pair from state",
+		"contract", pair.Erc721Address,
+	)
+	return true
+}
+
+// convertNFT checks the ownership of the given nft and converts it into its
+// ERC721 token, returning the token ID. The token is sent with
+// safeTransferFrom unless unsafeTransfer is set.
+func (k Keeper) convertNFT(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	nftID string,
+	receiver common.Address,
+	sender sdk.AccAddress,
+	unsafeTransfer bool,
+) (string, error) {
+	if !k.nftKeeper.HasNFT(ctx, pair.ClassId, nftID) {
+		return "", sdkerrors.Wrapf(types.ErrNFTNotExist, "nft not exist: %s", nftID)
+	}
+
+	if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, nftID); !owner.Equals(sender) {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+	}
+
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeNFT():
-		return k.convertERC721NativeNFT(ctx, pair, msg, receiver, sender) // case 1.2
+		return k.convertNFTNativeNFT(ctx, pair, nftID, receiver, unsafeTransfer) // case 1.1
 	case pair.IsNativeERC721():
-		return k.convertERC721NativeERC721(ctx, pair, msg, receiver, sender) // case 2.1
+		return k.convertNFTNativeERC721(ctx, pair, nftID, receiver, unsafeTransfer) // case 2.2
 	default:
-		return nil, types.ErrUndefinedOwner
+		return "", types.ErrUndefinedOwner
+	}
+}
+
+// convertERC721 checks the ownership of the given ERC721 token and converts it
+// into its native Cosmos nft, returning the nft ID
+func (k Keeper) convertERC721(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenID string,
+	receiver sdk.AccAddress,
+	sender common.Address,
+) (string, error) {
+	bigTokenID, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid tokenID %s", tokenID)
+	}
+
+	owner, err := k.QueryERC721TokenOwner(ctx, pair.GetERC721Contract(), bigTokenID)
+	if err != nil {
+		return "", err
+	}
+	if owner != sender {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of erc721 token %s", sender, tokenID)
+	}
+
+	// Check ownership and execute conversion
+	switch {
+	case pair.IsNativeNFT():
+		return k.convertERC721NativeNFT(ctx, pair, bigTokenID, sender, receiver) // case 1.2
+	case pair.IsNativeERC721():
+		return k.convertERC721NativeERC721(ctx, pair, bigTokenID, sender, receiver) // case 2.1
+	default:
+		return "", types.ErrUndefinedOwner
 	}
 }
 
 // convertNFTNativeNFT handles the nft conversion for a native Cosmos nft
 // token pair:
 //  - escrow nft on module account
-//  - mint nft and send to receiver
+//  - mint token with the token ID derived from the nft ID and send to receiver
+//    (see transferERC721)
+//  - set the royalty of the nft on the token
 func (k Keeper) convertNFTNativeNFT(
 	ctx sdk.Context,
 	pair types.TokenPair,
-	msg *types.MsgConvertNFT,
+	nftID string,
 	receiver common.Address,
-	sender sdk.AccAddress,
-) (
-	*types.MsgConvertNFTResponse, error,
-) {
+	unsafeTransfer bool,
+) (string, error) {
 	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
 	contract := pair.GetERC721Contract()
 
+	data, found := k.nftKeeper.GetNFT(ctx, pair.ClassId, nftID)
+	if !found {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %s of class %s", nftID, pair.ClassId)
+	}
+
 	// Escrow nft on module account
-	if err := k.nftKeeper.Transfer(ctx, msg.ClassId, msg.NftId, types.ModuleAddress.Bytes()); err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to escrow nft")
+	if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftID, types.ModuleAddress.Bytes()); err != nil {
+		return "", sdkerrors.Wrap(err, "failed to escrow nft")
 	}
 
-	// Get next token id
-	tokenID, err := k.QueryERC721NextTokenID(ctx, contract)
-	if err != nil {
-		return nil, err
+	// Mint token with the token id derived from the nft id and the nft uri
+	// and send to receiver. The token is minted to the module address first
+	// for the receiver to be checked by safeTransferFrom.
+	tokenID := types.CreateTokenID(nftID)
+	mintTo := types.ModuleAddress
+	if unsafeTransfer {
+		mintTo = receiver
+	}
+	if _, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "mintWithTokenId", mintTo, tokenID, data.Uri); err != nil {
+		return "", err
 	}
 
-	// Mint tokens and send to receiver
-	if _, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "mint", receiver); err != nil {
-		return nil, err
+	// Set the royalty of the nft on the token
+	if err := k.setERC721Royalty(ctx, pair, tokenID, data); err != nil {
+		return "", err
 	}
 
-	// set nft pair
-	k.SetNFTPairByNFTID(ctx, msg.NftId, tokenID.String())
-	k.SetNFTPairByTokenID(ctx, tokenID.String(), msg.NftId)
+	if !unsafeTransfer {
+		if _, err := k.transferERC721(ctx, contract, receiver, tokenID, false); err != nil {
+			return "", err
+		}
+	}
 
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeConvertNFT,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(types.AttributeKeyNFTClass, msg.ClassId),
-				sdk.NewAttribute(types.AttributeKeyNFTID, msg.NftId),
-				sdk.NewAttribute(types.AttributeKeyERC721Token, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyERC721TokenID, tokenID.String()),
-			),
-		},
-	)
+	// set nft pair
+	k.SetNFTPairByNFTID(ctx, pair, nftID, tokenID.String())
+	k.SetNFTPairByTokenID(ctx, pair, tokenID.String(), nftID)
 
-	return &types.MsgConvertNFTResponse{}, nil
+	return tokenID.String(), nil
 }
 
 // convertNFTNativeERC721 handles the nft conversion for a native ERC721 token
 // pair:
-//  - escrow nft on module account
-//  - unescrow nft that have been previously escrowed with ConvertERC721 and send to receiver
-//  - burn escrowed nft
+//  - burn nft, keeping its uri hash and data
+//  - unescrow token that have been previously escrowed with ConvertERC721 and
+//    send to receiver (see transferERC721)
 func (k Keeper) convertNFTNativeERC721(
 	ctx sdk.Context,
 	pair types.TokenPair,
-	msg *types.MsgConvertNFT,
+	nftID string,
 	receiver common.Address,
-	sender sdk.AccAddress,
-) (
-	*types.MsgConvertNFTResponse, error,
-) {
-	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+	unsafeTransfer bool,
+) (string, error) {
 	contract := pair.GetERC721Contract()
 
-	// burn nft
-	if err := k.nftKeeper.Burn(ctx, msg.ClassId, msg.NftId); err != nil {
-		return nil, err
+	data, found := k.nftKeeper.GetNFT(ctx, pair.ClassId, nftID)
+	if !found {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %s of class %s", nftID, pair.ClassId)
 	}
 
+	// burn nft and keep its data, which can't be stored on the ERC721 token,
+	// to restore it when the token is converted back
+	if err := k.nftKeeper.Burn(ctx, pair.ClassId, nftID); err != nil {
+		return "", err
+	}
+	k.SetNFTData(ctx, pair, data)
+
 	// query tokenID by given nftID
-	tokenID := string(k.GetNFTPairByNFTID(ctx, msg.NftId))
-	// sender := common.Address{msg.Sender}
+	tokenID := string(k.GetNFTPairByNFTID(ctx, pair, nftID))
+	bigTokenID, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok {
+		return "", sdkerrors.Wrapf(types.ErrInternalTokenPair, "no erc721 token paired with nft %s", nftID)
+	}
 
-	fmt.Printf("################### convertNFTNativeERC721 0 sender %v+ ,receiver %v+, NftId %v+ \n",sender,receiver,msg.NftId)
 	// Unescrow Token and send to receiver
-	res, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "safeTransferFrom",receiver,receiver, msg.NftId)
+	res, err := k.transferERC721(ctx, contract, receiver, bigTokenID, unsafeTransfer)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	// delete nft pair
-	k.DeleteNFTPairByNFTID(ctx, msg.NftId)
-	k.DeleteNFTPairByTokenID(ctx, tokenID)
+	k.DeleteNFTPairByNFTID(ctx, pair, nftID)
+	k.DeleteNFTPairByTokenID(ctx, pair, tokenID)
 
 	// Check for unexpected `Approval` event in logs
 	if err := k.monitorApprovalEvent(res); err != nil {
-		return nil, err
+		return "", err
 	}
 
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeConvertNFT,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(types.AttributeKeyNFTClass, msg.ClassId),
-				sdk.NewAttribute(types.AttributeKeyNFTID, msg.NftId),
-				sdk.NewAttribute(types.AttributeKeyERC721Token, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyERC721TokenID, tokenID),
-			),
-		},
-	)
-
-	return &types.MsgConvertNFTResponse{}, nil
+	return tokenID, nil
 }
 
 // convertERC721NativeNFT handles the erc721 conversion for a native Cosmos nft
 // token pair:
-//  - burn escrowed nft
+//  - burn token
 //  - unescrow nft that have been previously escrowed
 func (k Keeper) convertERC721NativeNFT(
 	ctx sdk.Context,
 	pair types.TokenPair,
-	msg *types.MsgConvertERC721,
-	receiver sdk.AccAddress,
+	tokenID *big.Int,
 	sender common.Address,
-) (
-	*types.MsgConvertERC721Response, error,
-) {
+	receiver sdk.AccAddress,
+) (string, error) {
 	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
 	contract := pair.GetERC721Contract()
 
-	// Burn escrowed tokens
-	if _, err := k.CallEVM(ctx, erc721, common.HexToAddress(msg.Sender), contract, true, "burn", big.NewInt(0)); err != nil { // TODO
-		return nil, err
+	// Burn token
+	if _, err := k.CallEVM(ctx, erc721, sender, contract, true, "burn", tokenID); err != nil {
+		return "", err
 	}
 
-	// query nftID by given tokenID
-	nftID := string(k.GetNFTPairByTokenID(ctx, msg.TokenId))
-
-	// unlock nft
-	if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftID, receiver); err != nil {
-		return nil, err
-	}
-
-	// delete nft pair
-	k.DeleteNFTPairByNFTID(ctx, nftID)
-	k.DeleteNFTPairByTokenID(ctx, msg.TokenId)
-
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeConvertERC721,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
-				sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
-				sdk.NewAttribute(types.AttributeKeyERC721Token, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyERC721TokenID, msg.TokenId),
-			),
-		},
-	)
-
-	return &types.MsgConvertERC721Response{}, nil
+	return k.unlockNFT(ctx, pair, tokenID.String(), receiver)
 }
 
 // convertERC721NativeERC721 handles the erc721 conversion for a native erc721 token
 // pair:
 //  - escrow tokens on module account
-//  - mint nft to the receiver: nftID: tokenAddress|tokenID
+//  - mint nft to the receiver, with the nftID derived by the pair nft ID scheme
 func (k Keeper) convertERC721NativeERC721(
 	ctx sdk.Context,
 	pair types.TokenPair,
-	msg *types.MsgConvertERC721,
-	receiver sdk.AccAddress,
+	tokenID *big.Int,
 	sender common.Address,
-) (
-	*types.MsgConvertERC721Response, error,
-) {
+	receiver sdk.AccAddress,
+) (string, error) {
 	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
 	contract := pair.GetERC721Contract()
 
-	bigTokenId := new(big.Int)
-	_, err := fmt.Sscan(msg.TokenId, bigTokenId)
+	// Escrow tokens on module account
+	res, err := k.CallEVM(ctx, erc721, sender, contract, true, "safeTransferFrom", sender, types.ModuleAddress, tokenID)
 	if err != nil {
-		sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s error scanning value", err)
-		return nil, err
+		return "", err
 	}
 
-	// Escrow tokens on module account
-	fmt.Printf("################### convertERC721NativeERC721 1 sender %v+ ,receiver %v+, NftId %v+ \n",sender,types.ModuleAddress,msg.TokenId)
-	res, err := k.CallEVM(ctx, erc721, sender, contract, true, "safeTransferFrom", sender,types.ModuleAddress, bigTokenId)
+	nftID, err := k.mintNFT(ctx, pair, tokenID.String(), receiver)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	tokenID, success := big.NewInt(0).SetString(msg.TokenId, 10)
+	// Check for unexpected `Approval` event in logs
+	if err := k.monitorApprovalEvent(res); err != nil {
+		return "", err
+	}
+
+	return nftID, nil
+}
+
+// transferERC721 sends a token held by the module address to the receiver.
+// safeTransferFrom is used unless unsafeTransfer is set, so that contract
+// receivers must implement onERC721Received to accept the token.
+func (k Keeper) transferERC721(
+	ctx sdk.Context,
+	contract common.Address,
+	receiver common.Address,
+	tokenID *big.Int,
+	unsafeTransfer bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+
+	method := "safeTransferFrom"
+	if unsafeTransfer {
+		method = "transferFrom"
+	}
+
+	return k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, method, types.ModuleAddress, receiver, tokenID)
+}
+
+// mintNFT mints the native Cosmos nft representing the given token of a
+// native ERC721 token pair, which has already been escrowed on the module
+// address, with the EIP-2981 royalty of the token and saves the nft pair
+func (k Keeper) mintNFT(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenID string,
+	receiver sdk.AccAddress,
+) (string, error) {
+	contract := pair.GetERC721Contract()
+
+	bigTokenID, success := big.NewInt(0).SetString(tokenID, 10)
 	if !success {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid tokenID")
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid tokenID")
 	}
 
 	// query erc721 token
-	token, err := k.QueryERC721Token(ctx, contract, tokenID)
+	token, err := k.QueryERC721Token(ctx, contract, bigTokenID)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+
+	nftID, err := pair.CreateNFTID(bigTokenID)
+	if err != nil {
+		return "", err
 	}
 
-	// generate nftID
-	// nftID := contract.String() + "|" + msg.TokenId
-	// xxl TODO
-	nftID := "Cat-" + msg.TokenId
 	nft := nft.NFT{
-		ClassId: types.CreateClassID(contract.String()),
+		ClassId: pair.ClassId,
 		Id:      nftID,
 		Uri:     token.URI,
 	}
 
+	// restore the data of an nft that was previously converted to the token
+	if data, found := k.GetNFTData(ctx, pair, nftID); found {
+		nft.UriHash = data.UriHash
+		nft.Data = data.Data
+		k.DeleteNFTData(ctx, pair, nftID)
+	}
+
+	// keep the EIP-2981 royalty of the token on the nft
+	if nft.Data, err = k.nftDataWithERC721Royalty(ctx, pair, bigTokenID, nft.Data); err != nil {
+		return "", err
+	}
+
 	// mint nft
 	if err := k.nftKeeper.Mint(ctx, nft, receiver); err != nil {
-		return nil, err
+		return "", err
 	}
 
 	// save nft pair
-	k.SetNFTPairByNFTID(ctx, nftID, msg.TokenId)
-	k.SetNFTPairByTokenID(ctx, msg.TokenId, nftID)
+	k.SetNFTPairByNFTID(ctx, pair, nftID, tokenID)
+	k.SetNFTPairByTokenID(ctx, pair, tokenID, nftID)
 
-	// Check for unexpected `Approval` event in logs
-	if err := k.monitorApprovalEvent(res); err != nil {
-		return nil, err
+	return nftID, nil
+}
+
+// unlockNFT sends the escrowed native Cosmos nft represented by the given
+// token of a native nft token pair to the receiver and deletes the nft pair
+func (k Keeper) unlockNFT(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenID string,
+	receiver sdk.AccAddress,
+) (string, error) {
+	// query nftID by given tokenID
+	nftID := string(k.GetNFTPairByTokenID(ctx, pair, tokenID))
+	if nftID == "" {
+		return "", sdkerrors.Wrapf(types.ErrInternalTokenPair, "no nft paired with erc721 token %s", tokenID)
 	}
 
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeConvertERC721,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
-				sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
-				sdk.NewAttribute(types.AttributeKeyERC721Token, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyERC721TokenID, msg.TokenId),
-			),
-		},
-	)
+	// unlock nft
+	if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftID, receiver); err != nil {
+		return "", err
+	}
 
-	return &types.MsgConvertERC721Response{}, nil
+	// delete nft pair
+	k.DeleteNFTPairByNFTID(ctx, pair, nftID)
+	k.DeleteNFTPairByTokenID(ctx, pair, tokenID)
+
+	return nftID, nil
 }
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestConvertNFTReceiver() {
+	const (
+		nftID   = "nft1"
+		tokenID = int64(1234567890123)
+	)
+
+	testCases := []struct {
+		name           string
+		nativeERC721   bool
+		contract       bool
+		unsafeTransfer bool
+		expPass        bool
+	}{
+		{"native nft - account receiver", false, false, false, true},
+		{"native nft - account receiver with transferFrom", false, false, true, true},
+		{"native nft - contract receiver without onERC721Received", false, true, false, false},
+		{"native nft - contract receiver with transferFrom", false, true, true, true},
+		{"native erc721 - account receiver", true, false, false, true},
+		{"native erc721 - account receiver with transferFrom", true, false, true, true},
+		{"native erc721 - contract receiver without onERC721Received", true, true, false, false},
+		{"native erc721 - contract receiver with transferFrom", true, true, true, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			var (
+				pair       types.TokenPair
+				convertID  string
+				expTokenID *big.Int
+			)
+			if tc.nativeERC721 {
+				// convert the token to its nft first, escrowing the token on
+				// the module address
+				pair = suite.setupNativeERC721Pair(suite.address, tokenID)
+				suite.convertERC721(pair, tokenID, sender)
+
+				expTokenID = big.NewInt(tokenID)
+				convertID = string(suite.app.Erc721Keeper.GetNFTPairByTokenID(suite.ctx, pair, expTokenID.String()))
+				suite.Require().NotEmpty(convertID)
+			} else {
+				pair = suite.setupNativeNFTPair(sender, nftID)
+				convertID = nftID
+				expTokenID = types.CreateTokenID(nftID)
+			}
+
+			receiver := tests.GenerateAddress()
+			if tc.contract {
+				receiver = suite.deployContract()
+			}
+
+			msg := types.NewMsgConvertNFT(pair.ClassId, convertID, receiver, sender)
+			msg.UnsafeTransfer = tc.unsafeTransfer
+
+			// the state of a failed conversion is reverted
+			cacheCtx, write := suite.ctx.CacheContext()
+			_, err := suite.app.Erc721Keeper.ConvertNFT(sdk.WrapSDKContext(cacheCtx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(sender, suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassId, convertID))
+				return
+			}
+			suite.Require().NoError(err)
+			write()
+
+			// the token is sent from the module address with the uint256 token
+			// id paired with the nft
+			owner, err := suite.ownerOf(pair.GetERC721Contract(), expTokenID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(receiver, owner)
+
+			// the native nft is escrowed, the nft of a native token is burned
+			if tc.nativeERC721 {
+				suite.Require().False(suite.app.NFTKeeper.HasNFT(suite.ctx, pair.ClassId, convertID))
+			} else {
+				moduleAcc := sdk.AccAddress(types.ModuleAddress.Bytes())
+				suite.Require().Equal(moduleAcc, suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassId, convertID))
+			}
+
+			var eventTokenID string
+			for _, event := range cacheCtx.EventManager().Events() {
+				if event.Type != types.EventTypeConvertNFT {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyERC721TokenID {
+						eventTokenID = string(attr.Value)
+					}
+				}
+			}
+			suite.Require().Equal(expTokenID.String(), eventTokenID)
+
+			if !tc.nativeERC721 {
+				suite.Require().Equal(expTokenID.String(), string(suite.app.Erc721Keeper.GetNFTPairByNFTID(suite.ctx, pair, nftID)))
+			}
+		})
+	}
+}
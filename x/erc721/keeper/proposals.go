@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -9,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/nft"
 
+	"github.com/UptickNetwork/uptick/contracts"
 	"github.com/UptickNetwork/uptick/x/erc721/types"
 )
 
@@ -69,6 +72,7 @@ func (k Keeper) RegisterERC721(ctx sdk.Context, contract common.Address) (*types
 	}
 
 	pair := types.NewTokenPair(contract, class.Id, true, types.OWNER_EXTERNAL)
+	pair.NftIdScheme = types.NFT_ID_SCHEME_KECCAK256
 	k.SetTokenPair(ctx, pair)
 	k.SetClassMap(ctx, pair.ClassId, pair.GetID())
 	k.SetERC721Map(ctx, common.HexToAddress(pair.Erc721Address), pair.GetID())
@@ -133,3 +137,183 @@ func (k Keeper) ToggleConversion(ctx sdk.Context, token string) (types.TokenPair
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// UpdateTokenPairERC721 updates the ERC721 contract of a registered token pair.
+// The nfts and ERC721 tokens escrowed for the current contract are returned to
+// their owners, as they can't be converted with the new contract. The new
+// contract of a native nft pair must grant the minter and admin roles to the
+// module address.
+func (k Keeper) UpdateTokenPairERC721(ctx sdk.Context, erc721Addr, newERC721Addr common.Address) (types.TokenPair, error) {
+	id := k.GetERC721Map(ctx, erc721Addr)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token %s not registered", erc721Addr)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", erc721Addr)
+	}
+
+	if k.IsERC721Registered(ctx, newERC721Addr) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairAlreadyExists, "token ERC721 contract already registered: %s", newERC721Addr)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, newERC721Addr)
+	if acc == nil || !acc.IsContract() {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "new ERC721 address %s is not a contract", newERC721Addr)
+	}
+
+	if _, err := k.QueryERC721(ctx, newERC721Addr); err != nil {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "could not get token %s erc721 data", newERC721Addr)
+	}
+
+	// The module mints and pauses the tokens of a module owned contract
+	if pair.IsNativeNFT() {
+		for _, role := range [][32]byte{types.MinterRole, types.DefaultAdminRole} {
+			granted, err := k.QueryERC721HasRole(ctx, newERC721Addr, role, types.ModuleAddress)
+			if err != nil {
+				return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "could not get the roles of token %s", newERC721Addr)
+			}
+			if !granted {
+				return types.TokenPair{}, sdkerrors.Wrapf(
+					sdkerrors.ErrUnauthorized, "module address is not granted role %x on new ERC721 contract %s", role, newERC721Addr,
+				)
+			}
+		}
+	}
+
+	if err := k.returnEscrowedTokens(ctx, pair); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	// Delete old token pair (id is changed because the ERC721 address was modified)
+	k.DeleteTokenPair(ctx, pair)
+	k.DeleteERC721Map(ctx, erc721Addr)
+
+	pair.Erc721Address = newERC721Addr.String()
+	k.SetTokenPair(ctx, pair)
+	k.SetClassMap(ctx, pair.ClassId, pair.GetID())
+	k.SetERC721Map(ctx, newERC721Addr, pair.GetID())
+
+	return pair, nil
+}
+
+// DeregisterTokenPair deletes a registered token pair, returning its escrowed
+// nfts and ERC721 tokens to their owners
+func (k Keeper) DeregisterTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if err := k.returnEscrowedTokens(ctx, pair); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	k.DeleteERC721Map(ctx, pair.GetERC721Contract())
+	k.DeleteClassMap(ctx, pair.ClassId)
+
+	return pair, nil
+}
+
+// returnEscrowedTokens returns the escrowed tokens of a token pair to the
+// owners of their converted representation and deletes the nft pairs and the
+// burned nfts of the token pair:
+//  - native nfts: send the escrowed nfts to the bech32 address of the owners
+//    of the ERC721 tokens and pause the ERC721 contract, whose tokens are no
+//    longer backed by the nfts
+//  - native ERC721: burn the nfts and send the escrowed ERC721 tokens to the
+//    hex address of the owners of the nfts
+func (k Keeper) returnEscrowedTokens(ctx sdk.Context, pair types.TokenPair) error {
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+	contract := pair.GetERC721Contract()
+
+	for _, nftPair := range k.GetNFTPairsByTokenPair(ctx, pair) {
+		tokenID, ok := new(big.Int).SetString(nftPair.TokenId, 10)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInternalTokenPair, "invalid erc721 token id %s", nftPair.TokenId)
+		}
+
+		var event sdk.Event
+		switch {
+		case pair.IsNativeNFT():
+			owner, err := k.QueryERC721TokenOwner(ctx, contract, tokenID)
+			if err != nil {
+				return err
+			}
+
+			receiver := sdk.AccAddress(owner.Bytes())
+			if err := k.nftKeeper.Transfer(ctx, pair.ClassId, nftPair.NftId, receiver); err != nil {
+				return sdkerrors.Wrapf(err, "failed to return nft %s", nftPair.NftId)
+			}
+
+			event = sdk.NewEvent(
+				types.EventTypeReturnNFT,
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+				sdk.NewAttribute(types.AttributeKeyNFTID, nftPair.NftId),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyERC721TokenID, nftPair.TokenId),
+			)
+		case pair.IsNativeERC721():
+			owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, nftPair.NftId)
+			if owner.Empty() {
+				return sdkerrors.Wrapf(types.ErrNFTNotExist, "nft not exist: %s", nftPair.NftId)
+			}
+
+			if err := k.nftKeeper.Burn(ctx, pair.ClassId, nftPair.NftId); err != nil {
+				return err
+			}
+
+			// transferFrom is used as the owner may not be able to receive the
+			// token with safeTransferFrom
+			receiver := common.BytesToAddress(owner.Bytes())
+			if _, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "transferFrom", types.ModuleAddress, receiver, tokenID); err != nil {
+				return sdkerrors.Wrapf(err, "failed to return erc721 token %s", nftPair.TokenId)
+			}
+
+			event = sdk.NewEvent(
+				types.EventTypeReturnERC721,
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+				sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+				sdk.NewAttribute(types.AttributeKeyNFTID, nftPair.NftId),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyERC721TokenID, nftPair.TokenId),
+			)
+		default:
+			return types.ErrUndefinedOwner
+		}
+
+		k.DeleteNFTPairByNFTID(ctx, pair, nftPair.NftId)
+		k.DeleteNFTPairByTokenID(ctx, pair, nftPair.TokenId)
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	k.DeleteNFTDataByTokenPair(ctx, pair)
+
+	if !pair.IsNativeNFT() {
+		return nil
+	}
+
+	paused, err := k.QueryERC721Paused(ctx, contract)
+	if err != nil {
+		return err
+	}
+	if !paused {
+		if _, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "pause"); err != nil {
+			return sdkerrors.Wrapf(err, "failed to pause erc721 contract %s", pair.Erc721Address)
+		}
+	}
+
+	return nil
+}
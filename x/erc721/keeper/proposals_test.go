@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairERC721() {
+	var (
+		pair        types.TokenPair
+		erc721Addr  common.Address
+		newContract common.Address
+	)
+
+	receiver := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				erc721Addr = tests.GenerateAddress()
+				newContract = suite.deployERC721()
+			},
+			false,
+		},
+		{
+			"fail - new contract already registered",
+			func() {
+				pair = suite.setupNativeERC721Pair(suite.address, 1)
+				erc721Addr = pair.GetERC721Contract()
+				newContract = erc721Addr
+			},
+			false,
+		},
+		{
+			"fail - new address is not a contract",
+			func() {
+				pair = suite.setupNativeERC721Pair(suite.address, 1)
+				erc721Addr = pair.GetERC721Contract()
+				newContract = tests.GenerateAddress()
+			},
+			false,
+		},
+		{
+			"fail - native nft pair: module not granted the roles of the new contract",
+			func() {
+				pair = suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1")
+				erc721Addr = pair.GetERC721Contract()
+				newContract = suite.deployERC721()
+			},
+			false,
+		},
+		{
+			"fail - native nft pair: module only granted the minter role",
+			func() {
+				pair = suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1")
+				erc721Addr = pair.GetERC721Contract()
+				newContract = suite.deployERC721()
+				suite.callERC721(suite.address, newContract, "grantRole", types.MinterRole, types.ModuleAddress)
+			},
+			false,
+		},
+		{
+			"fail - native nft pair: module only granted the admin role",
+			func() {
+				pair = suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1")
+				erc721Addr = pair.GetERC721Contract()
+				newContract = suite.deployERC721()
+				suite.callERC721(suite.address, newContract, "grantRole", types.DefaultAdminRole, types.ModuleAddress)
+			},
+			false,
+		},
+		{
+			"ok - native nft pair: module granted the roles of the new contract",
+			func() {
+				pair = suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1")
+				suite.convertNFT(pair, "nft1", receiver)
+				erc721Addr = pair.GetERC721Contract()
+				newContract = suite.deployERC721()
+				suite.callERC721(suite.address, newContract, "grantRole", types.MinterRole, types.ModuleAddress)
+				suite.callERC721(suite.address, newContract, "grantRole", types.DefaultAdminRole, types.ModuleAddress)
+			},
+			true,
+		},
+		{
+			"ok - native ERC721 pair",
+			func() {
+				pair = suite.setupNativeERC721Pair(suite.address, 1)
+				suite.convertERC721(pair, 1, receiver.Bytes())
+				erc721Addr = pair.GetERC721Contract()
+				newContract = suite.deployERC721()
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			updated, err := suite.app.Erc721Keeper.UpdateTokenPairERC721(suite.ctx, erc721Addr, newContract)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(newContract, updated.GetERC721Contract())
+			suite.Require().Equal(pair.ClassId, updated.ClassId)
+			suite.Require().Equal(updated.GetID(), suite.app.Erc721Keeper.GetTokenPairID(suite.ctx, newContract.String()))
+			suite.Require().Equal(updated.GetID(), suite.app.Erc721Keeper.GetTokenPairID(suite.ctx, pair.ClassId))
+			suite.Require().False(suite.app.Erc721Keeper.IsERC721Registered(suite.ctx, erc721Addr))
+			_, found := suite.app.Erc721Keeper.GetTokenPair(suite.ctx, pair.GetID())
+			suite.Require().False(found)
+
+			// the escrowed tokens of the previous contract are returned
+			suite.Require().Empty(suite.app.Erc721Keeper.GetNFTPairsByTokenPair(suite.ctx, pair))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterTokenPair() {
+	testCases := []struct {
+		name     string
+		malleate func() (types.TokenPair, string)
+		expPass  bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() (types.TokenPair, string) {
+				return types.TokenPair{}, classID
+			},
+			false,
+		},
+		{
+			"ok - native nft pair by class",
+			func() (types.TokenPair, string) {
+				pair := suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1")
+				return pair, classID
+			},
+			true,
+		},
+		{
+			"ok - native ERC721 pair by contract",
+			func() (types.TokenPair, string) {
+				pair := suite.setupNativeERC721Pair(suite.address, 1)
+				return pair, pair.Erc721Address
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair, token := tc.malleate()
+
+			deregistered, err := suite.app.Erc721Keeper.DeregisterTokenPair(suite.ctx, token)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(pair, deregistered)
+
+			_, found := suite.app.Erc721Keeper.GetTokenPair(suite.ctx, pair.GetID())
+			suite.Require().False(found)
+			suite.Require().False(suite.app.Erc721Keeper.IsERC721Registered(suite.ctx, pair.GetERC721Contract()))
+			suite.Require().False(suite.app.Erc721Keeper.IsClassRegistered(suite.ctx, pair.ClassId))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReturnEscrowedTokens() {
+	receiver := tests.GenerateAddress()
+
+	suite.Run("native nft pair: nfts returned and contract paused", func() {
+		suite.SetupTest()
+		pair := suite.setupNativeNFTPair(sdk.AccAddress(suite.address.Bytes()), "nft1", "nft2")
+		suite.convertNFT(pair, "nft1", receiver)
+		suite.convertNFT(pair, "nft2", suite.address)
+
+		// the ERC721 token of nft2 is transferred on the EVM
+		tokenID := types.CreateTokenID("nft2")
+		suite.callERC721(suite.address, pair.GetERC721Contract(), "transferFrom", suite.address, receiver, tokenID)
+
+		_, err := suite.app.Erc721Keeper.DeregisterTokenPair(suite.ctx, classID)
+		suite.Require().NoError(err)
+
+		// the nfts are returned to the owners of the ERC721 tokens
+		for _, nftID := range []string{"nft1", "nft2"} {
+			owner := suite.app.NFTKeeper.GetOwner(suite.ctx, classID, nftID)
+			suite.Require().Equal(sdk.AccAddress(receiver.Bytes()), owner, nftID)
+		}
+		suite.Require().Empty(suite.app.Erc721Keeper.GetNFTPairsByTokenPair(suite.ctx, pair))
+
+		paused, err := suite.app.Erc721Keeper.QueryERC721Paused(suite.ctx, pair.GetERC721Contract())
+		suite.Require().NoError(err)
+		suite.Require().True(paused)
+	})
+
+	suite.Run("native ERC721 pair: nfts burned and tokens returned", func() {
+		suite.SetupTest()
+		pair := suite.setupNativeERC721Pair(suite.address, 1, 2)
+		suite.convertERC721(pair, 1, receiver.Bytes())
+		suite.convertERC721(pair, 2, suite.address.Bytes())
+
+		nftID, err := pair.CreateNFTID(big.NewInt(1))
+		suite.Require().NoError(err)
+
+		_, err = suite.app.Erc721Keeper.DeregisterTokenPair(suite.ctx, pair.Erc721Address)
+		suite.Require().NoError(err)
+
+		// the ERC721 tokens are returned to the owners of the nfts
+		suite.Require().False(suite.app.NFTKeeper.HasNFT(suite.ctx, pair.ClassId, nftID))
+		owner, err := suite.ownerOf(pair.GetERC721Contract(), big.NewInt(1))
+		suite.Require().NoError(err)
+		suite.Require().Equal(receiver, owner)
+		owner, err = suite.ownerOf(pair.GetERC721Contract(), big.NewInt(2))
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.address, owner)
+		suite.Require().Empty(suite.app.Erc721Keeper.GetNFTPairsByTokenPair(suite.ctx, pair))
+
+		paused, err := suite.app.Erc721Keeper.QueryERC721Paused(suite.ctx, pair.GetERC721Contract())
+		suite.Require().NoError(err)
+		suite.Require().False(paused)
+	})
+}
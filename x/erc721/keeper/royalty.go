@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/UptickNetwork/uptick/contracts"
+	collectiontypes "github.com/UptickNetwork/uptick/x/collection/types"
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+// QueryERC721SupportsRoyalty returns true if the given ERC721 contract
+// implements the EIP-2981 royalties
+func (k Keeper) QueryERC721SupportsRoyalty(ctx sdk.Context, contract common.Address) bool {
+	var supportsRes types.ERC721BoolResponse
+
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+
+	res, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "supportsInterface", types.InterfaceIDERC2981)
+	if err != nil {
+		return false
+	}
+
+	if err := erc721.UnpackIntoInterface(&supportsRes, "supportsInterface", res.Ret); err != nil {
+		return false
+	}
+
+	return supportsRes.Value
+}
+
+// QueryERC721Royalty returns the EIP-2981 royalty of the given token, in basis
+// points of the sale price. It returns false if the contract doesn't implement
+// EIP-2981 or the token has no royalty.
+func (k Keeper) QueryERC721Royalty(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) (collectiontypes.RoyaltyInfo, bool) {
+	if !k.QueryERC721SupportsRoyalty(ctx, contract) {
+		return collectiontypes.RoyaltyInfo{}, false
+	}
+
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+	salePrice := big.NewInt(collectiontypes.MaxRoyaltyBasisPoints)
+
+	res, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "royaltyInfo", tokenID, salePrice)
+	if err != nil {
+		return collectiontypes.RoyaltyInfo{}, false
+	}
+
+	out, err := erc721.Unpack("royaltyInfo", res.Ret)
+	if err != nil || len(out) != 2 {
+		return collectiontypes.RoyaltyInfo{}, false
+	}
+
+	receiver, ok := out[0].(common.Address)
+	if !ok || receiver == (common.Address{}) {
+		return collectiontypes.RoyaltyInfo{}, false
+	}
+
+	// the royalty of the whole sale price is the royalty in basis points
+	amount, ok := out[1].(*big.Int)
+	if !ok || amount.Cmp(salePrice) > 0 {
+		return collectiontypes.RoyaltyInfo{}, false
+	}
+
+	return collectiontypes.RoyaltyInfo{
+		Receiver:    sdk.AccAddress(receiver.Bytes()).String(),
+		BasisPoints: uint32(amount.Uint64()),
+	}, true
+}
+
+// setERC721Royalty sets the royalty of the given nft, or of its class, on the
+// ERC721 token deployed by the module for a native nft token pair. The
+// contracts deployed before the EIP-2981 support are skipped.
+func (k Keeper) setERC721Royalty(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenID *big.Int,
+	data nft.NFT,
+) error {
+	class, _ := k.nftKeeper.GetClass(ctx, pair.ClassId)
+	royalty, found := collectiontypes.GetRoyalty(k.cdc, class, &data)
+	if !found {
+		return nil
+	}
+
+	contract := pair.GetERC721Contract()
+	if !k.QueryERC721SupportsRoyalty(ctx, contract) {
+		return nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(royalty.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(collectiontypes.ErrInvalidRoyalty, "invalid receiver address (%s)", err)
+	}
+
+	erc721 := contracts.ERC721PresetMinterPauserAutoIdsContract.ABI
+	feeNumerator := new(big.Int).SetUint64(uint64(royalty.BasisPoints))
+
+	_, err = k.CallEVM(ctx, erc721, types.ModuleAddress, contract, true, "setTokenRoyalty", tokenID, common.BytesToAddress(receiver), feeNumerator)
+	return err
+}
+
+// nftDataWithERC721Royalty returns the data of the nft representing the given
+// token of a native ERC721 token pair, with the EIP-2981 royalty of the token
+// if the contract implements it
+func (k Keeper) nftDataWithERC721Royalty(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenID *big.Int,
+	data *codectypes.Any,
+) (*codectypes.Any, error) {
+	royalty, found := k.QueryERC721Royalty(ctx, pair.GetERC721Contract(), tokenID)
+	if !found {
+		return data, nil
+	}
+
+	return collectiontypes.SetNFTDataRoyalty(k.cdc, data, royalty)
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+
+	collectiontypes "github.com/UptickNetwork/uptick/x/collection/types"
+	"github.com/UptickNetwork/uptick/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryERC721Royalty() {
+	var contract common.Address
+	receiver := tests.GenerateAddress()
+	tokenID := big.NewInt(1)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expSupports bool
+		expFound    bool
+		expBps      uint32
+	}{
+		{
+			"contract without EIP-2981",
+			func() {
+				contract = suite.deployContract()
+			},
+			false,
+			false,
+			0,
+		},
+		{
+			"token without royalty",
+			func() {
+				contract = suite.deployERC721()
+				suite.mintERC721(contract, suite.address, tokenID.Int64())
+			},
+			true,
+			false,
+			0,
+		},
+		{
+			"default royalty of the contract",
+			func() {
+				contract = suite.deployERC721()
+				suite.mintERC721(contract, suite.address, tokenID.Int64())
+				suite.callERC721(suite.address, contract, "setDefaultRoyalty", receiver, big.NewInt(250))
+			},
+			true,
+			true,
+			250,
+		},
+		{
+			"token royalty overrides the default royalty",
+			func() {
+				contract = suite.deployERC721()
+				suite.mintERC721(contract, suite.address, tokenID.Int64())
+				suite.callERC721(suite.address, contract, "setDefaultRoyalty", receiver, big.NewInt(250))
+				suite.callERC721(suite.address, contract, "setTokenRoyalty", tokenID, receiver, big.NewInt(500))
+			},
+			true,
+			true,
+			500,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			suite.Require().Equal(tc.expSupports, suite.app.Erc721Keeper.QueryERC721SupportsRoyalty(suite.ctx, contract))
+
+			royalty, found := suite.app.Erc721Keeper.QueryERC721Royalty(suite.ctx, contract, tokenID)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(sdk.AccAddress(receiver.Bytes()).String(), royalty.Receiver)
+				suite.Require().Equal(tc.expBps, royalty.BasisPoints)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertNFTRoyalty() {
+	suite.SetupTest()
+	cdc := suite.app.AppCodec()
+	owner := sdk.AccAddress(suite.address.Bytes())
+
+	classRoyalty := collectiontypes.NewRoyaltyInfo(sdk.AccAddress(tests.GenerateAddress().Bytes()), 250)
+	nftRoyalty := collectiontypes.NewRoyaltyInfo(sdk.AccAddress(tests.GenerateAddress().Bytes()), 500)
+
+	classData, err := codectypes.NewAnyWithValue(&collectiontypes.DenomMetadata{Creator: owner.String(), Royalty: classRoyalty})
+	suite.Require().NoError(err)
+	class := nft.Class{Id: classID, Name: className, Symbol: classSym, Data: classData}
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(suite.ctx, class))
+
+	nftData, err := collectiontypes.SetNFTDataRoyalty(cdc, nil, *nftRoyalty)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: classID, Id: "nft1", Uri: nftURI}, owner))
+	suite.Require().NoError(suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: classID, Id: "nft2", Uri: nftURI, Data: nftData}, owner))
+
+	pair, err := suite.app.Erc721Keeper.RegisterNFT(suite.ctx, class)
+	suite.Require().NoError(err)
+
+	suite.convertNFT(*pair, "nft1", suite.address)
+	suite.convertNFT(*pair, "nft2", suite.address)
+
+	// the nft without royalty gets the royalty of its class
+	royalty, found := suite.app.Erc721Keeper.QueryERC721Royalty(suite.ctx, pair.GetERC721Contract(), types.CreateTokenID("nft1"))
+	suite.Require().True(found)
+	suite.Require().Equal(*classRoyalty, royalty)
+
+	royalty, found = suite.app.Erc721Keeper.QueryERC721Royalty(suite.ctx, pair.GetERC721Contract(), types.CreateTokenID("nft2"))
+	suite.Require().True(found)
+	suite.Require().Equal(*nftRoyalty, royalty)
+}
+
+func (suite *KeeperTestSuite) TestConvertERC721Royalty() {
+	suite.SetupTest()
+	cdc := suite.app.AppCodec()
+	receiver := tests.GenerateAddress()
+
+	pair := suite.setupNativeERC721Pair(suite.address, 1, 2)
+	contract := pair.GetERC721Contract()
+	suite.callERC721(suite.address, contract, "setTokenRoyalty", big.NewInt(1), receiver, big.NewInt(500))
+
+	suite.convertERC721(pair, 1, sdk.AccAddress(suite.address.Bytes()))
+	suite.convertERC721(pair, 2, sdk.AccAddress(suite.address.Bytes()))
+
+	class, found := suite.app.NFTKeeper.GetClass(suite.ctx, pair.ClassId)
+	suite.Require().True(found)
+
+	// the royalty of the token is kept on its nft
+	nftID := string(suite.app.Erc721Keeper.GetNFTPairByTokenID(suite.ctx, pair, "1"))
+	token, found := suite.app.NFTKeeper.GetNFT(suite.ctx, pair.ClassId, nftID)
+	suite.Require().True(found)
+	royalty, found := collectiontypes.GetRoyalty(cdc, class, &token)
+	suite.Require().True(found)
+	suite.Require().Equal(*collectiontypes.NewRoyaltyInfo(sdk.AccAddress(receiver.Bytes()), 500), royalty)
+
+	// a token without royalty gets none
+	nftID = string(suite.app.Erc721Keeper.GetNFTPairByTokenID(suite.ctx, pair, "2"))
+	token, found = suite.app.NFTKeeper.GetNFT(suite.ctx, pair.ClassId, nftID)
+	suite.Require().True(found)
+	_, found = collectiontypes.GetRoyalty(cdc, class, &token)
+	suite.Require().False(found)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -98,6 +99,12 @@ func (k Keeper) SetClassMap(ctx sdk.Context, classID string, id []byte) {
 	store.Set([]byte(classID), id)
 }
 
+// DeleteClassMap deletes the token pair id for the given class
+func (k Keeper) DeleteClassMap(ctx sdk.Context, classID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	store.Delete([]byte(classID))
+}
+
 // IsTokenPairRegistered - check if registered token tokenPair is registered
 func (k Keeper) IsTokenPairRegistered(ctx sdk.Context, id []byte) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
@@ -116,32 +123,136 @@ func (k Keeper) IsClassRegistered(ctx sdk.Context, classID string) bool {
 	return store.Has([]byte(classID))
 }
 
-func (k Keeper) SetNFTPairByNFTID(ctx sdk.Context, nftID string, tokenID string) {
+// SetNFTPairByNFTID stores the ERC721 token ID paired with the given nft of a
+// token pair
+func (k Keeper) SetNFTPairByNFTID(ctx sdk.Context, pair types.TokenPair, nftID string, tokenID string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByNFTID)
-	store.Set([]byte(nftID), []byte(tokenID))
+	store.Set(types.NFTPairByNFTIDKey(pair.GetID(), nftID), []byte(tokenID))
 }
 
-func (k Keeper) GetNFTPairByNFTID(ctx sdk.Context, nftID string) []byte {
+// GetNFTPairByNFTID returns the ERC721 token ID paired with the given nft of a
+// token pair
+func (k Keeper) GetNFTPairByNFTID(ctx sdk.Context, pair types.TokenPair, nftID string) []byte {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByNFTID)
-	return store.Get([]byte(nftID))
+	return store.Get(types.NFTPairByNFTIDKey(pair.GetID(), nftID))
 }
 
-func (k Keeper) DeleteNFTPairByNFTID(ctx sdk.Context, nftID string) {
+// DeleteNFTPairByNFTID removes the ERC721 token ID paired with the given nft of
+// a token pair
+func (k Keeper) DeleteNFTPairByNFTID(ctx sdk.Context, pair types.TokenPair, nftID string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByNFTID)
-	store.Delete([]byte(nftID))
+	store.Delete(types.NFTPairByNFTIDKey(pair.GetID(), nftID))
 }
 
-func (k Keeper) SetNFTPairByTokenID(ctx sdk.Context, tokenID string, nftID string) {
+// SetNFTPairByTokenID stores the nft ID paired with the given ERC721 token of a
+// token pair
+func (k Keeper) SetNFTPairByTokenID(ctx sdk.Context, pair types.TokenPair, tokenID string, nftID string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByTokenID)
-	store.Set([]byte(tokenID), []byte(nftID))
+	store.Set(types.NFTPairByTokenIDKey(pair.GetERC721Contract(), tokenID), []byte(nftID))
 }
 
-func (k Keeper) GetNFTPairByTokenID(ctx sdk.Context, tokenID string) []byte {
+// GetNFTPairByTokenID returns the nft ID paired with the given ERC721 token of
+// a token pair
+func (k Keeper) GetNFTPairByTokenID(ctx sdk.Context, pair types.TokenPair, tokenID string) []byte {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByTokenID)
-	return store.Get([]byte(tokenID))
+	return store.Get(types.NFTPairByTokenIDKey(pair.GetERC721Contract(), tokenID))
 }
 
-func (k Keeper) DeleteNFTPairByTokenID(ctx sdk.Context, tokenID string) {
+// DeleteNFTPairByTokenID removes the nft ID paired with the given ERC721 token
+// of a token pair
+func (k Keeper) DeleteNFTPairByTokenID(ctx sdk.Context, pair types.TokenPair, tokenID string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByTokenID)
-	store.Delete([]byte(tokenID))
+	store.Delete(types.NFTPairByTokenIDKey(pair.GetERC721Contract(), tokenID))
+}
+
+// GetNFTPairs returns the mappings of all the converted nfts
+func (k Keeper) GetNFTPairs(ctx sdk.Context) []types.NFTPair {
+	nftPairs := []types.NFTPair{}
+
+	for _, pair := range k.GetTokenPairs(ctx) {
+		nftPairs = append(nftPairs, k.GetNFTPairsByTokenPair(ctx, pair)...)
+	}
+
+	return nftPairs
+}
+
+// GetNFTPairsByTokenPair returns the mappings of the converted nfts of a token
+// pair
+func (k Keeper) GetNFTPairsByTokenPair(ctx sdk.Context, pair types.TokenPair) []types.NFTPair {
+	nftPairs := []types.NFTPair{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByNFTID)
+	iterator := sdk.KVStorePrefixIterator(store, pair.GetID())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		nftPairs = append(nftPairs, types.NFTPair{
+			ClassId:       pair.ClassId,
+			NftId:         string(iterator.Key()[len(pair.GetID()):]),
+			Erc721Address: pair.Erc721Address,
+			TokenId:       string(iterator.Value()),
+		})
+	}
+
+	return nftPairs
+}
+
+// SetNFTData stores a burned nft of a native ERC721 token pair
+func (k Keeper) SetNFTData(ctx sdk.Context, pair types.TokenPair, data nft.NFT) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTData)
+	store.Set(types.NFTPairByNFTIDKey(pair.GetID(), data.Id), k.cdc.MustMarshal(&data))
+}
+
+// GetNFTData returns a burned nft of a native ERC721 token pair
+func (k Keeper) GetNFTData(ctx sdk.Context, pair types.TokenPair, nftID string) (nft.NFT, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTData)
+	bz := store.Get(types.NFTPairByNFTIDKey(pair.GetID(), nftID))
+	if len(bz) == 0 {
+		return nft.NFT{}, false
+	}
+
+	var data nft.NFT
+	k.cdc.MustUnmarshal(bz, &data)
+	return data, true
+}
+
+// DeleteNFTData removes a burned nft of a native ERC721 token pair
+func (k Keeper) DeleteNFTData(ctx sdk.Context, pair types.TokenPair, nftID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTData)
+	store.Delete(types.NFTPairByNFTIDKey(pair.GetID(), nftID))
+}
+
+// DeleteNFTDataByTokenPair removes all the burned nfts of a native ERC721
+// token pair
+func (k Keeper) DeleteNFTDataByTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTData)
+	iterator := sdk.KVStorePrefixIterator(store, pair.GetID())
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllNFTData returns all the burned nfts of the native ERC721 token pairs
+func (k Keeper) GetAllNFTData(ctx sdk.Context) []nft.NFT {
+	nfts := []nft.NFT{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixNFTData)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var data nft.NFT
+		k.cdc.MustUnmarshal(iterator.Value(), &data)
+
+		nfts = append(nfts, data)
+	}
+
+	return nfts
 }
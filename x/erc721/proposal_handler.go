@@ -21,6 +21,10 @@ func NewErc721ProposalHandler(k *keeper.Keeper) gov.Handler {
 			return handleRegisterERC721Proposal(ctx, k, c)
 		case *types.ToggleTokenConversionProposal:
 			return handleToggleConversionProposal(ctx, k, c)
+		case *types.UpdateTokenPairERC721Proposal:
+			return handleUpdateTokenPairERC721Proposal(ctx, k, c)
+		case *types.DeregisterTokenPairProposal:
+			return handleDeregisterTokenPairProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -76,3 +80,37 @@ func handleToggleConversionProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 
 	return nil
 }
+
+func handleUpdateTokenPairERC721Proposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTokenPairERC721Proposal) error {
+	pair, err := k.UpdateTokenPairERC721(ctx, p.GetERC721Address(), p.GetNewERC721Address())
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenPairERC721,
+			sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+		),
+	)
+
+	return nil
+}
+
+func handleDeregisterTokenPairProposal(ctx sdk.Context, k *keeper.Keeper, p *types.DeregisterTokenPairProposal) error {
+	pair, err := k.DeregisterTokenPair(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyNFTClass, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+		),
+	)
+
+	return nil
+}
@@ -21,12 +21,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertNFT{},
 		&MsgConvertERC721{},
+		&MsgConvertNFTBatch{},
+		&MsgConvertERC721Batch{},
 	)
 	registry.RegisterImplementations(
 		(*gov.Content)(nil),
 		&RegisterNFTProposal{},
 		&RegisterERC721Proposal{},
 		&ToggleTokenConversionProposal{},
+		&UpdateTokenPairERC721Proposal{},
+		&DeregisterTokenPairProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
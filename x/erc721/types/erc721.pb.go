@@ -56,6 +56,43 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e4208f03f5270a65, []int{0}
 }
 
+// NFTIDScheme enumerates the schemes used to derive the native Cosmos nft ID of
+// an ERC721 token.
+type NFTIDScheme int32
+
+const (
+	// NFT_ID_SCHEME_UNSPECIFIED defines an invalid/undefined scheme.
+	NFT_ID_SCHEME_UNSPECIFIED NFTIDScheme = 0
+	// NFT_ID_SCHEME_KECCAK256 derives the nft ID as "erc721:" followed by the
+	// hex encoded keccak256 hash of the 20 bytes contract address concatenated
+	// with the 32 bytes big endian token ID.
+	NFT_ID_SCHEME_KECCAK256 NFTIDScheme = 1
+	// NFT_ID_SCHEME_LEGACY derives the nft ID as "Cat-" followed by the decimal
+	// token ID, as by the native ERC721 token pairs registered before the nft ID
+	// schemes.
+	NFT_ID_SCHEME_LEGACY NFTIDScheme = 2
+)
+
+var NFTIDScheme_name = map[int32]string{
+	0: "NFT_ID_SCHEME_UNSPECIFIED",
+	1: "NFT_ID_SCHEME_KECCAK256",
+	2: "NFT_ID_SCHEME_LEGACY",
+}
+
+var NFTIDScheme_value = map[string]int32{
+	"NFT_ID_SCHEME_UNSPECIFIED": 0,
+	"NFT_ID_SCHEME_KECCAK256":   1,
+	"NFT_ID_SCHEME_LEGACY":      2,
+}
+
+func (x NFTIDScheme) String() string {
+	return proto.EnumName(NFTIDScheme_name, int32(x))
+}
+
+func (NFTIDScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e4208f03f5270a65, []int{1}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 // Cosmos Coin and an ERC721 token address.
 type TokenPair struct {
@@ -67,6 +104,9 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// ERC721 owner address ENUM (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=uptick.erc721.v1.Owner" json:"contract_owner,omitempty"`
+	// scheme used to derive the nft IDs of the ERC721 tokens, only set for
+	// native ERC721 token pairs
+	NftIdScheme NFTIDScheme `protobuf:"varint,5,opt,name=nft_id_scheme,json=nftIdScheme,proto3,enum=uptick.erc721.v1.NFTIDScheme" json:"nft_id_scheme,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +170,163 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetNftIdScheme() NFTIDScheme {
+	if m != nil {
+		return m.NftIdScheme
+	}
+	return NFT_ID_SCHEME_UNSPECIFIED
+}
+
+// NFTPair defines the mapping between a native Cosmos nft and an ERC721 token
+// of a registered token pair.
+type NFTPair struct {
+	// cosmos nft class ID of the token pair
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// cosmos nft ID
+	NftId string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// address of ERC721 contract token of the token pair
+	Erc721Address string `protobuf:"bytes,3,opt,name=erc721_address,json=erc721Address,proto3" json:"erc721_address,omitempty"`
+	// ERC721 token ID
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *NFTPair) Reset()         { *m = NFTPair{} }
+func (m *NFTPair) String() string { return proto.CompactTextString(m) }
+func (*NFTPair) ProtoMessage()    {}
+func (*NFTPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4208f03f5270a65, []int{1}
+}
+func (m *NFTPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTPair.Merge(m, src)
+}
+func (m *NFTPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTPair proto.InternalMessageInfo
+
+func (m *NFTPair) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTPair) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+func (m *NFTPair) GetErc721Address() string {
+	if m != nil {
+		return m.Erc721Address
+	}
+	return ""
+}
+
+func (m *NFTPair) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// ConvertedNFT defines a converted nft along with the current holders of its
+// representations.
+type ConvertedNFT struct {
+	// mapping between the nft and the ERC721 token
+	NftPair NFTPair `protobuf:"bytes,1,opt,name=nft_pair,json=nftPair,proto3" json:"nft_pair"`
+	// custodian holding the native representation of the nft: the cosmos
+	// address of the nft owner for native nft pairs or the hex address of the
+	// ERC721 token owner for native ERC721 pairs
+	Custodian string `protobuf:"bytes,2,opt,name=custodian,proto3" json:"custodian,omitempty"`
+	// escrowed is true if the custodian is the module
+	Escrowed bool `protobuf:"varint,3,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// owner of the converted representation of the nft
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *ConvertedNFT) Reset()         { *m = ConvertedNFT{} }
+func (m *ConvertedNFT) String() string { return proto.CompactTextString(m) }
+func (*ConvertedNFT) ProtoMessage()    {}
+func (*ConvertedNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4208f03f5270a65, []int{2}
+}
+func (m *ConvertedNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertedNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertedNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertedNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertedNFT.Merge(m, src)
+}
+func (m *ConvertedNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertedNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertedNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertedNFT proto.InternalMessageInfo
+
+func (m *ConvertedNFT) GetNftPair() NFTPair {
+	if m != nil {
+		return m.NftPair
+	}
+	return NFTPair{}
+}
+
+func (m *ConvertedNFT) GetCustodian() string {
+	if m != nil {
+		return m.Custodian
+	}
+	return ""
+}
+
+func (m *ConvertedNFT) GetEscrowed() bool {
+	if m != nil {
+		return m.Escrowed
+	}
+	return false
+}
+
+func (m *ConvertedNFT) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 // RegisterNFTProposal is a gov Content type to register a token pair for a
 // native Cosmos coin.
 type RegisterNFTProposal struct {
@@ -145,7 +342,7 @@ func (m *RegisterNFTProposal) Reset()         { *m = RegisterNFTProposal{} }
 func (m *RegisterNFTProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterNFTProposal) ProtoMessage()    {}
 func (*RegisterNFTProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4208f03f5270a65, []int{1}
+	return fileDescriptor_e4208f03f5270a65, []int{3}
 }
 func (m *RegisterNFTProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +407,7 @@ func (m *RegisterERC721Proposal) Reset()         { *m = RegisterERC721Proposal{}
 func (m *RegisterERC721Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC721Proposal) ProtoMessage()    {}
 func (*RegisterERC721Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4208f03f5270a65, []int{2}
+	return fileDescriptor_e4208f03f5270a65, []int{4}
 }
 func (m *RegisterERC721Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +473,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4208f03f5270a65, []int{3}
+	return fileDescriptor_e4208f03f5270a65, []int{5}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,50 +523,212 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 	return ""
 }
 
+// UpdateTokenPairERC721Proposal is a gov Content type to update the ERC721
+// contract of a registered token pair. The escrowed nfts and ERC721 tokens of
+// the token pair are returned to their owners before the update.
+type UpdateTokenPairERC721Proposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// contract address of ERC721 token
+	Erc721Address string `protobuf:"bytes,3,opt,name=erc721_address,json=erc721Address,proto3" json:"erc721_address,omitempty"`
+	// new address of ERC721 token contract
+	NewErc721Address string `protobuf:"bytes,4,opt,name=new_erc721_address,json=newErc721Address,proto3" json:"new_erc721_address,omitempty"`
+}
+
+func (m *UpdateTokenPairERC721Proposal) Reset()         { *m = UpdateTokenPairERC721Proposal{} }
+func (m *UpdateTokenPairERC721Proposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateTokenPairERC721Proposal) ProtoMessage()    {}
+func (*UpdateTokenPairERC721Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4208f03f5270a65, []int{6}
+}
+func (m *UpdateTokenPairERC721Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTokenPairERC721Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTokenPairERC721Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateTokenPairERC721Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTokenPairERC721Proposal.Merge(m, src)
+}
+func (m *UpdateTokenPairERC721Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTokenPairERC721Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTokenPairERC721Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTokenPairERC721Proposal proto.InternalMessageInfo
+
+func (m *UpdateTokenPairERC721Proposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateTokenPairERC721Proposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateTokenPairERC721Proposal) GetErc721Address() string {
+	if m != nil {
+		return m.Erc721Address
+	}
+	return ""
+}
+
+func (m *UpdateTokenPairERC721Proposal) GetNewErc721Address() string {
+	if m != nil {
+		return m.NewErc721Address
+	}
+	return ""
+}
+
+// DeregisterTokenPairProposal is a gov Content type to deregister a token
+// pair. The escrowed nfts and ERC721 tokens of the token pair are returned to
+// their owners.
+type DeregisterTokenPairProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC721 or
+	// the Cosmos nft class
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *DeregisterTokenPairProposal) Reset()         { *m = DeregisterTokenPairProposal{} }
+func (m *DeregisterTokenPairProposal) String() string { return proto.CompactTextString(m) }
+func (*DeregisterTokenPairProposal) ProtoMessage()    {}
+func (*DeregisterTokenPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4208f03f5270a65, []int{7}
+}
+func (m *DeregisterTokenPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterTokenPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterTokenPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterTokenPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterTokenPairProposal.Merge(m, src)
+}
+func (m *DeregisterTokenPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterTokenPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterTokenPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterTokenPairProposal proto.InternalMessageInfo
+
+func (m *DeregisterTokenPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("uptick.erc721.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("uptick.erc721.v1.NFTIDScheme", NFTIDScheme_name, NFTIDScheme_value)
 	proto.RegisterType((*TokenPair)(nil), "uptick.erc721.v1.TokenPair")
+	proto.RegisterType((*NFTPair)(nil), "uptick.erc721.v1.NFTPair")
+	proto.RegisterType((*ConvertedNFT)(nil), "uptick.erc721.v1.ConvertedNFT")
 	proto.RegisterType((*RegisterNFTProposal)(nil), "uptick.erc721.v1.RegisterNFTProposal")
 	proto.RegisterType((*RegisterERC721Proposal)(nil), "uptick.erc721.v1.RegisterERC721Proposal")
 	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "uptick.erc721.v1.ToggleTokenConversionProposal")
+	proto.RegisterType((*UpdateTokenPairERC721Proposal)(nil), "uptick.erc721.v1.UpdateTokenPairERC721Proposal")
+	proto.RegisterType((*DeregisterTokenPairProposal)(nil), "uptick.erc721.v1.DeregisterTokenPairProposal")
 }
 
 func init() { proto.RegisterFile("uptick/erc721/v1/erc721.proto", fileDescriptor_e4208f03f5270a65) }
 
 var fileDescriptor_e4208f03f5270a65 = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x8d, 0xb7, 0x96, 0x6d, 0x1e, 0xab, 0x82, 0x19, 0x90, 0x55, 0x2c, 0xab, 0x2a, 0x90, 0x2a,
-	0x0e, 0x89, 0x52, 0x84, 0x26, 0x71, 0x40, 0xda, 0xba, 0x4c, 0x14, 0x8d, 0xb4, 0x0a, 0xad, 0x40,
-	0x5c, 0xaa, 0x34, 0x31, 0xc1, 0x6a, 0x16, 0x47, 0xb6, 0xd7, 0x81, 0xc4, 0x0f, 0x40, 0x9c, 0xf8,
-	0x09, 0x48, 0x9c, 0xf9, 0x1f, 0x3b, 0xee, 0xc8, 0x09, 0xa1, 0xf6, 0xc2, 0xcf, 0x40, 0xb1, 0x1d,
-	0x69, 0xda, 0x75, 0xb7, 0xef, 0xbd, 0xef, 0xe5, 0xf3, 0xcb, 0xf3, 0x67, 0xb8, 0x7b, 0x56, 0x08,
-	0x12, 0xcf, 0x5c, 0xcc, 0xe2, 0xfd, 0xae, 0xe7, 0xce, 0x3d, 0x5d, 0x39, 0x05, 0xa3, 0x82, 0x22,
-	0x53, 0xb5, 0x1d, 0x4d, 0xce, 0xbd, 0xe6, 0x76, 0x4a, 0x53, 0x2a, 0x9b, 0x6e, 0x59, 0x29, 0x5d,
-	0xf3, 0xa1, 0x1e, 0x93, 0x7f, 0x10, 0xee, 0xdc, 0x9b, 0x62, 0x11, 0x79, 0x65, 0xad, 0xba, 0xed,
-	0x5f, 0x00, 0x6e, 0x8c, 0xe8, 0x0c, 0xe7, 0xc3, 0x88, 0x30, 0xf4, 0x18, 0x36, 0xd4, 0xb8, 0x49,
-	0x94, 0x24, 0x0c, 0x73, 0x6e, 0x81, 0x16, 0xe8, 0x6c, 0x84, 0x5b, 0x8a, 0x3d, 0x50, 0x24, 0xda,
-	0x81, 0xeb, 0x71, 0x16, 0x71, 0x3e, 0x21, 0x89, 0xb5, 0x22, 0x05, 0x6b, 0x12, 0xf7, 0x13, 0x64,
-	0xc1, 0x35, 0x9c, 0x47, 0xd3, 0x0c, 0x27, 0xd6, 0x6a, 0x0b, 0x74, 0xd6, 0xc3, 0x0a, 0xa2, 0x17,
-	0xb0, 0x11, 0xd3, 0x5c, 0xb0, 0x28, 0x16, 0x13, 0x7a, 0x9e, 0x63, 0x66, 0xd5, 0x5a, 0xa0, 0xd3,
-	0xe8, 0x3e, 0x70, 0xae, 0xff, 0x88, 0x33, 0x28, 0xdb, 0xe1, 0x56, 0x25, 0x97, 0xf0, 0x79, 0xed,
-	0xdf, 0x8f, 0x3d, 0xd0, 0xfe, 0x06, 0xe0, 0xdd, 0x10, 0xa7, 0x84, 0x0b, 0xcc, 0x82, 0xe3, 0xd1,
-	0x90, 0xd1, 0x82, 0xf2, 0x28, 0x43, 0xdb, 0xb0, 0x2e, 0x88, 0xc8, 0xb0, 0x36, 0xac, 0x00, 0x6a,
-	0xc1, 0xcd, 0x04, 0xf3, 0x98, 0x91, 0x42, 0x10, 0x9a, 0x6b, 0xaf, 0x57, 0x29, 0xf4, 0x0c, 0xd6,
-	0xa5, 0x75, 0xe9, 0x76, 0xb3, 0xbb, 0xe3, 0xc4, 0x94, 0x9f, 0x52, 0xee, 0x94, 0x09, 0xe9, 0xb4,
-	0x9c, 0x5e, 0x29, 0x38, 0xac, 0x5d, 0xfc, 0xd9, 0x33, 0x42, 0xa5, 0x96, 0x66, 0x8c, 0xf6, 0x17,
-	0x78, 0xbf, 0xf2, 0xe2, 0x87, 0xbd, 0xfd, 0xae, 0x77, 0x63, 0x3b, 0x8f, 0xa0, 0x8e, 0xba, 0xca,
-	0x7f, 0xf5, 0x6a, 0xfe, 0x9a, 0xd4, 0xa7, 0x73, 0xb8, 0x3b, 0xa2, 0x69, 0x9a, 0x61, 0x79, 0x7f,
-	0x3d, 0x9a, 0xcf, 0x31, 0xe3, 0x84, 0xe6, 0x37, 0x36, 0x51, 0x7e, 0x57, 0x8e, 0xd4, 0x87, 0x2b,
-	0xa0, 0xf2, 0x7f, 0xf2, 0x0a, 0xd6, 0xe5, 0x75, 0xa0, 0x7b, 0xf0, 0xce, 0xe0, 0x6d, 0xe0, 0x87,
-	0x93, 0x71, 0xf0, 0x66, 0xe8, 0xf7, 0xfa, 0xc7, 0x7d, 0xff, 0xc8, 0x34, 0x90, 0x09, 0x6f, 0x2b,
-	0xfa, 0xf5, 0xe0, 0x68, 0x7c, 0xe2, 0x9b, 0x00, 0x21, 0xd8, 0x50, 0x8c, 0xff, 0x6e, 0xe4, 0x87,
-	0xc1, 0xc1, 0x89, 0xb9, 0xd2, 0xac, 0x7d, 0xfd, 0x69, 0x1b, 0x87, 0x2f, 0x2f, 0x16, 0x36, 0xb8,
-	0x5c, 0xd8, 0xe0, 0xef, 0xc2, 0x06, 0xdf, 0x97, 0xb6, 0x71, 0xb9, 0xb4, 0x8d, 0xdf, 0x4b, 0xdb,
-	0x78, 0xef, 0xa4, 0x44, 0x7c, 0x3c, 0x9b, 0x3a, 0x31, 0x3d, 0x75, 0xc7, 0x72, 0x3b, 0x02, 0x2c,
-	0xce, 0x29, 0x9b, 0xb9, 0x7a, 0x99, 0x3f, 0x55, 0xaf, 0x42, 0x7c, 0x2e, 0x30, 0x9f, 0xde, 0x92,
-	0xcb, 0xfc, 0xf4, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0xc4, 0x47, 0xb4, 0x33, 0x03, 0x00,
-	0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x21, 0x21, 0xc9, 0x0b, 0x44, 0xd9, 0x59, 0x58, 0x4c, 0x20, 0x21, 0x8a, 0x76, 0x25,
+	0x84, 0x56, 0x8e, 0x92, 0x15, 0x8b, 0xc4, 0x61, 0xa5, 0xe0, 0x38, 0x4b, 0x16, 0x30, 0xc8, 0x24,
+	0xda, 0xb6, 0x17, 0xcb, 0xb1, 0x27, 0xc1, 0x22, 0x78, 0x52, 0x7b, 0x48, 0x5a, 0xa9, 0xbd, 0x57,
+	0x3d, 0xf5, 0xdc, 0x53, 0xa5, 0xfe, 0x83, 0xfe, 0x0a, 0x8e, 0x1c, 0x7b, 0xaa, 0x2a, 0xb8, 0xf4,
+	0xd8, 0x9f, 0x50, 0x79, 0x66, 0x12, 0x02, 0xa5, 0x52, 0x25, 0xda, 0x9b, 0xbf, 0xf7, 0xbe, 0x79,
+	0xef, 0x7d, 0xef, 0xbd, 0x19, 0x43, 0xee, 0xac, 0x4f, 0x5d, 0xfb, 0xa4, 0x84, 0x7d, 0x7b, 0xb3,
+	0x52, 0x2e, 0x0d, 0xca, 0xe2, 0x4b, 0xe9, 0xfb, 0x84, 0x12, 0x94, 0xe1, 0x6e, 0x45, 0x18, 0x07,
+	0xe5, 0xec, 0x7c, 0x97, 0x74, 0x09, 0x73, 0x96, 0xc2, 0x2f, 0xce, 0xcb, 0xae, 0xd8, 0x24, 0x38,
+	0x25, 0x41, 0xc9, 0xeb, 0xd0, 0xd2, 0xa0, 0xdc, 0xc6, 0xd4, 0x2a, 0x87, 0xdf, 0xdc, 0x5b, 0xfc,
+	0x2c, 0x41, 0xb2, 0x49, 0x4e, 0xb0, 0x77, 0x68, 0xb9, 0x3e, 0xfa, 0x03, 0xd2, 0x3c, 0x9c, 0x69,
+	0x39, 0x8e, 0x8f, 0x83, 0x40, 0x96, 0x0a, 0xd2, 0x5a, 0xd2, 0x98, 0xe3, 0xd6, 0x2a, 0x37, 0xa2,
+	0x25, 0x48, 0xd8, 0x3d, 0x2b, 0x08, 0x4c, 0xd7, 0x91, 0xa7, 0x18, 0x21, 0xce, 0x70, 0xc3, 0x41,
+	0x32, 0xc4, 0xb1, 0x67, 0xb5, 0x7b, 0xd8, 0x91, 0xa7, 0x0b, 0xd2, 0x5a, 0xc2, 0x18, 0x41, 0xf4,
+	0x0f, 0xa4, 0x6d, 0xe2, 0x51, 0xdf, 0xb2, 0xa9, 0x49, 0x86, 0x1e, 0xf6, 0xe5, 0x68, 0x41, 0x5a,
+	0x4b, 0x57, 0x16, 0x95, 0xdb, 0x42, 0x94, 0x83, 0xd0, 0x6d, 0xcc, 0x8d, 0xe8, 0x0c, 0xa2, 0x2a,
+	0xcc, 0x79, 0x1d, 0x6a, 0xba, 0x8e, 0x19, 0xd8, 0xc7, 0xf8, 0x14, 0xcb, 0x31, 0x76, 0x3c, 0xf7,
+	0xf5, 0x71, 0xbd, 0xde, 0x6c, 0xd4, 0x8e, 0x18, 0xc9, 0x48, 0x79, 0x1d, 0xda, 0x70, 0x38, 0xd8,
+	0x8a, 0x7e, 0x7a, 0xb3, 0x2a, 0x15, 0x9f, 0x43, 0x5c, 0xaf, 0x37, 0x99, 0xde, 0x49, 0x21, 0xd2,
+	0x4d, 0x21, 0x0b, 0x30, 0xc3, 0xd3, 0x09, 0x85, 0x31, 0x16, 0xe8, 0x8e, 0x0e, 0x4d, 0x7f, 0xa3,
+	0x43, 0x34, 0xec, 0x6a, 0x78, 0x3e, 0xca, 0x03, 0x33, 0xdc, 0x70, 0x8a, 0xaf, 0x25, 0x98, 0x55,
+	0x89, 0x37, 0xc0, 0x3e, 0xc5, 0x8e, 0x5e, 0x6f, 0xa2, 0x2d, 0x48, 0x84, 0x99, 0xfa, 0x96, 0xeb,
+	0xb3, 0x22, 0x52, 0x95, 0xa5, 0x3b, 0x35, 0x85, 0x15, 0x6f, 0x47, 0xcf, 0x3f, 0xac, 0x46, 0x8c,
+	0xb8, 0xd7, 0xa1, 0x4c, 0xc0, 0x0a, 0x24, 0xed, 0xb3, 0x80, 0x12, 0xc7, 0xb5, 0x3c, 0x51, 0xe8,
+	0xb5, 0x01, 0x65, 0x21, 0x81, 0x03, 0xdb, 0x27, 0xc3, 0xf1, 0x34, 0xc6, 0x18, 0xcd, 0x43, 0xec,
+	0x7a, 0x0a, 0x49, 0x83, 0x83, 0xe2, 0x4b, 0x09, 0x7e, 0x35, 0x70, 0xd7, 0x0d, 0x28, 0xf6, 0xc3,
+	0x94, 0x3e, 0xe9, 0x93, 0xc0, 0xea, 0x85, 0x6c, 0xea, 0xd2, 0x1e, 0x16, 0x5d, 0xe2, 0x00, 0x15,
+	0x20, 0xe5, 0x84, 0x01, 0xdd, 0x3e, 0x75, 0xc9, 0x28, 0xff, 0xa4, 0x09, 0x6d, 0x40, 0x8c, 0x35,
+	0x54, 0x9e, 0x16, 0xc2, 0xf8, 0x32, 0x2a, 0xe1, 0x02, 0x8a, 0x65, 0x54, 0xd4, 0x90, 0x20, 0x84,
+	0x71, 0x36, 0x1b, 0x54, 0xa4, 0xf8, 0x0c, 0x7e, 0x1b, 0xd5, 0xa2, 0x19, 0xea, 0x66, 0xa5, 0x7c,
+	0xef, 0x72, 0x7e, 0x07, 0x31, 0xa7, 0x3b, 0x87, 0x27, 0x8c, 0x22, 0x7b, 0x00, 0xb9, 0x26, 0xe9,
+	0x76, 0x7b, 0x98, 0x5d, 0x0f, 0x3e, 0xb1, 0xc0, 0x25, 0xde, 0xbd, 0x8b, 0x08, 0xcf, 0x85, 0x21,
+	0x45, 0x72, 0x0e, 0xc4, 0x6e, 0xbe, 0x93, 0x20, 0xd7, 0xea, 0x3b, 0x16, 0xc5, 0xe3, 0x4b, 0xf9,
+	0x83, 0xa4, 0x7f, 0xe7, 0xe2, 0xfe, 0x09, 0xc8, 0xc3, 0x43, 0xf3, 0x16, 0x95, 0xef, 0x48, 0xc6,
+	0xc3, 0x43, 0x6d, 0x92, 0x2d, 0x8a, 0x7e, 0x0c, 0xcb, 0x35, 0xec, 0x8b, 0x49, 0x8d, 0xeb, 0xfe,
+	0x99, 0x7d, 0x5a, 0xff, 0x0f, 0x62, 0xfc, 0x55, 0x58, 0x80, 0x5f, 0x0e, 0xfe, 0xd7, 0x35, 0xc3,
+	0x6c, 0xe9, 0x47, 0x87, 0x9a, 0xda, 0xa8, 0x37, 0xb4, 0x5a, 0x26, 0x82, 0x32, 0x30, 0xcb, 0xcd,
+	0xfb, 0x07, 0xb5, 0xd6, 0x9e, 0x96, 0x91, 0x10, 0x82, 0x34, 0xb7, 0x68, 0x0f, 0x9a, 0x9a, 0xa1,
+	0x57, 0xf7, 0x32, 0x53, 0xd9, 0xe8, 0x8b, 0xb7, 0xf9, 0xc8, 0xba, 0x0b, 0xa9, 0x89, 0x17, 0x03,
+	0xe5, 0x60, 0x49, 0xaf, 0x37, 0xcd, 0x46, 0xcd, 0x3c, 0x52, 0x77, 0xb4, 0x7d, 0xed, 0x56, 0xe4,
+	0x65, 0x58, 0xbc, 0xe9, 0xde, 0xd5, 0x54, 0xb5, 0xba, 0x5b, 0xd9, 0xf8, 0x3b, 0x23, 0x21, 0x19,
+	0xe6, 0x6f, 0x3a, 0xf7, 0xb4, 0x7f, 0xab, 0xea, 0xc3, 0x51, 0xaa, 0xed, 0x9d, 0xf3, 0xcb, 0xbc,
+	0x74, 0x71, 0x99, 0x97, 0x3e, 0x5e, 0xe6, 0xa5, 0x57, 0x57, 0xf9, 0xc8, 0xc5, 0x55, 0x3e, 0xf2,
+	0xfe, 0x2a, 0x1f, 0x79, 0xa4, 0x74, 0x5d, 0x7a, 0x7c, 0xd6, 0x56, 0x6c, 0x72, 0x5a, 0x6a, 0xb1,
+	0xcb, 0xaf, 0x63, 0x3a, 0x24, 0xfe, 0x49, 0x49, 0xfc, 0x05, 0x9e, 0x8c, 0xfe, 0x03, 0xf4, 0x69,
+	0x1f, 0x07, 0xed, 0x19, 0xf6, 0x7c, 0xff, 0xf5, 0x65, 0x00, 0x3a, 0xdb, 0x28, 0x9f, 0x25, 0x06,
+	0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -403,6 +762,9 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	if this.ContractOwner != that1.ContractOwner {
 		return false
 	}
+	if this.NftIdScheme != that1.NftIdScheme {
+		return false
+	}
 	return true
 }
 func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
@@ -435,55 +797,231 @@ func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *TokenPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UpdateTokenPairERC721Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractOwner != 0 {
-		i = encodeVarintErc721(dAtA, i, uint64(m.ContractOwner))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
+	that1, ok := that.(*UpdateTokenPairERC721Proposal)
+	if !ok {
+		that2, ok := that.(UpdateTokenPairERC721Proposal)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintErc721(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Erc721Address) > 0 {
-		i -= len(m.Erc721Address)
-		copy(dAtA[i:], m.Erc721Address)
-		i = encodeVarintErc721(dAtA, i, uint64(len(m.Erc721Address)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc721Address != that1.Erc721Address {
+		return false
+	}
+	if this.NewErc721Address != that1.NewErc721Address {
+		return false
+	}
+	return true
+}
+func (this *DeregisterTokenPairProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeregisterTokenPairProposal)
+	if !ok {
+		that2, ok := that.(DeregisterTokenPairProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NftIdScheme != 0 {
+		i = encodeVarintErc721(dAtA, i, uint64(m.NftIdScheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc721(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc721Address) > 0 {
+		i -= len(m.Erc721Address)
+		copy(dAtA[i:], m.Erc721Address)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Erc721Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Erc721Address) > 0 {
+		i -= len(m.Erc721Address)
+		copy(dAtA[i:], m.Erc721Address)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Erc721Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvertedNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertedNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertedNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Escrowed {
+		i--
+		if m.Escrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Custodian) > 0 {
+		i -= len(m.Custodian)
+		copy(dAtA[i:], m.Custodian)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Custodian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.NftPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc721(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -622,6 +1160,101 @@ func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateTokenPairERC721Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairERC721Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairERC721Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewErc721Address) > 0 {
+		i -= len(m.NewErc721Address)
+		copy(dAtA[i:], m.NewErc721Address)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.NewErc721Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Erc721Address) > 0 {
+		i -= len(m.Erc721Address)
+		copy(dAtA[i:], m.Erc721Address)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Erc721Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterTokenPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterTokenPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterTokenPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc721(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintErc721(dAtA []byte, offset int, v uint64) int {
 	offset -= sovErc721(v)
 	base := offset
@@ -653,6 +1286,56 @@ func (m *TokenPair) Size() (n int) {
 	if m.ContractOwner != 0 {
 		n += 1 + sovErc721(uint64(m.ContractOwner))
 	}
+	if m.NftIdScheme != 0 {
+		n += 1 + sovErc721(uint64(m.NftIdScheme))
+	}
+	return n
+}
+
+func (m *NFTPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.Erc721Address)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvertedNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NftPair.Size()
+	n += 1 + l + sovErc721(uint64(l))
+	l = len(m.Custodian)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	if m.Escrowed {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
 	return n
 }
 
@@ -714,16 +1397,579 @@ func (m *ToggleTokenConversionProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovErc721(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *UpdateTokenPairERC721Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.Erc721Address)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.NewErc721Address)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterTokenPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc721(uint64(l))
+	}
+	return n
+}
+
+func sovErc721(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc721(x uint64) (n int) {
+	return sovErc721(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc721
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc721Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftIdScheme", wireType)
+			}
+			m.NftIdScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NftIdScheme |= NFTIDScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc721(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc721
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc721Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc721(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertedNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc721
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertedNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NftPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custodian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Custodian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrowed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc721(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovErc721(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozErc721(x uint64) (n int) {
-	return sovErc721(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *RegisterNFTProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -746,15 +1992,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterNFTProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterNFTProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc721Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -782,11 +2028,11 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc721Address = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -814,13 +2060,13 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc721
@@ -830,17 +2076,80 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthErc721
 			}
-			m.ContractOwner = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc721(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC721Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc721
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC721Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC721Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc721
@@ -850,11 +2159,88 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc721Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc721(dAtA[iNdEx:])
@@ -876,7 +2262,7 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterNFTProposal) Unmarshal(dAtA []byte) error {
+func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -899,10 +2285,10 @@ func (m *RegisterNFTProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterNFTProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterNFTProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -971,9 +2357,9 @@ func (m *RegisterNFTProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc721
@@ -983,24 +2369,23 @@ func (m *RegisterNFTProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthErc721
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthErc721
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1023,7 +2408,7 @@ func (m *RegisterNFTProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterERC721Proposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateTokenPairERC721Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1046,10 +2431,10 @@ func (m *RegisterERC721Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterERC721Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTokenPairERC721Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterERC721Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTokenPairERC721Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1148,6 +2533,38 @@ func (m *RegisterERC721Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Erc721Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc721Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc721
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc721
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc721
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc721Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc721(dAtA[iNdEx:])
@@ -1169,7 +2586,7 @@ func (m *RegisterERC721Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
+func (m *DeregisterTokenPairProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1192,10 +2609,10 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18,4 +18,5 @@ var (
 	ErrABIUnpack               = sdkerrors.Register(ModuleName, 11, "contract ABI unpack failed")
 	ErrEVMCall                 = sdkerrors.Register(ModuleName, 12, "EVM call unexpected error")
 	ErrERC721TokenPairDisabled = sdkerrors.Register(ModuleName, 13, "erc721 token pair is disabled")
+	ErrInvalidNFTIDScheme      = sdkerrors.Register(ModuleName, 14, "invalid nft ID scheme")
 )
@@ -11,16 +11,24 @@ const (
 	EventTypeMint                  = "mint"
 	EventTypeConvertNFT            = "convert_nft"
 	EventTypeConvertERC721         = "convert_erc721"
+	EventTypeConvertNFTBatch       = "convert_nft_batch"
+	EventTypeConvertERC721Batch    = "convert_erc721_batch"
 	EventTypeBurn                  = "burn"
 	EventTypeRegisterNFT           = "register_nft"
 	EventTypeRegisterERC721        = "register_erc721"
 	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeUpdateTokenPairERC721 = "update_token_pair_erc721"
+	EventTypeDeregisterTokenPair   = "deregister_token_pair"
+	EventTypeReturnNFT             = "return_nft"
+	EventTypeReturnERC721          = "return_erc721"
 
-	AttributeKeyNFTClass      = "nft_class"
-	AttributeKeyNFTID         = "nft_id"
-	AttributeKeyERC721Token   = "erc721_token"    // #nosec
-	AttributeKeyERC721TokenID = "erc721_token_id" // #nosec
-	AttributeKeyReceiver      = "receiver"
+	AttributeKeyNFTClass       = "nft_class"
+	AttributeKeyNFTID          = "nft_id"
+	AttributeKeyERC721Token    = "erc721_token"    // #nosec
+	AttributeKeyERC721TokenID  = "erc721_token_id" // #nosec
+	AttributeKeyNFTIDs         = "nft_ids"
+	AttributeKeyERC721TokenIDs = "erc721_token_ids" // #nosec
+	AttributeKeyReceiver       = "receiver"
 
 	ERC721EventTransfer = "Transfer"
 )
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // ERC721Data represents the ERC721 token details used to map
@@ -51,3 +52,17 @@ type ERC721TokenIDResponse struct {
 type ERC721TokenOwnerResponse struct {
 	Value common.Address
 }
+
+type ERC721BoolResponse struct {
+	Value bool
+}
+
+// InterfaceIDERC2981 is the ERC165 interface ID of the EIP-2981 royalties
+var InterfaceIDERC2981 = [4]byte{0x2a, 0x55, 0x20, 0x5a}
+
+// AccessControl roles of the ERC721 preset contract required by the module to
+// mint the tokens of a module owned contract
+var (
+	DefaultAdminRole = [32]byte{}
+	MinterRole       = crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+)
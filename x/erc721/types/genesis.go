@@ -1,12 +1,17 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+func NewGenesisState(params Params, pairs []TokenPair, nftPairs []NFTPair) GenesisState {
 	return GenesisState{
 		Params:     params,
 		TokenPairs: pairs,
+		NftPairs:   nftPairs,
 	}
 }
 
@@ -23,6 +28,7 @@ func DefaultGenesisState() *GenesisState {
 func (gs GenesisState) Validate() error {
 	seenErc721 := make(map[string]bool)
 	seenClass := make(map[string]bool)
+	pairByClass := make(map[string]TokenPair)
 
 	for _, b := range gs.TokenPairs {
 		if seenErc721[b.Erc721Address] {
@@ -36,7 +42,66 @@ func (gs GenesisState) Validate() error {
 		}
 		seenErc721[b.Erc721Address] = true
 		seenClass[b.ClassId] = true
+		pairByClass[b.ClassId] = b
+	}
+
+	seenNFT := make(map[string]bool)
+	seenToken := make(map[string]bool)
+
+	for _, p := range gs.NftPairs {
+		pair, found := pairByClass[p.ClassId]
+		if !found {
+			return fmt.Errorf("nft pair class '%s' is not a registered token pair", p.ClassId)
+		}
+		if pair.Erc721Address != p.Erc721Address {
+			return fmt.Errorf(
+				"nft pair contract '%s' doesn't match the token pair contract '%s' of class '%s'",
+				p.Erc721Address, pair.Erc721Address, p.ClassId,
+			)
+		}
+		nftKey := p.ClassId + "/" + p.NftId
+		tokenKey := p.Erc721Address + "/" + p.TokenId
+		if seenNFT[nftKey] {
+			return fmt.Errorf("nft duplicated on genesis nft pairs: '%s'", nftKey)
+		}
+		if seenToken[tokenKey] {
+			return fmt.Errorf("erc721 token duplicated on genesis nft pairs: '%s'", tokenKey)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seenNFT[nftKey] = true
+		seenToken[tokenKey] = true
+	}
+
+	seenData := make(map[string]bool)
+
+	for _, data := range gs.NftData {
+		pair, found := pairByClass[data.ClassId]
+		if !found {
+			return fmt.Errorf("nft data class '%s' is not a registered token pair", data.ClassId)
+		}
+		if !pair.IsNativeERC721() {
+			return fmt.Errorf("nft data class '%s' is not a native ERC721 token pair", data.ClassId)
+		}
+		key := data.ClassId + "/" + data.Id
+		if seenData[key] {
+			return fmt.Errorf("nft duplicated on genesis nft data: '%s'", key)
+		}
+		if err := nft.ValidateNFTID(data.Id); err != nil {
+			return err
+		}
+		seenData[key] = true
 	}
 
 	return gs.Params.Validate()
 }
+
+// Validate performs a stateless validation of a NFTPair
+func (p NFTPair) Validate() error {
+	if err := nft.ValidateNFTID(p.NftId); err != nil {
+		return err
+	}
+
+	return ValidateTokenID(p.TokenId)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	nft "github.com/cosmos/cosmos-sdk/x/nft"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +30,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// nft mappings of the converted nfts and ERC721 tokens
+	NftPairs []NFTPair `protobuf:"bytes,3,rep,name=nft_pairs,json=nftPairs,proto3" json:"nft_pairs"`
+	// burned nfts of native ERC721 token pairs, kept to restore their metadata
+	// when the ERC721 tokens are converted again
+	NftData []nft.NFT `protobuf:"bytes,4,rep,name=nft_data,json=nftData,proto3" json:"nft_data"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +84,20 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetNftPairs() []NFTPair {
+	if m != nil {
+		return m.NftPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNftData() []nft.NFT {
+	if m != nil {
+		return m.NftData
+	}
+	return nil
+}
+
 // Params defines the erc721 module params
 type Params struct {
 	// parameter to enable the conversion of Cosmos nft <--> ERC721 tokens.
@@ -143,27 +163,31 @@ func init() {
 func init() { proto.RegisterFile("uptick/erc721/v1/genesis.proto", fileDescriptor_fc044dbce6d614a3) }
 
 var fileDescriptor_fc044dbce6d614a3 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x2d, 0x28, 0xc9,
-	0x4c, 0xce, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x37, 0x32, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0x41,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x64, 0x31, 0x74, 0x40, 0xe5, 0xc0, 0x1a, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc5, 0xc8, 0xc5, 0xe3, 0x0e, 0x31,
-	0x38, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x8c, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58,
-	0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x42, 0x0f, 0xdd, 0x22, 0xbd, 0x00, 0xb0, 0xbc, 0x13,
-	0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xd5, 0x42, 0x4e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9,
-	0x79, 0xf1, 0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xd2, 0x98,
-	0x9a, 0x43, 0x40, 0x8a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xfa, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a,
-	0x19, 0x5c, 0x6c, 0x10, 0xb3, 0x85, 0x94, 0xb9, 0x78, 0x53, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3,
-	0x21, 0x3a, 0xc1, 0x8e, 0xe1, 0x08, 0xe2, 0x81, 0x08, 0xba, 0x82, 0xc5, 0x84, 0x2c, 0xb9, 0xf8,
-	0x61, 0x8a, 0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xca, 0x9c, 0x04, 0x1f,
-	0xdd, 0x93, 0xe7, 0x75, 0x85, 0x28, 0x0d, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0x82, 0x1a, 0xe7,
-	0x5a, 0x96, 0x0b, 0xe2, 0x3a, 0x79, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
-	0x94, 0x5e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x28, 0xd8, 0xf1,
-	0x7e, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0xfa, 0xd0, 0xe0, 0xad, 0x80, 0x05, 0x70, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x1c, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x83,
-	0x40, 0xa4, 0xb0, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0xcf, 0xd2, 0x40,
+	0x10, 0xc6, 0x5b, 0xde, 0x37, 0xf8, 0xba, 0x40, 0xd4, 0xc6, 0xc4, 0x8a, 0x5a, 0x08, 0x5e, 0x38,
+	0xed, 0xa6, 0x98, 0xf8, 0x27, 0xf1, 0xd4, 0x88, 0x72, 0x91, 0x10, 0x44, 0x0f, 0x5e, 0x9a, 0x6d,
+	0xdd, 0x96, 0xa6, 0xb6, 0xdb, 0x74, 0x87, 0xaa, 0x57, 0x3f, 0x81, 0x1f, 0x8b, 0x23, 0x47, 0x4f,
+	0xc4, 0x94, 0x2f, 0x62, 0xf6, 0x0f, 0x89, 0x91, 0xdb, 0x74, 0x9e, 0xe7, 0x37, 0xd3, 0x67, 0x07,
+	0x79, 0xbb, 0x0a, 0xb2, 0x38, 0x27, 0xac, 0x8e, 0x5f, 0xcc, 0x7c, 0xd2, 0xf8, 0x24, 0x65, 0x25,
+	0x13, 0x99, 0xc0, 0x55, 0xcd, 0x81, 0x3b, 0x77, 0xb5, 0x8e, 0xb5, 0x8e, 0x1b, 0x7f, 0xf8, 0xe4,
+	0x82, 0x30, 0x9a, 0x02, 0x86, 0xf7, 0x53, 0x9e, 0x72, 0x55, 0x12, 0x59, 0x99, 0xee, 0xe3, 0x98,
+	0x8b, 0x82, 0x0b, 0x52, 0x26, 0x40, 0x1a, 0x3f, 0x62, 0x40, 0x7d, 0x59, 0x6b, 0x75, 0xf2, 0xb3,
+	0x83, 0xfa, 0xef, 0xf4, 0xda, 0x0f, 0x40, 0x81, 0x39, 0xcf, 0x51, 0xb7, 0xa2, 0x35, 0x2d, 0x84,
+	0x6b, 0x8f, 0xed, 0x69, 0x6f, 0xe6, 0xe2, 0xff, 0x7f, 0x03, 0xaf, 0x94, 0x1e, 0x5c, 0xef, 0x8f,
+	0x23, 0x6b, 0x6d, 0xdc, 0x4e, 0x80, 0x7a, 0xc0, 0x73, 0x56, 0x86, 0x15, 0xcd, 0x6a, 0xe1, 0x76,
+	0xc6, 0x57, 0xd3, 0xde, 0xec, 0xd1, 0x25, 0xbc, 0x91, 0xa6, 0x15, 0xcd, 0x6a, 0xc3, 0x23, 0x38,
+	0x37, 0x84, 0xf3, 0x1a, 0xdd, 0x2e, 0x13, 0x30, 0x13, 0xae, 0xd4, 0x84, 0x87, 0x97, 0x13, 0x96,
+	0x6f, 0x37, 0xff, 0xf0, 0x37, 0x65, 0x02, 0x9a, 0x7e, 0x89, 0x64, 0x1d, 0x7e, 0xa1, 0x40, 0xdd,
+	0x6b, 0x05, 0x3f, 0xc0, 0x3a, 0x3b, 0x96, 0x79, 0x4d, 0x76, 0x89, 0x1b, 0xf4, 0x56, 0x99, 0xc0,
+	0x1b, 0x0a, 0x74, 0xb2, 0x45, 0x5d, 0x9d, 0xc9, 0x79, 0x8a, 0x06, 0xac, 0xa4, 0xd1, 0x57, 0x16,
+	0xea, 0x7d, 0xea, 0x11, 0x6e, 0xd6, 0x7d, 0xdd, 0x9c, 0xab, 0x9e, 0xf3, 0x0a, 0xdd, 0x39, 0x9b,
+	0x9a, 0x22, 0xdc, 0x72, 0x9e, 0xbb, 0x1d, 0x69, 0x0b, 0xee, 0xb5, 0xc7, 0xd1, 0x60, 0xae, 0xad,
+	0x9f, 0xde, 0x2f, 0x38, 0xcf, 0xd7, 0x66, 0xdc, 0xbc, 0x29, 0xe4, 0x67, 0xb0, 0xd8, 0xb7, 0x9e,
+	0x7d, 0x68, 0x3d, 0xfb, 0x4f, 0xeb, 0xd9, 0xbf, 0x4e, 0x9e, 0x75, 0x38, 0x79, 0xd6, 0xef, 0x93,
+	0x67, 0x7d, 0xc6, 0x69, 0x06, 0xdb, 0x5d, 0x84, 0x63, 0x5e, 0x90, 0x8f, 0x2a, 0xf2, 0x92, 0xc1,
+	0x37, 0x5e, 0xe7, 0xc4, 0x1c, 0xfd, 0xfb, 0xf9, 0xec, 0xf0, 0xa3, 0x62, 0x22, 0xea, 0xaa, 0xfb,
+	0x3d, 0xfb, 0x3b, 0x00, 0x11, 0xc1, 0x40, 0xaf, 0x46, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +210,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NftData) > 0 {
+		for iNdEx := len(m.NftData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NftData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NftPairs) > 0 {
+		for iNdEx := len(m.NftPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NftPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -281,6 +333,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.NftPairs) > 0 {
+		for _, e := range m.NftPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NftData) > 0 {
+		for _, e := range m.NftData {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -401,6 +465,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftPairs = append(m.NftPairs, NFTPair{})
+			if err := m.NftPairs[len(m.NftPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftData = append(m.NftData, nft.NFT{})
+			if err := m.NftData[len(m.NftData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
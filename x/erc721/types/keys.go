@@ -32,6 +32,7 @@ const (
 	prefixTokenPairByClass
 	prefixNFTPairByNFTID
 	prefixNFTPairByTokenID
+	prefixNFTData
 )
 
 // KVStore key prefixes
@@ -41,4 +42,17 @@ var (
 	KeyPrefixTokenPairByClass  = []byte{prefixTokenPairByClass}
 	KeyPrefixNFTPairByNFTID    = []byte{prefixNFTPairByNFTID}
 	KeyPrefixNFTPairByTokenID  = []byte{prefixNFTPairByTokenID}
+	KeyPrefixNFTData           = []byte{prefixNFTData}
 )
+
+// NFTPairByNFTIDKey returns the key of the nft pair for the given token pair ID
+// and nft ID, relative to KeyPrefixNFTPairByNFTID
+func NFTPairByNFTIDKey(pairID []byte, nftID string) []byte {
+	return append(append([]byte{}, pairID...), []byte(nftID)...)
+}
+
+// NFTPairByTokenIDKey returns the key of the nft pair for the given ERC721
+// contract and token ID, relative to KeyPrefixNFTPairByTokenID
+func NFTPairByTokenIDKey(contract common.Address, tokenID string) []byte {
+	return append(contract.Bytes(), []byte(tokenID)...)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -10,11 +11,21 @@ import (
 var (
 	_ sdk.Msg = &MsgConvertNFT{}
 	_ sdk.Msg = &MsgConvertERC721{}
+	_ sdk.Msg = &MsgConvertNFTBatch{}
+	_ sdk.Msg = &MsgConvertERC721Batch{}
 )
 
 const (
-	TypeMsgConvertNFT    = "convert_nft"
-	TypeMsgConvertERC721 = "convert_ERC721"
+	TypeMsgConvertNFT         = "convert_nft"
+	TypeMsgConvertERC721      = "convert_ERC721"
+	TypeMsgConvertNFTBatch    = "convert_nft_batch"
+	TypeMsgConvertERC721Batch = "convert_ERC721_batch"
+
+	// MaxBatchSize is the maximum number of tokens converted by a batch msg
+	MaxBatchSize = 500
+	// BatchConversionGasPerItem is the gas consumed by a batch msg for each
+	// converted token, covering the EVM execution of the conversion
+	BatchConversionGasPerItem = 50_000
 )
 
 // NewMsgConvertNFT creates a new instance of MsgConvertNFT
@@ -95,3 +106,108 @@ func (msg MsgConvertERC721) GetSigners() []sdk.AccAddress {
 	addr := common.HexToAddress(msg.Sender)
 	return []sdk.AccAddress{addr.Bytes()}
 }
+
+// NewMsgConvertNFTBatch creates a new instance of MsgConvertNFTBatch
+func NewMsgConvertNFTBatch(classID string, nftIDs []string, receiver common.Address, sender sdk.AccAddress) *MsgConvertNFTBatch { // nolint: interfacer
+	return &MsgConvertNFTBatch{
+		ClassId:  classID,
+		NftIds:   nftIDs,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertNFTBatch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertNFTBatch) Type() string { return TypeMsgConvertNFTBatch }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertNFTBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	if err := nft.ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	return validateBatchIDs(msg.NftIds, nft.ValidateNFTID)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertNFTBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertNFTBatch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC721Batch creates a new instance of MsgConvertERC721Batch
+func NewMsgConvertERC721Batch(tokenIDs []string, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC721Batch { // nolint: interfacer
+	return &MsgConvertERC721Batch{
+		ContractAddress: contract.String(),
+		TokenIds:        tokenIDs,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC721Batch) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC721Batch) Type() string { return TypeMsgConvertERC721Batch }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC721Batch) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return validateBatchIDs(msg.TokenIds, ValidateTokenID)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC721Batch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC721Batch) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// validateBatchIDs checks that the ids of a batch msg are valid, within the
+// batch size limit and not duplicated
+func validateBatchIDs(ids []string, validate func(string) error) error {
+	if len(ids) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty batch")
+	}
+	if len(ids) > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch size %d exceeds the maximum %d", len(ids), MaxBatchSize)
+	}
+
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if err := validate(id); err != nil {
+			return err
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated id %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -18,6 +20,8 @@ const (
 	ProposalTypeRegisterNFT           string = "RegisterNFT"
 	ProposalTypeRegisterERC721        string = "RegisterERC721"
 	ProposalTypeToggleTokenConversion string = "ToggleNFTConversion" // #nosec
+	ProposalTypeUpdateTokenPairERC721 string = "UpdateTokenPairERC721"
+	ProposalTypeDeregisterTokenPair   string = "DeregisterNFTTokenPair"
 )
 
 // Implements Proposal Interface
@@ -25,12 +29,16 @@ var (
 	_ gov.Content = &RegisterNFTProposal{}
 	_ gov.Content = &RegisterERC721Proposal{}
 	_ gov.Content = &ToggleTokenConversionProposal{}
+	_ gov.Content = &UpdateTokenPairERC721Proposal{}
+	_ gov.Content = &DeregisterTokenPairProposal{}
 )
 
 func init() {
 	gov.RegisterProposalType(ProposalTypeRegisterNFT)
 	gov.RegisterProposalType(ProposalTypeRegisterERC721)
 	gov.RegisterProposalType(ProposalTypeToggleTokenConversion)
+	gov.RegisterProposalType(ProposalTypeUpdateTokenPairERC721)
+	gov.RegisterProposalType(ProposalTypeDeregisterTokenPair)
 }
 
 // CreateClass generates a string the module name plus the address to avoid conflicts with names staring with a number
@@ -125,3 +133,77 @@ func (ttcp *ToggleTokenConversionProposal) ValidateBasic() error {
 
 	return gov.ValidateAbstract(ttcp)
 }
+
+// NewUpdateTokenPairERC721Proposal returns new instance of UpdateTokenPairERC721Proposal
+func NewUpdateTokenPairERC721Proposal(title, description, erc721Addr, newERC721Addr string) gov.Content {
+	return &UpdateTokenPairERC721Proposal{
+		Title:            title,
+		Description:      description,
+		Erc721Address:    erc721Addr,
+		NewErc721Address: newERC721Addr,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateTokenPairERC721Proposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateTokenPairERC721Proposal) ProposalType() string {
+	return ProposalTypeUpdateTokenPairERC721
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateTokenPairERC721Proposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(p.Erc721Address); err != nil {
+		return sdkerrors.Wrap(err, "ERC721 address")
+	}
+
+	if err := ethermint.ValidateAddress(p.NewErc721Address); err != nil {
+		return sdkerrors.Wrap(err, "new ERC721 address")
+	}
+
+	if p.GetERC721Address() == p.GetNewERC721Address() {
+		return fmt.Errorf("new ERC721 address must differ from the current one %s", p.Erc721Address)
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+// GetERC721Address returns the common.Address representation of the ERC721 hex address
+func (p UpdateTokenPairERC721Proposal) GetERC721Address() common.Address {
+	return common.HexToAddress(p.Erc721Address)
+}
+
+// GetNewERC721Address returns the common.Address representation of the new ERC721 hex address
+func (p UpdateTokenPairERC721Proposal) GetNewERC721Address() common.Address {
+	return common.HexToAddress(p.NewErc721Address)
+}
+
+// NewDeregisterTokenPairProposal returns new instance of DeregisterTokenPairProposal
+func NewDeregisterTokenPairProposal(title, description string, token string) gov.Content {
+	return &DeregisterTokenPairProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*DeregisterTokenPairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*DeregisterTokenPairProposal) ProposalType() string {
+	return ProposalTypeDeregisterTokenPair
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *DeregisterTokenPairProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	return gov.ValidateAbstract(p)
+}
@@ -135,7 +135,7 @@ func (m *QueryTokenPairsResponse) GetPagination() *query.PageResponse {
 // QueryTokenPairRequest is the request type for the Query/TokenPair RPC method.
 type QueryTokenPairRequest struct {
 	// token identifier can be either the hex contract address of the ERC721 or
-	// the Cosmos base denomination
+	// the Cosmos nft classID
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
@@ -225,6 +225,323 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryNFTPairByNFTIDRequest is the request type for the Query/NFTPairByNFTID
+// RPC method.
+type QueryNFTPairByNFTIDRequest struct {
+	// class_id of the converted nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id of the converted nft
+	NftId string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *QueryNFTPairByNFTIDRequest) Reset()         { *m = QueryNFTPairByNFTIDRequest{} }
+func (m *QueryNFTPairByNFTIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairByNFTIDRequest) ProtoMessage()    {}
+func (*QueryNFTPairByNFTIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89ad90d26f17fbf9, []int{4}
+}
+func (m *QueryNFTPairByNFTIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairByNFTIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairByNFTIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTPairByNFTIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairByNFTIDRequest.Merge(m, src)
+}
+func (m *QueryNFTPairByNFTIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairByNFTIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairByNFTIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTPairByNFTIDRequest proto.InternalMessageInfo
+
+func (m *QueryNFTPairByNFTIDRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryNFTPairByNFTIDRequest) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+// QueryNFTPairByNFTIDResponse is the response type for the
+// Query/NFTPairByNFTID RPC method.
+type QueryNFTPairByNFTIDResponse struct {
+	ConvertedNft ConvertedNFT `protobuf:"bytes,1,opt,name=converted_nft,json=convertedNft,proto3" json:"converted_nft"`
+}
+
+func (m *QueryNFTPairByNFTIDResponse) Reset()         { *m = QueryNFTPairByNFTIDResponse{} }
+func (m *QueryNFTPairByNFTIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairByNFTIDResponse) ProtoMessage()    {}
+func (*QueryNFTPairByNFTIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89ad90d26f17fbf9, []int{5}
+}
+func (m *QueryNFTPairByNFTIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairByNFTIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairByNFTIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTPairByNFTIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairByNFTIDResponse.Merge(m, src)
+}
+func (m *QueryNFTPairByNFTIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairByNFTIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairByNFTIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTPairByNFTIDResponse proto.InternalMessageInfo
+
+func (m *QueryNFTPairByNFTIDResponse) GetConvertedNft() ConvertedNFT {
+	if m != nil {
+		return m.ConvertedNft
+	}
+	return ConvertedNFT{}
+}
+
+// QueryNFTPairByTokenIDRequest is the request type for the
+// Query/NFTPairByTokenID RPC method.
+type QueryNFTPairByTokenIDRequest struct {
+	// token identifier can be either the hex contract address of the ERC721 or
+	// the Cosmos nft classID
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// token_id of the converted ERC721 token
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *QueryNFTPairByTokenIDRequest) Reset()         { *m = QueryNFTPairByTokenIDRequest{} }
+func (m *QueryNFTPairByTokenIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairByTokenIDRequest) ProtoMessage()    {}
+func (*QueryNFTPairByTokenIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89ad90d26f17fbf9, []int{6}
+}
+func (m *QueryNFTPairByTokenIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairByTokenIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairByTokenIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTPairByTokenIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairByTokenIDRequest.Merge(m, src)
+}
+func (m *QueryNFTPairByTokenIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairByTokenIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairByTokenIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTPairByTokenIDRequest proto.InternalMessageInfo
+
+func (m *QueryNFTPairByTokenIDRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryNFTPairByTokenIDRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// QueryNFTPairByTokenIDResponse is the response type for the
+// Query/NFTPairByTokenID RPC method.
+type QueryNFTPairByTokenIDResponse struct {
+	ConvertedNft ConvertedNFT `protobuf:"bytes,1,opt,name=converted_nft,json=convertedNft,proto3" json:"converted_nft"`
+}
+
+func (m *QueryNFTPairByTokenIDResponse) Reset()         { *m = QueryNFTPairByTokenIDResponse{} }
+func (m *QueryNFTPairByTokenIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairByTokenIDResponse) ProtoMessage()    {}
+func (*QueryNFTPairByTokenIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89ad90d26f17fbf9, []int{7}
+}
+func (m *QueryNFTPairByTokenIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairByTokenIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairByTokenIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTPairByTokenIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairByTokenIDResponse.Merge(m, src)
+}
+func (m *QueryNFTPairByTokenIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairByTokenIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairByTokenIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTPairByTokenIDResponse proto.InternalMessageInfo
+
+func (m *QueryNFTPairByTokenIDResponse) GetConvertedNft() ConvertedNFT {
+	if m != nil {
+		return m.ConvertedNft
+	}
+	return ConvertedNFT{}
+}
+
+// QueryConvertedNFTsRequest is the request type for the Query/ConvertedNFTs
+// RPC method.
+type QueryConvertedNFTsRequest struct {
+	// token identifier can be either the hex contract address of the ERC721 or
+	// the Cosmos nft classID
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConvertedNFTsRequest) Reset()         { *m = QueryConvertedNFTsRequest{} }
+func (m *QueryConvertedNFTsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertedNFTsRequest) ProtoMessage()    {}
+func (*QueryConvertedNFTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89ad90d26f17fbf9, []int{8}
+}
+func (m *QueryConvertedNFTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertedNFTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertedNFTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConvertedNFTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertedNFTsRequest.Merge(m, src)
+}
+func (m *QueryConvertedNFTsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertedNFTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertedNFTsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConvertedNFTsRequest proto.InternalMessageInfo
+
+func (m *QueryConvertedNFTsRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryConvertedNFTsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConvertedNFTsResponse is the response type for the Query/ConvertedNFTs
+// RPC method.
+type QueryConvertedNFTsResponse struct {
+	ConvertedNfts []ConvertedNFT `protobuf:"bytes,1,rep,name=converted_nfts,json=convertedNfts,proto3" json:"converted_nfts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConvertedNFTsResponse) Reset()         { *m = QueryConvertedNFTsResponse{} }
+func (m *QueryConvertedNFTsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertedNFTsResponse) ProtoMessage()    {}
+func (*QueryConvertedNFTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89ad90d26f17fbf9, []int{9}
+}
+func (m *QueryConvertedNFTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertedNFTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertedNFTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConvertedNFTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertedNFTsResponse.Merge(m, src)
+}
+func (m *QueryConvertedNFTsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertedNFTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertedNFTsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConvertedNFTsResponse proto.InternalMessageInfo
+
+func (m *QueryConvertedNFTsResponse) GetConvertedNfts() []ConvertedNFT {
+	if m != nil {
+		return m.ConvertedNfts
+	}
+	return nil
+}
+
+func (m *QueryConvertedNFTsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -233,7 +550,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89ad90d26f17fbf9, []int{4}
+	return fileDescriptor_89ad90d26f17fbf9, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +589,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_89ad90d26f17fbf9, []int{5}
+	return fileDescriptor_89ad90d26f17fbf9, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,6 +630,12 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "uptick.erc721.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "uptick.erc721.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "uptick.erc721.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryNFTPairByNFTIDRequest)(nil), "uptick.erc721.v1.QueryNFTPairByNFTIDRequest")
+	proto.RegisterType((*QueryNFTPairByNFTIDResponse)(nil), "uptick.erc721.v1.QueryNFTPairByNFTIDResponse")
+	proto.RegisterType((*QueryNFTPairByTokenIDRequest)(nil), "uptick.erc721.v1.QueryNFTPairByTokenIDRequest")
+	proto.RegisterType((*QueryNFTPairByTokenIDResponse)(nil), "uptick.erc721.v1.QueryNFTPairByTokenIDResponse")
+	proto.RegisterType((*QueryConvertedNFTsRequest)(nil), "uptick.erc721.v1.QueryConvertedNFTsRequest")
+	proto.RegisterType((*QueryConvertedNFTsResponse)(nil), "uptick.erc721.v1.QueryConvertedNFTsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "uptick.erc721.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "uptick.erc721.v1.QueryParamsResponse")
 }
@@ -320,40 +643,57 @@ func init() {
 func init() { proto.RegisterFile("uptick/erc721/v1/query.proto", fileDescriptor_89ad90d26f17fbf9) }
 
 var fileDescriptor_89ad90d26f17fbf9 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x2d, 0x8d, 0x94, 0x37, 0x0b, 0x3a, 0x02, 0x0d, 0x21, 0xb8, 0x95, 0x15, 0xda,
-	0x80, 0xe0, 0x4e, 0x0e, 0x12, 0xac, 0x28, 0x03, 0xb0, 0x80, 0x42, 0x04, 0x4b, 0x17, 0xb8, 0x44,
-	0x27, 0x63, 0x85, 0xf8, 0x5c, 0xdf, 0xc5, 0x50, 0x21, 0x16, 0x16, 0x16, 0x06, 0x24, 0x16, 0x3e,
-	0x01, 0x9f, 0xa5, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x3e, 0x08, 0xf2, 0xdd, 0xd9, 0xa9, 0x6b,
-	0x68, 0xb2, 0xd9, 0xef, 0xbf, 0xe7, 0xf7, 0xbc, 0x77, 0x36, 0xb4, 0x67, 0x91, 0x0a, 0xc6, 0x13,
-	0xca, 0xe3, 0xf1, 0xfd, 0x9e, 0x47, 0x13, 0x8f, 0x1e, 0xce, 0x78, 0x7c, 0x44, 0xa2, 0x58, 0x28,
-	0x81, 0x2f, 0x9a, 0x2c, 0x31, 0x59, 0x92, 0x78, 0xad, 0x5b, 0x63, 0x21, 0xa7, 0x42, 0xd2, 0x11,
-	0x93, 0xdc, 0x94, 0xd2, 0xc4, 0x1b, 0x71, 0xc5, 0x3c, 0x1a, 0x31, 0x3f, 0x08, 0x99, 0x0a, 0x44,
-	0x68, 0xba, 0x5b, 0x4e, 0x69, 0xb6, 0xcf, 0x43, 0x2e, 0x03, 0x69, 0xf3, 0xd7, 0x4b, 0x79, 0xab,
-	0x63, 0xd2, 0x6d, 0x5f, 0x08, 0xff, 0x0d, 0xa7, 0x2c, 0x0a, 0x28, 0x0b, 0x43, 0xa1, 0xf4, 0xec,
-	0xac, 0xb9, 0xe1, 0x0b, 0x5f, 0xe8, 0x47, 0x9a, 0x3e, 0x99, 0xa8, 0xfb, 0x0a, 0xae, 0x3c, 0x4b,
-	0xa1, 0x9e, 0x8b, 0x09, 0x0f, 0x07, 0x2c, 0x88, 0xe5, 0x90, 0x1f, 0xce, 0xb8, 0x54, 0xf8, 0x21,
-	0xc0, 0x12, 0xb0, 0x89, 0x76, 0x51, 0xb7, 0xde, 0xdb, 0x23, 0xc6, 0x0d, 0x49, 0xdd, 0x10, 0x63,
-	0xdc, 0xba, 0x21, 0x03, 0xe6, 0x73, 0xdb, 0x3b, 0x3c, 0xd5, 0xe9, 0x7e, 0x47, 0xb0, 0x5d, 0x92,
-	0x90, 0x91, 0x08, 0x25, 0xc7, 0x7d, 0xa8, 0xab, 0x34, 0xfa, 0x32, 0x4a, 0xc3, 0x4d, 0xb4, 0xbb,
-	0xd9, 0xad, 0xf7, 0xae, 0x91, 0xb3, 0x4b, 0x24, 0x79, 0x6b, 0xff, 0xc2, 0xf1, 0xaf, 0x9d, 0xca,
-	0x10, 0x54, 0x3e, 0x0b, 0x3f, 0x2a, 0x70, 0x6e, 0x68, 0xce, 0xfd, 0x95, 0x9c, 0x06, 0xa0, 0x00,
-	0x7a, 0x07, 0x2e, 0x17, 0x39, 0xb3, 0x4d, 0x34, 0x60, 0x4b, 0xeb, 0xe9, 0x25, 0xd4, 0x86, 0xe6,
-	0xc5, 0x3d, 0x38, 0xbb, 0xb9, 0xdc, 0xd5, 0x03, 0x80, 0xa5, 0x2b, 0xbb, 0xb9, 0x35, 0x4c, 0xd5,
-	0x72, 0x53, 0x6e, 0x03, 0xb0, 0x9e, 0x3d, 0x60, 0x31, 0x9b, 0x66, 0x27, 0xe2, 0x3e, 0x81, 0x4b,
-	0x85, 0xa8, 0x95, 0xbb, 0x07, 0xd5, 0x48, 0x47, 0xac, 0x54, 0xb3, 0x2c, 0x65, 0x3a, 0xac, 0x8e,
-	0xad, 0xee, 0x7d, 0xdb, 0x84, 0x2d, 0x3d, 0x0f, 0x7f, 0x42, 0x00, 0xcb, 0xd3, 0xc1, 0xdd, 0xf2,
-	0x80, 0x7f, 0xdf, 0x91, 0xd6, 0xcd, 0x35, 0x2a, 0x0d, 0xa5, 0xdb, 0xf9, 0xf8, 0xe3, 0xcf, 0xd7,
-	0x0d, 0x07, 0xb7, 0x29, 0x4f, 0xd2, 0xef, 0x61, 0x79, 0x87, 0x4f, 0xdd, 0x00, 0xfc, 0x19, 0x41,
-	0x2d, 0x6f, 0xc6, 0xfb, 0xab, 0xc6, 0x67, 0x1c, 0xdd, 0xd5, 0x85, 0x16, 0xe3, 0xb6, 0xc6, 0xd8,
-	0xc3, 0x9d, 0xf3, 0x30, 0xe8, 0x7b, 0xfd, 0xf2, 0x01, 0x27, 0x50, 0x35, 0xab, 0xc3, 0x9d, 0xff,
-	0x28, 0x14, 0x4e, 0xa8, 0x75, 0x63, 0x45, 0x95, 0x85, 0xd8, 0xd1, 0x10, 0x57, 0xf1, 0x76, 0x09,
-	0xc2, 0x1c, 0x4d, 0xff, 0xf1, 0xf1, 0xdc, 0x41, 0x27, 0x73, 0x07, 0xfd, 0x9e, 0x3b, 0xe8, 0xcb,
-	0xc2, 0xa9, 0x9c, 0x2c, 0x9c, 0xca, 0xcf, 0x85, 0x53, 0x39, 0x20, 0x7e, 0xa0, 0x5e, 0xcf, 0x46,
-	0x64, 0x2c, 0xa6, 0xf4, 0x85, 0xd6, 0x7a, 0xca, 0xd5, 0x5b, 0x11, 0x4f, 0xa8, 0xfd, 0x37, 0xbc,
-	0xcb, 0xa6, 0xa9, 0xa3, 0x88, 0xcb, 0x51, 0x55, 0x7f, 0xe6, 0x77, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0x8a, 0x61, 0x6d, 0xb7, 0x04, 0x00, 0x00,
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x28, 0x45, 0x5e, 0x84, 0x90, 0x11, 0x04, 0x96, 0xb2, 0x90, 0x0d, 0x42, 0x51,
+	0xd8, 0xb1, 0x35, 0xc1, 0x18, 0x2f, 0x8a, 0xa4, 0xda, 0x18, 0x2b, 0x36, 0x78, 0xe1, 0x52, 0xb7,
+	0xed, 0x74, 0x59, 0x81, 0xdd, 0xa5, 0x3b, 0xad, 0x36, 0x84, 0x8b, 0x17, 0x2f, 0x1e, 0x4c, 0x3c,
+	0x78, 0xf0, 0xac, 0x1f, 0xc0, 0x83, 0x9f, 0x81, 0x23, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x1f, 0xc4,
+	0xec, 0xcc, 0xec, 0xb6, 0xcb, 0xb6, 0xb4, 0x31, 0x9c, 0xd8, 0x9d, 0x79, 0xff, 0xfc, 0x9e, 0x77,
+	0x79, 0x9f, 0x14, 0x12, 0x35, 0x87, 0x9a, 0xa5, 0x1d, 0x4c, 0xaa, 0xa5, 0xbb, 0xe9, 0x14, 0xae,
+	0xa7, 0xf0, 0x7e, 0x8d, 0x54, 0x1b, 0x9a, 0x53, 0xb5, 0xa9, 0x8d, 0x46, 0xf9, 0xad, 0xc6, 0x6f,
+	0xb5, 0x7a, 0x4a, 0xbe, 0x59, 0xb2, 0xdd, 0x3d, 0xdb, 0xc5, 0x45, 0xdd, 0x25, 0x3c, 0x14, 0xd7,
+	0x53, 0x45, 0x42, 0xf5, 0x14, 0x76, 0x74, 0xc3, 0xb4, 0x74, 0x6a, 0xda, 0x16, 0xcf, 0x96, 0x95,
+	0x48, 0x6d, 0x83, 0x58, 0xc4, 0x35, 0x5d, 0x71, 0x3f, 0x13, 0xb9, 0x17, 0x7d, 0xf8, 0x75, 0xc2,
+	0xb0, 0x6d, 0x63, 0x97, 0x60, 0xdd, 0x31, 0xb1, 0x6e, 0x59, 0x36, 0x65, 0xb5, 0xfd, 0xe4, 0x31,
+	0xc3, 0x36, 0x6c, 0xf6, 0x88, 0xbd, 0x27, 0x7e, 0xaa, 0xbe, 0x82, 0xeb, 0x2f, 0x3c, 0xa8, 0x4d,
+	0x7b, 0x87, 0x58, 0x1b, 0xba, 0x59, 0x75, 0xf3, 0x64, 0xbf, 0x46, 0x5c, 0x8a, 0x32, 0x00, 0x4d,
+	0xc0, 0x49, 0x69, 0x4e, 0x4a, 0x0e, 0xa5, 0x17, 0x34, 0xae, 0x46, 0xf3, 0xd4, 0x68, 0x5c, 0xb8,
+	0x50, 0xa3, 0x6d, 0xe8, 0x06, 0x11, 0xb9, 0xf9, 0x96, 0x4c, 0xf5, 0x9b, 0x04, 0x13, 0x91, 0x16,
+	0xae, 0x63, 0x5b, 0x2e, 0x41, 0x6b, 0x30, 0x44, 0xbd, 0xd3, 0x82, 0xe3, 0x1d, 0x4f, 0x4a, 0x73,
+	0x97, 0x92, 0x43, 0xe9, 0x69, 0xed, 0xec, 0x10, 0xb5, 0x20, 0x75, 0xed, 0xf2, 0xd1, 0xef, 0xd9,
+	0x58, 0x1e, 0x68, 0x50, 0x0b, 0x3d, 0x0e, 0x71, 0xf6, 0x31, 0xce, 0xc5, 0xae, 0x9c, 0x1c, 0x20,
+	0x04, 0xba, 0x02, 0xe3, 0x61, 0x4e, 0x7f, 0x12, 0x63, 0xd0, 0xcf, 0xfa, 0xb1, 0x21, 0x0c, 0xe6,
+	0xf9, 0x8b, 0xba, 0x75, 0x76, 0x72, 0x81, 0xaa, 0x07, 0x00, 0x4d, 0x55, 0x62, 0x72, 0x3d, 0x88,
+	0x1a, 0x0c, 0x44, 0xa9, 0x39, 0x90, 0x59, 0xed, 0x5c, 0x66, 0x93, 0x05, 0x78, 0x0f, 0xd9, 0x75,
+	0x9f, 0x67, 0x0a, 0xae, 0x94, 0x76, 0x75, 0xd7, 0x2d, 0x98, 0x65, 0x81, 0x34, 0xc0, 0xde, 0xb3,
+	0x65, 0x34, 0x0e, 0x71, 0xab, 0x42, 0xbd, 0x8b, 0x3e, 0xce, 0x6a, 0x55, 0x68, 0xb6, 0xac, 0x6e,
+	0xc3, 0x74, 0xdb, 0x7a, 0x02, 0x38, 0x0b, 0xc3, 0x25, 0xdb, 0xaa, 0x93, 0x2a, 0x25, 0xe5, 0x82,
+	0x55, 0xa1, 0x82, 0x59, 0x89, 0x32, 0x3f, 0xf2, 0xc3, 0x72, 0x99, 0x4d, 0x81, 0x7d, 0x35, 0x48,
+	0xcd, 0x55, 0xa8, 0xfa, 0x1c, 0x12, 0xe1, 0x4e, 0x4c, 0x65, 0x76, 0xfd, 0xdc, 0x59, 0x7a, 0x8a,
+	0xf8, 0xc4, 0x02, 0xf0, 0x01, 0xf6, 0x9e, 0x2d, 0xab, 0xaf, 0x61, 0xa6, 0x43, 0xc1, 0x8b, 0x87,
+	0x6f, 0xc0, 0x14, 0xeb, 0xd5, 0x1a, 0xe8, 0x9e, 0x4f, 0x9e, 0x69, 0xf3, 0xdf, 0xf7, 0x3f, 0x5b,
+	0xf2, 0x5d, 0x02, 0xb9, 0x5d, 0x6f, 0x21, 0xf2, 0x29, 0x8c, 0x84, 0x44, 0xfa, 0xbb, 0xd2, 0x9b,
+	0xca, 0xe1, 0x56, 0x95, 0x17, 0xb8, 0x31, 0x63, 0x80, 0x18, 0xf3, 0x86, 0x5e, 0xd5, 0xf7, 0xfc,
+	0x41, 0xa9, 0xcf, 0xe0, 0x5a, 0xe8, 0x54, 0x48, 0x58, 0x85, 0xb8, 0xc3, 0x4e, 0xc4, 0x07, 0x9a,
+	0x8c, 0xa2, 0xf3, 0x0c, 0x01, 0x2d, 0xa2, 0xd3, 0x9f, 0x07, 0xa0, 0x9f, 0xd5, 0x43, 0xef, 0x25,
+	0x80, 0xa6, 0x89, 0xa0, 0x64, 0xb4, 0x40, 0x7b, 0x2b, 0x93, 0x97, 0x7a, 0x88, 0xe4, 0x94, 0xea,
+	0xfc, 0xbb, 0x9f, 0x7f, 0x3f, 0xf5, 0x29, 0x28, 0x81, 0x49, 0xdd, 0xb3, 0xed, 0xa6, 0xd5, 0xb6,
+	0x18, 0x15, 0xfa, 0x20, 0xc1, 0x60, 0x90, 0x8c, 0x16, 0xbb, 0x95, 0xf7, 0x39, 0x92, 0xdd, 0x03,
+	0x05, 0xc6, 0x32, 0xc3, 0x58, 0x40, 0xf3, 0xe7, 0x61, 0xe0, 0x03, 0xf6, 0x72, 0x88, 0xbe, 0x4a,
+	0x30, 0x12, 0x5e, 0x6d, 0xb4, 0xdc, 0xa1, 0x55, 0x5b, 0x47, 0x91, 0x57, 0x7a, 0x8c, 0x16, 0x74,
+	0xab, 0x8c, 0xee, 0x36, 0xd2, 0x22, 0x74, 0x9e, 0xf9, 0x08, 0x36, 0xdf, 0xa2, 0x0e, 0xf1, 0x01,
+	0xb7, 0xa4, 0x43, 0xf4, 0x43, 0x82, 0xd1, 0xb3, 0x7b, 0x8c, 0xb4, 0x6e, 0xbd, 0xc3, 0x0e, 0x22,
+	0xe3, 0x9e, 0xe3, 0x05, 0xed, 0x43, 0x46, 0x7b, 0x1f, 0xdd, 0xeb, 0x4c, 0x5b, 0x28, 0x36, 0x0a,
+	0xbe, 0x01, 0xf9, 0x53, 0x15, 0x7f, 0x19, 0xf8, 0x17, 0x09, 0x86, 0x43, 0x8b, 0x89, 0x6e, 0x75,
+	0xa0, 0x68, 0x67, 0x1d, 0xf2, 0x72, 0x6f, 0xc1, 0x82, 0x17, 0x33, 0xde, 0x25, 0xb4, 0x18, 0xe1,
+	0x0d, 0x5b, 0x40, 0xf0, 0xf9, 0xeb, 0x10, 0xe7, 0x9b, 0x83, 0xe6, 0x3b, 0x34, 0x0a, 0x2d, 0xa8,
+	0x7c, 0xa3, 0x4b, 0x94, 0xe0, 0x98, 0x65, 0x1c, 0x53, 0x68, 0x22, 0xc2, 0xc1, 0x37, 0x73, 0xed,
+	0xc9, 0xd1, 0x89, 0x22, 0x1d, 0x9f, 0x28, 0xd2, 0x9f, 0x13, 0x45, 0xfa, 0x78, 0xaa, 0xc4, 0x8e,
+	0x4f, 0x95, 0xd8, 0xaf, 0x53, 0x25, 0xb6, 0xa5, 0x19, 0x26, 0xdd, 0xae, 0x15, 0xb5, 0x92, 0xbd,
+	0x87, 0x5f, 0xb2, 0x5e, 0x39, 0x42, 0xdf, 0xd8, 0xd5, 0x1d, 0xcc, 0x3b, 0xe3, 0xb7, 0x7e, 0x35,
+	0xda, 0x70, 0x88, 0x5b, 0x8c, 0xb3, 0x1f, 0x23, 0x77, 0xfe, 0x0d, 0x00, 0xf4, 0xce, 0x5b, 0x50,
+	0x5d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -372,6 +712,12 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// NFTPairByNFTID retrieves the ERC721 token paired with a converted nft
+	NFTPairByNFTID(ctx context.Context, in *QueryNFTPairByNFTIDRequest, opts ...grpc.CallOption) (*QueryNFTPairByNFTIDResponse, error)
+	// NFTPairByTokenID retrieves the nft paired with a converted ERC721 token
+	NFTPairByTokenID(ctx context.Context, in *QueryNFTPairByTokenIDRequest, opts ...grpc.CallOption) (*QueryNFTPairByTokenIDResponse, error)
+	// ConvertedNFTs retrieves the converted nfts of a token pair
+	ConvertedNFTs(ctx context.Context, in *QueryConvertedNFTsRequest, opts ...grpc.CallOption) (*QueryConvertedNFTsResponse, error)
 	// Params retrieves the erc721 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -402,6 +748,33 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) NFTPairByNFTID(ctx context.Context, in *QueryNFTPairByNFTIDRequest, opts ...grpc.CallOption) (*QueryNFTPairByNFTIDResponse, error) {
+	out := new(QueryNFTPairByNFTIDResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc721.v1.Query/NFTPairByNFTID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTPairByTokenID(ctx context.Context, in *QueryNFTPairByTokenIDRequest, opts ...grpc.CallOption) (*QueryNFTPairByTokenIDResponse, error) {
+	out := new(QueryNFTPairByTokenIDResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc721.v1.Query/NFTPairByTokenID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConvertedNFTs(ctx context.Context, in *QueryConvertedNFTsRequest, opts ...grpc.CallOption) (*QueryConvertedNFTsResponse, error) {
+	out := new(QueryConvertedNFTsResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc721.v1.Query/ConvertedNFTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/uptick.erc721.v1.Query/Params", in, out, opts...)
@@ -417,6 +790,12 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// NFTPairByNFTID retrieves the ERC721 token paired with a converted nft
+	NFTPairByNFTID(context.Context, *QueryNFTPairByNFTIDRequest) (*QueryNFTPairByNFTIDResponse, error)
+	// NFTPairByTokenID retrieves the nft paired with a converted ERC721 token
+	NFTPairByTokenID(context.Context, *QueryNFTPairByTokenIDRequest) (*QueryNFTPairByTokenIDResponse, error)
+	// ConvertedNFTs retrieves the converted nfts of a token pair
+	ConvertedNFTs(context.Context, *QueryConvertedNFTsRequest) (*QueryConvertedNFTsResponse, error)
 	// Params retrieves the erc721 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -431,6 +810,15 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) NFTPairByNFTID(ctx context.Context, req *QueryNFTPairByNFTIDRequest) (*QueryNFTPairByNFTIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTPairByNFTID not implemented")
+}
+func (*UnimplementedQueryServer) NFTPairByTokenID(ctx context.Context, req *QueryNFTPairByTokenIDRequest) (*QueryNFTPairByTokenIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTPairByTokenID not implemented")
+}
+func (*UnimplementedQueryServer) ConvertedNFTs(ctx context.Context, req *QueryConvertedNFTsRequest) (*QueryConvertedNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertedNFTs not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -475,6 +863,60 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NFTPairByNFTID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTPairByNFTIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTPairByNFTID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc721.v1.Query/NFTPairByNFTID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTPairByNFTID(ctx, req.(*QueryNFTPairByNFTIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTPairByTokenID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTPairByTokenIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTPairByTokenID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc721.v1.Query/NFTPairByTokenID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTPairByTokenID(ctx, req.(*QueryNFTPairByTokenIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConvertedNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvertedNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvertedNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc721.v1.Query/ConvertedNFTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvertedNFTs(ctx, req.(*QueryConvertedNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -505,6 +947,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "NFTPairByNFTID",
+			Handler:    _Query_NFTPairByNFTID_Handler,
+		},
+		{
+			MethodName: "NFTPairByTokenID",
+			Handler:    _Query_NFTPairByTokenID_Handler,
+		},
+		{
+			MethodName: "ConvertedNFTs",
+			Handler:    _Query_ConvertedNFTs_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -661,7 +1115,238 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNFTPairByNFTIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairByNFTIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairByNFTIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairByNFTIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairByNFTIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairByNFTIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConvertedNft.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairByTokenIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairByTokenIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairByTokenIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairByTokenIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairByTokenIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairByTokenIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConvertedNft.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertedNFTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertedNFTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertedNFTsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertedNFTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertedNFTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertedNFTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConvertedNfts) > 0 {
+		for iNdEx := len(m.ConvertedNfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvertedNfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -753,64 +1438,641 @@ func (m *QueryTokenPairsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryTokenPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryTokenPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNFTPairByNFTIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTPairByNFTIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConvertedNft.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNFTPairByTokenIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTPairByTokenIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConvertedNft.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConvertedNFTsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConvertedNFTsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConvertedNfts) > 0 {
+		for _, e := range m.ConvertedNfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTPairByNFTIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTPairByNFTIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTPairByNFTIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTPairByNFTIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,15 +2095,15 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTPairByNFTIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTPairByNFTIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedNft", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -868,10 +2130,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConvertedNft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -896,7 +2155,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTPairByTokenIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -919,17 +2178,17 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTPairByTokenIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTPairByTokenIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -939,29 +2198,109 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTPairByTokenIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTPairByTokenIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTPairByTokenIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedNft", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -988,10 +2327,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConvertedNft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1016,7 +2352,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConvertedNFTsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,10 +2375,10 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConvertedNFTsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConvertedNFTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1077,6 +2413,42 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1098,7 +2470,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConvertedNFTsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1121,15 +2493,15 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConvertedNFTsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConvertedNFTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedNfts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1156,7 +2528,44 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConvertedNfts = append(m.ConvertedNfts, ConvertedNFT{})
+			if err := m.ConvertedNfts[len(m.ConvertedNfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,14 +1,24 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethermint "github.com/evmos/ethermint/types"
 )
 
+// LegacyNFTIDPrefix is the prefix of the nft IDs derived with
+// NFT_ID_SCHEME_LEGACY
+const LegacyNFTIDPrefix = "Cat-"
+
 // NewTokenPair returns an instance of TokenPair
 func NewTokenPair(erc721Address common.Address, classID string, enabled bool, contractOwner Owner) TokenPair {
 	return TokenPair{
@@ -35,9 +45,29 @@ func (tp TokenPair) Validate() error {
 	if err := sdk.ValidateDenom(tp.ClassId); err != nil {
 		return err
 	}
+	if _, ok := NFTIDScheme_name[int32(tp.NftIdScheme)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidNFTIDScheme, "unknown scheme %d", tp.NftIdScheme)
+	}
+	if tp.IsNativeERC721() && tp.NftIdScheme == NFT_ID_SCHEME_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalidNFTIDScheme, "no scheme set for native ERC721 %s", tp.Erc721Address)
+	}
 	return ethermint.ValidateAddress(tp.Erc721Address)
 }
 
+// CreateNFTID returns the native Cosmos nft ID of the given ERC721 token,
+// derived with the nft ID scheme of the token pair
+func (tp TokenPair) CreateNFTID(tokenID *big.Int) (string, error) {
+	switch tp.NftIdScheme {
+	case NFT_ID_SCHEME_KECCAK256:
+		hash := crypto.Keccak256(tp.GetERC721Contract().Bytes(), common.BigToHash(tokenID).Bytes())
+		return fmt.Sprintf("%s:%s", ModuleName, hex.EncodeToString(hash)), nil
+	case NFT_ID_SCHEME_LEGACY:
+		return LegacyNFTIDPrefix + tokenID.String(), nil
+	default:
+		return "", sdkerrors.Wrapf(ErrInvalidNFTIDScheme, "cannot derive nft ID with scheme %s", tp.NftIdScheme)
+	}
+}
+
 // IsNativeNFT returns true if the owner of the ERC721 contract is the
 // erc721 module account
 func (tp TokenPair) IsNativeNFT() bool {
@@ -49,3 +79,25 @@ func (tp TokenPair) IsNativeNFT() bool {
 func (tp TokenPair) IsNativeERC721() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// CreateTokenID returns the ERC721 token ID of the given native Cosmos nft.
+// Decimal nft IDs lower than 2^255 are kept as is, while any other ID is hashed
+// with keccak256 and its top bit cleared, so that an nft is always mapped to the
+// same token ID below the automatically assigned IDs of the module contract.
+func CreateTokenID(nftID string) *big.Int {
+	tokenID, ok := new(big.Int).SetString(nftID, 10)
+	if ok && tokenID.Sign() >= 0 && tokenID.BitLen() < 256 && tokenID.String() == nftID {
+		return tokenID
+	}
+	tokenID = new(big.Int).SetBytes(crypto.Keccak256([]byte(nftID)))
+	return tokenID.SetBit(tokenID, 255, 0)
+}
+
+// ValidateTokenID checks that the given ERC721 token ID is a decimal uint256
+func ValidateTokenID(tokenID string) error {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok || id.Sign() < 0 || id.BitLen() > 256 {
+		return fmt.Errorf("invalid erc721 token id '%s'", tokenID)
+	}
+	return nil
+}
@@ -39,6 +39,9 @@ type MsgConvertNFT struct {
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// cosmos bech32 address from the owner of the given Cosmos coins
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// unsafe_transfer sends the ERC721 token with transferFrom instead of
+	// safeTransferFrom, skipping the onERC721Received check of contract receivers
+	UnsafeTransfer bool `protobuf:"varint,5,opt,name=unsafe_transfer,json=unsafeTransfer,proto3" json:"unsafe_transfer,omitempty"`
 }
 
 func (m *MsgConvertNFT) Reset()         { *m = MsgConvertNFT{} }
@@ -102,6 +105,13 @@ func (m *MsgConvertNFT) GetSender() string {
 	return ""
 }
 
+func (m *MsgConvertNFT) GetUnsafeTransfer() bool {
+	if m != nil {
+		return m.UnsafeTransfer
+	}
+	return false
+}
+
 // MsgConvertNFTResponse returns no fields
 type MsgConvertNFTResponse struct {
 }
@@ -250,43 +260,289 @@ func (m *MsgConvertERC721Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertERC721Response proto.InternalMessageInfo
 
+// MsgConvertNFTBatch defines a Msg to convert multiple native Cosmos nfts of
+// the same class to ERC721 tokens
+type MsgConvertNFTBatch struct {
+	// nft classID to convert to ERC721
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nftIDs to convert to ERC721
+	NftIds []string `protobuf:"bytes,2,rep,name=nft_ids,json=nftIds,proto3" json:"nft_ids,omitempty"`
+	// recipient hex address to receive ERC721 tokens
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// cosmos bech32 address from the owner of the given nfts
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// unsafe_transfer sends the ERC721 tokens with transferFrom instead of
+	// safeTransferFrom, skipping the onERC721Received check of contract receivers
+	UnsafeTransfer bool `protobuf:"varint,5,opt,name=unsafe_transfer,json=unsafeTransfer,proto3" json:"unsafe_transfer,omitempty"`
+}
+
+func (m *MsgConvertNFTBatch) Reset()         { *m = MsgConvertNFTBatch{} }
+func (m *MsgConvertNFTBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertNFTBatch) ProtoMessage()    {}
+func (*MsgConvertNFTBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331f042db48d170e, []int{4}
+}
+func (m *MsgConvertNFTBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertNFTBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertNFTBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertNFTBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertNFTBatch.Merge(m, src)
+}
+func (m *MsgConvertNFTBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertNFTBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertNFTBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertNFTBatch proto.InternalMessageInfo
+
+func (m *MsgConvertNFTBatch) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *MsgConvertNFTBatch) GetNftIds() []string {
+	if m != nil {
+		return m.NftIds
+	}
+	return nil
+}
+
+func (m *MsgConvertNFTBatch) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertNFTBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgConvertNFTBatch) GetUnsafeTransfer() bool {
+	if m != nil {
+		return m.UnsafeTransfer
+	}
+	return false
+}
+
+// MsgConvertNFTBatchResponse returns no fields
+type MsgConvertNFTBatchResponse struct {
+}
+
+func (m *MsgConvertNFTBatchResponse) Reset()         { *m = MsgConvertNFTBatchResponse{} }
+func (m *MsgConvertNFTBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertNFTBatchResponse) ProtoMessage()    {}
+func (*MsgConvertNFTBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331f042db48d170e, []int{5}
+}
+func (m *MsgConvertNFTBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertNFTBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertNFTBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertNFTBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertNFTBatchResponse.Merge(m, src)
+}
+func (m *MsgConvertNFTBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertNFTBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertNFTBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertNFTBatchResponse proto.InternalMessageInfo
+
+// MsgConvertERC721Batch defines a Msg to convert multiple ERC721 tokens of the
+// same contract to native Cosmos nfts
+type MsgConvertERC721Batch struct {
+	// ERC721 token contract address registered in a token pair
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// tokenIDs to convert
+	TokenIds []string `protobuf:"bytes,2,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// bech32 address to receive native Cosmos nfts
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender hex address from the owner of the given ERC721 tokens
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertERC721Batch) Reset()         { *m = MsgConvertERC721Batch{} }
+func (m *MsgConvertERC721Batch) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC721Batch) ProtoMessage()    {}
+func (*MsgConvertERC721Batch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331f042db48d170e, []int{6}
+}
+func (m *MsgConvertERC721Batch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC721Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC721Batch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC721Batch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC721Batch.Merge(m, src)
+}
+func (m *MsgConvertERC721Batch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC721Batch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC721Batch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC721Batch proto.InternalMessageInfo
+
+func (m *MsgConvertERC721Batch) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgConvertERC721Batch) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+func (m *MsgConvertERC721Batch) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertERC721Batch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertERC721BatchResponse returns no fields
+type MsgConvertERC721BatchResponse struct {
+}
+
+func (m *MsgConvertERC721BatchResponse) Reset()         { *m = MsgConvertERC721BatchResponse{} }
+func (m *MsgConvertERC721BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC721BatchResponse) ProtoMessage()    {}
+func (*MsgConvertERC721BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331f042db48d170e, []int{7}
+}
+func (m *MsgConvertERC721BatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC721BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC721BatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC721BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC721BatchResponse.Merge(m, src)
+}
+func (m *MsgConvertERC721BatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC721BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC721BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC721BatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertNFT)(nil), "uptick.erc721.v1.MsgConvertNFT")
 	proto.RegisterType((*MsgConvertNFTResponse)(nil), "uptick.erc721.v1.MsgConvertNFTResponse")
 	proto.RegisterType((*MsgConvertERC721)(nil), "uptick.erc721.v1.MsgConvertERC721")
 	proto.RegisterType((*MsgConvertERC721Response)(nil), "uptick.erc721.v1.MsgConvertERC721Response")
+	proto.RegisterType((*MsgConvertNFTBatch)(nil), "uptick.erc721.v1.MsgConvertNFTBatch")
+	proto.RegisterType((*MsgConvertNFTBatchResponse)(nil), "uptick.erc721.v1.MsgConvertNFTBatchResponse")
+	proto.RegisterType((*MsgConvertERC721Batch)(nil), "uptick.erc721.v1.MsgConvertERC721Batch")
+	proto.RegisterType((*MsgConvertERC721BatchResponse)(nil), "uptick.erc721.v1.MsgConvertERC721BatchResponse")
 }
 
 func init() { proto.RegisterFile("uptick/erc721/v1/tx.proto", fileDescriptor_331f042db48d170e) }
 
 var fileDescriptor_331f042db48d170e = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x8f, 0x12, 0x31,
-	0x14, 0xc6, 0x29, 0x28, 0x60, 0x13, 0x22, 0x69, 0x44, 0x87, 0x89, 0x19, 0xc9, 0x18, 0x23, 0x6a,
-	0x32, 0xcd, 0xe0, 0x81, 0xb3, 0x12, 0x8d, 0x1c, 0xe0, 0x40, 0xf4, 0xe2, 0x85, 0x0c, 0x9d, 0x32,
-	0x4e, 0xc0, 0x76, 0xd2, 0x96, 0x11, 0x6f, 0xc6, 0x33, 0x07, 0x13, 0x0f, 0xfe, 0x4b, 0x1e, 0x49,
-	0xbc, 0x78, 0xdc, 0xc0, 0xfe, 0x21, 0x1b, 0x5a, 0x06, 0x16, 0xb2, 0x81, 0x64, 0x6f, 0x7d, 0xdf,
-	0xf7, 0xcd, 0xeb, 0x6f, 0x5e, 0x1f, 0xac, 0xcf, 0x12, 0x15, 0x93, 0x09, 0xa6, 0x82, 0xb4, 0x5b,
-	0x3e, 0x4e, 0x7d, 0xac, 0xe6, 0x5e, 0x22, 0xb8, 0xe2, 0xa8, 0x6a, 0x2c, 0xcf, 0x58, 0x5e, 0xea,
-	0xdb, 0x8f, 0x23, 0xce, 0xa3, 0x29, 0xc5, 0x41, 0x12, 0xe3, 0x80, 0x31, 0xae, 0x02, 0x15, 0x73,
-	0x26, 0x4d, 0xde, 0x7e, 0x10, 0xf1, 0x88, 0xeb, 0x23, 0xde, 0x9c, 0x8c, 0xea, 0xce, 0x60, 0xa5,
-	0x27, 0xa3, 0x0e, 0x67, 0x29, 0x15, 0xaa, 0xff, 0xfe, 0x23, 0xaa, 0xc3, 0x32, 0x99, 0x06, 0x52,
-	0x0e, 0xe3, 0xd0, 0x02, 0x0d, 0xd0, 0xbc, 0x37, 0x28, 0xe9, 0xba, 0x1b, 0xa2, 0x1a, 0x2c, 0xb2,
-	0xb1, 0xda, 0x18, 0x79, 0x6d, 0xdc, 0x65, 0x63, 0xd5, 0x0d, 0x91, 0x0d, 0xcb, 0x82, 0x12, 0x1a,
-	0xa7, 0x54, 0x58, 0x05, 0x6d, 0xec, 0x6a, 0xf4, 0x10, 0x16, 0x25, 0x65, 0x21, 0x15, 0xd6, 0x1d,
-	0xed, 0x6c, 0x2b, 0xf7, 0x11, 0xac, 0x1d, 0x5c, 0x3b, 0xa0, 0x32, 0xe1, 0x4c, 0x52, 0x77, 0x01,
-	0x60, 0x75, 0xef, 0xbc, 0x1b, 0x74, 0xda, 0x2d, 0x1f, 0xbd, 0x80, 0x55, 0xc2, 0x99, 0x12, 0x01,
-	0x51, 0xc3, 0x20, 0x0c, 0x05, 0x95, 0x72, 0xcb, 0x76, 0x3f, 0xd3, 0xdf, 0x18, 0x79, 0x83, 0xaf,
-	0xf8, 0x84, 0xb2, 0x3d, 0x65, 0x49, 0xd7, 0xb7, 0xe4, 0xb4, 0xa1, 0x75, 0x4c, 0x93, 0xa1, 0xb6,
-	0xfe, 0xe4, 0x61, 0xa1, 0x27, 0x23, 0xf4, 0x03, 0x40, 0x78, 0x6d, 0x80, 0x4f, 0xbc, 0xe3, 0x87,
-	0xf1, 0x0e, 0x7e, 0xd5, 0x7e, 0x7e, 0x26, 0xb0, 0x9b, 0x45, 0xf3, 0xe7, 0xbf, 0xcb, 0xdf, 0x79,
-	0x17, 0x35, 0xf0, 0x0d, 0x5b, 0x80, 0x89, 0xf9, 0x60, 0xc8, 0xc6, 0x0a, 0x2d, 0x00, 0xac, 0x1c,
-	0x8e, 0xcc, 0x3d, 0x75, 0x89, 0xc9, 0xd8, 0x2f, 0xcf, 0x67, 0x76, 0x2c, 0xaf, 0x34, 0xcb, 0x33,
-	0xf4, 0xf4, 0x24, 0x8b, 0x11, 0xdf, 0x7e, 0xf8, 0xbb, 0x72, 0xc0, 0x72, 0xe5, 0x80, 0x8b, 0x95,
-	0x03, 0x7e, 0xad, 0x9d, 0xdc, 0x72, 0xed, 0xe4, 0xfe, 0xaf, 0x9d, 0xdc, 0x67, 0x2f, 0x8a, 0xd5,
-	0x97, 0xd9, 0xc8, 0x23, 0xfc, 0x2b, 0xfe, 0xa4, 0x1b, 0xf5, 0xa9, 0xfa, 0xc6, 0xc5, 0x24, 0x6b,
-	0x3b, 0xcf, 0x1a, 0xab, 0xef, 0x09, 0x95, 0xa3, 0xa2, 0xde, 0xd2, 0xd7, 0x57, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xd8, 0x2c, 0xe3, 0x9a, 0x08, 0x03, 0x00, 0x00,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6f, 0x12, 0x4f,
+	0x18, 0xc6, 0x19, 0x68, 0x81, 0xbe, 0x49, 0xbf, 0x90, 0xc9, 0x17, 0xbb, 0x5d, 0xeb, 0x96, 0xac,
+	0x3f, 0xa0, 0xd6, 0xec, 0x06, 0x3c, 0xf4, 0x6c, 0x1b, 0x8d, 0x3d, 0xb4, 0x07, 0x52, 0x2f, 0x5e,
+	0xc8, 0xb2, 0x3b, 0x6c, 0x37, 0xd4, 0x19, 0x32, 0x33, 0x60, 0xbd, 0x19, 0xcf, 0x3d, 0x98, 0x34,
+	0x5e, 0x4d, 0x3c, 0xf8, 0xbf, 0x78, 0x6c, 0xd2, 0x8b, 0x47, 0x03, 0xfe, 0x21, 0x86, 0x19, 0x76,
+	0x2b, 0x48, 0x00, 0x9b, 0x78, 0xdb, 0xf7, 0x7d, 0x1f, 0x66, 0x3e, 0xcf, 0x93, 0x77, 0x80, 0xcd,
+	0x5e, 0x57, 0x46, 0x7e, 0xc7, 0x25, 0xdc, 0xdf, 0xab, 0xd7, 0xdc, 0x7e, 0xcd, 0x95, 0xe7, 0x4e,
+	0x97, 0x33, 0xc9, 0x70, 0x51, 0x8f, 0x1c, 0x3d, 0x72, 0xfa, 0x35, 0x73, 0x2b, 0x64, 0x2c, 0x3c,
+	0x23, 0xae, 0xd7, 0x8d, 0x5c, 0x8f, 0x52, 0x26, 0x3d, 0x19, 0x31, 0x2a, 0xb4, 0xde, 0xfc, 0x3f,
+	0x64, 0x21, 0x53, 0x9f, 0xee, 0xe8, 0x4b, 0x77, 0xed, 0xcf, 0x08, 0xd6, 0x8f, 0x44, 0x78, 0xc0,
+	0x68, 0x9f, 0x70, 0x79, 0xfc, 0xe2, 0x04, 0x6f, 0x42, 0xde, 0x3f, 0xf3, 0x84, 0x68, 0x46, 0x81,
+	0x81, 0xca, 0xa8, 0xba, 0xd6, 0xc8, 0xa9, 0xfa, 0x30, 0xc0, 0x25, 0xc8, 0xd2, 0xb6, 0x1c, 0x0d,
+	0xd2, 0x6a, 0xb0, 0x4a, 0xdb, 0xf2, 0x30, 0xc0, 0x26, 0xe4, 0x39, 0xf1, 0x49, 0xd4, 0x27, 0xdc,
+	0xc8, 0xa8, 0x41, 0x52, 0xe3, 0x3b, 0x90, 0x15, 0x84, 0x06, 0x84, 0x1b, 0x2b, 0x6a, 0x32, 0xae,
+	0x70, 0x05, 0x0a, 0x3d, 0x2a, 0xbc, 0x36, 0x69, 0x4a, 0xee, 0x51, 0xd1, 0x26, 0xdc, 0x58, 0x2d,
+	0xa3, 0x6a, 0xbe, 0xf1, 0x9f, 0x6e, 0x9f, 0x8c, 0xbb, 0xf6, 0x06, 0x94, 0x26, 0xf8, 0x1a, 0x44,
+	0x74, 0x19, 0x15, 0xc4, 0xbe, 0x40, 0x50, 0xbc, 0x99, 0x3c, 0x6f, 0x1c, 0xec, 0xd5, 0x6b, 0x78,
+	0x07, 0x8a, 0x3e, 0xa3, 0x92, 0x7b, 0xbe, 0x6c, 0x7a, 0x41, 0xc0, 0x89, 0x10, 0x63, 0x13, 0x85,
+	0xb8, 0xff, 0x4c, 0xb7, 0x47, 0x3e, 0x25, 0xeb, 0x10, 0x7a, 0x63, 0x27, 0xa7, 0xea, 0xdb, 0x19,
+	0xb2, 0x4d, 0x30, 0xa6, 0x69, 0x12, 0xd4, 0xaf, 0x08, 0xf0, 0x84, 0x89, 0x7d, 0x4f, 0xfa, 0xa7,
+	0xf3, 0x92, 0xde, 0x80, 0x9c, 0x4e, 0x5a, 0x18, 0xe9, 0x72, 0x66, 0x74, 0x8d, 0x8a, 0x5a, 0xfc,
+	0xdb, 0xac, 0xb7, 0xc0, 0xfc, 0x13, 0x33, 0x71, 0x71, 0x89, 0xa0, 0x34, 0x6d, 0x51, 0x1b, 0xf9,
+	0x8b, 0xd4, 0xef, 0xc2, 0x5a, 0x9c, 0x7a, 0x6c, 0x2d, 0x3f, 0x8e, 0xfd, 0x56, 0xe6, 0xec, 0x6d,
+	0xb8, 0x37, 0x13, 0x2a, 0xc6, 0xae, 0x5f, 0xaf, 0x40, 0xe6, 0x48, 0x84, 0xf8, 0x3d, 0x02, 0xf8,
+	0x6d, 0xcd, 0xb7, 0x9d, 0xe9, 0xf7, 0xe3, 0x4c, 0x78, 0x37, 0x2b, 0x0b, 0x04, 0x49, 0x2e, 0xd5,
+	0x0f, 0xd7, 0x3f, 0x2f, 0xd3, 0x36, 0x2e, 0xbb, 0x33, 0x1e, 0xab, 0xeb, 0xeb, 0x1f, 0x34, 0x69,
+	0x5b, 0xe2, 0x0b, 0x04, 0xeb, 0x93, 0xfb, 0x6a, 0xcf, 0xbb, 0x44, 0x6b, 0xcc, 0xc7, 0x8b, 0x35,
+	0x09, 0xcb, 0xae, 0x62, 0x79, 0x88, 0xef, 0xcf, 0x65, 0xd1, 0x4d, 0xfc, 0x09, 0x41, 0x61, 0x7a,
+	0x27, 0x1f, 0x2c, 0x70, 0xad, 0x54, 0xe6, 0x93, 0x65, 0x54, 0x09, 0x94, 0xa3, 0xa0, 0xaa, 0xf8,
+	0xd1, 0xa2, 0x80, 0x9a, 0x2d, 0xc5, 0xf0, 0x05, 0x01, 0x9e, 0xb1, 0x65, 0x95, 0xc5, 0x39, 0x68,
+	0x3a, 0x77, 0x49, 0x61, 0x02, 0x58, 0x53, 0x80, 0xbb, 0x78, 0x67, 0x89, 0xd4, 0x34, 0xe3, 0xfe,
+	0xcb, 0x6f, 0x03, 0x0b, 0x5d, 0x0d, 0x2c, 0xf4, 0x63, 0x60, 0xa1, 0x8f, 0x43, 0x2b, 0x75, 0x35,
+	0xb4, 0x52, 0xdf, 0x87, 0x56, 0xea, 0xb5, 0x13, 0x46, 0xf2, 0xb4, 0xd7, 0x72, 0x7c, 0xf6, 0xc6,
+	0x7d, 0xa5, 0x8e, 0x3b, 0x26, 0xf2, 0x2d, 0xe3, 0x9d, 0xf8, 0xf0, 0xf3, 0xf8, 0x78, 0xf9, 0xae,
+	0x4b, 0x44, 0x2b, 0xab, 0xfe, 0x88, 0x9f, 0xfe, 0x1a, 0x00, 0x75, 0x73, 0x7d, 0x56, 0xeb, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -307,6 +563,12 @@ type MsgClient interface {
 	// ConvertERC721 mints a native Cosmos coin representation of the ERC721 token
 	// contract that is registered on the token mapping.
 	ConvertERC721(ctx context.Context, in *MsgConvertERC721, opts ...grpc.CallOption) (*MsgConvertERC721Response, error)
+	// ConvertNFTBatch atomically converts multiple native Cosmos nfts of the
+	// same class to their ERC721 representation.
+	ConvertNFTBatch(ctx context.Context, in *MsgConvertNFTBatch, opts ...grpc.CallOption) (*MsgConvertNFTBatchResponse, error)
+	// ConvertERC721Batch atomically converts multiple ERC721 tokens of the same
+	// contract to their native Cosmos nft representation.
+	ConvertERC721Batch(ctx context.Context, in *MsgConvertERC721Batch, opts ...grpc.CallOption) (*MsgConvertERC721BatchResponse, error)
 }
 
 type msgClient struct {
@@ -335,6 +597,24 @@ func (c *msgClient) ConvertERC721(ctx context.Context, in *MsgConvertERC721, opt
 	return out, nil
 }
 
+func (c *msgClient) ConvertNFTBatch(ctx context.Context, in *MsgConvertNFTBatch, opts ...grpc.CallOption) (*MsgConvertNFTBatchResponse, error) {
+	out := new(MsgConvertNFTBatchResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc721.v1.Msg/ConvertNFTBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC721Batch(ctx context.Context, in *MsgConvertERC721Batch, opts ...grpc.CallOption) (*MsgConvertERC721BatchResponse, error) {
+	out := new(MsgConvertERC721BatchResponse)
+	err := c.cc.Invoke(ctx, "/uptick.erc721.v1.Msg/ConvertERC721Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertNFT mints a ERC721 representation of the native Cosmos nft
@@ -343,6 +623,12 @@ type MsgServer interface {
 	// ConvertERC721 mints a native Cosmos coin representation of the ERC721 token
 	// contract that is registered on the token mapping.
 	ConvertERC721(context.Context, *MsgConvertERC721) (*MsgConvertERC721Response, error)
+	// ConvertNFTBatch atomically converts multiple native Cosmos nfts of the
+	// same class to their ERC721 representation.
+	ConvertNFTBatch(context.Context, *MsgConvertNFTBatch) (*MsgConvertNFTBatchResponse, error)
+	// ConvertERC721Batch atomically converts multiple ERC721 tokens of the same
+	// contract to their native Cosmos nft representation.
+	ConvertERC721Batch(context.Context, *MsgConvertERC721Batch) (*MsgConvertERC721BatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -355,6 +641,12 @@ func (*UnimplementedMsgServer) ConvertNFT(ctx context.Context, req *MsgConvertNF
 func (*UnimplementedMsgServer) ConvertERC721(ctx context.Context, req *MsgConvertERC721) (*MsgConvertERC721Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC721 not implemented")
 }
+func (*UnimplementedMsgServer) ConvertNFTBatch(ctx context.Context, req *MsgConvertNFTBatch) (*MsgConvertNFTBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertNFTBatch not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC721Batch(ctx context.Context, req *MsgConvertERC721Batch) (*MsgConvertERC721BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC721Batch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -396,6 +688,42 @@ func _Msg_ConvertERC721_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertNFTBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertNFTBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertNFTBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc721.v1.Msg/ConvertNFTBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertNFTBatch(ctx, req.(*MsgConvertNFTBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC721Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC721Batch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC721Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uptick.erc721.v1.Msg/ConvertERC721Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC721Batch(ctx, req.(*MsgConvertERC721Batch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "uptick.erc721.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -408,6 +736,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertERC721",
 			Handler:    _Msg_ConvertERC721_Handler,
 		},
+		{
+			MethodName: "ConvertNFTBatch",
+			Handler:    _Msg_ConvertNFTBatch_Handler,
+		},
+		{
+			MethodName: "ConvertERC721Batch",
+			Handler:    _Msg_ConvertERC721Batch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "uptick/erc721/v1/tx.proto",
@@ -433,6 +769,16 @@ func (m *MsgConvertNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnsafeTransfer {
+		i--
+		if m.UnsafeTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -561,63 +907,228 @@ func (m *MsgConvertERC721Response) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgConvertNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConvertNFTBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConvertNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgConvertNFTBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConvertERC721) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConvertNFTBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnsafeTransfer {
+		i--
+		if m.UnsafeTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.TokenId)
-	if l > 0 {
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NftIds) > 0 {
+		for iNdEx := len(m.NftIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NftIds[iNdEx])
+			copy(dAtA[i:], m.NftIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NftIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertNFTBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertNFTBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertNFTBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC721Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC721Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC721Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC721BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC721BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC721BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnsafeTransfer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgConvertNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC721) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Receiver)
@@ -640,6 +1151,81 @@ func (m *MsgConvertERC721Response) Size() (n int) {
 	return n
 }
 
+func (m *MsgConvertNFTBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NftIds) > 0 {
+		for _, s := range m.NftIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnsafeTransfer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgConvertNFTBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC721Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC721BatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -803,6 +1389,26 @@ func (m *MsgConvertNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsafeTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnsafeTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1102,6 +1708,482 @@ func (m *MsgConvertERC721Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgConvertNFTBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertNFTBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertNFTBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsafeTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnsafeTransfer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertNFTBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertNFTBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertNFTBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC721Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC721Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC721Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC721BatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC721BatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC721BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0